@@ -19,6 +19,18 @@ type HandlerOptions struct {
 	IBCKeeper         *keeper.Keeper
 	WasmConfig        *wasmTypes.WasmConfig
 	TXCounterStoreKey sdk.StoreKey
+	// ContractAuthKeeper is optional and not set by default. When set, txs signed on behalf of contract accounts are
+	// authenticated by the contracts that the authentication ante hook is enabled for by governance.
+	ContractAuthKeeper wasmkeeper.ContractAuthKeeper
+	// ContractAuthGasLimit is the max gas a contract can consume to authenticate a tx. Defaults to
+	// wasmkeeper.DefaultContractAuthGasLimit when not set.
+	ContractAuthGasLimit sdk.Gas
+	// ContractFeeKeeper is optional. When set, contracts that are set as fee granter of a tx can sponsor the fees when
+	// the fee sponsorship ante hook is enabled for them by governance.
+	ContractFeeKeeper wasmkeeper.ContractFeeKeeper
+	// ContractFeeGasLimit is the max gas a contract can consume to approve sponsoring the fees of a tx. Defaults to
+	// wasmkeeper.DefaultContractFeeGasLimit when not set.
+	ContractFeeGasLimit sdk.Gas
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -43,6 +55,38 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
+	sigDecorators := []sdk.AnteDecorator{
+		// SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewSetPubKeyDecorator(options.AccountKeeper),
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+	}
+	if options.ContractAuthKeeper != nil {
+		contractAuthGasLimit := options.ContractAuthGasLimit
+		if contractAuthGasLimit == 0 {
+			contractAuthGasLimit = wasmkeeper.DefaultContractAuthGasLimit
+		}
+		// contract signers are authenticated by the contracts, all other txs by the signature decorators
+		sigDecorators = []sdk.AnteDecorator{
+			wasmkeeper.NewContractAuthDecorator(options.ContractAuthKeeper, options.AccountKeeper, options.SignModeHandler, contractAuthGasLimit, sigDecorators...),
+		}
+	}
+
+	feeDecorator := ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper)
+	feeAndSigDecorators := append([]sdk.AnteDecorator{feeDecorator}, sigDecorators...)
+	if options.ContractFeeKeeper != nil {
+		contractFeeGasLimit := options.ContractFeeGasLimit
+		if contractFeeGasLimit == 0 {
+			contractFeeGasLimit = wasmkeeper.DefaultContractFeeGasLimit
+		}
+		// fees of txs with a sponsoring contract fee granter are deducted from the contract after the signatures are
+		// verified, all other fees are deducted by the SDK before
+		feeAndSigDecorators = []sdk.AnteDecorator{
+			wasmkeeper.NewContractFeeDecorator(options.ContractFeeKeeper, contractFeeGasLimit, feeDecorator, sigDecorators...),
+		}
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
@@ -53,15 +97,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
-		// SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewSetPubKeyDecorator(options.AccountKeeper),
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+	}
+	anteDecorators = append(anteDecorators, feeAndSigDecorators...)
+	anteDecorators = append(anteDecorators,
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewAnteDecorator(options.IBCKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
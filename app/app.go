@@ -119,6 +119,9 @@ import (
 
 const appName = "WasmApp"
 
+// AvailableCapabilities are the wasmvm capabilities that contracts on this chain can require
+const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+
 // We pull these out so we can set them with LDFLAGS in the Makefile
 var (
 	NodeDir      = ".wasmd"
@@ -514,7 +517,6 @@ func NewWasmApp(
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
-	availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1"
 	app.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		keys[wasm.StoreKey],
@@ -531,7 +533,7 @@ func NewWasmApp(
 		app.GRPCQueryRouter(),
 		wasmDir,
 		wasmConfig,
-		availableCapabilities,
+		AvailableCapabilities,
 		wasmOpts...,
 	)
 
@@ -765,6 +767,7 @@ func NewWasmApp(
 			},
 			IBCKeeper:         app.IBCKeeper,
 			WasmConfig:        &wasmConfig,
+			ContractFeeKeeper: app.WasmKeeper,
 			TXCounterStoreKey: keys[wasm.StoreKey],
 		},
 	)
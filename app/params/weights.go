@@ -23,4 +23,17 @@ const (
 	DefaultWeightMsgStoreCode           int = 50
 	DefaultWeightMsgInstantiateContract int = 100
 	DefaultWeightMsgExecuteContract     int = 100
+
+	DefaultWeightMsgInstantiateContract2            int = 50
+	DefaultWeightMsgMigrateContract                 int = 50
+	DefaultWeightMsgUpdateAdmin                     int = 25
+	DefaultWeightMsgClearAdmin                      int = 10
+	DefaultWeightMsgStoreCodeAndInstantiateContract int = 50
+
+	DefaultWeightPinCodesProposal                int = 5
+	DefaultWeightUnpinCodesProposal              int = 5
+	DefaultWeightSudoContractProposal            int = 5
+	DefaultWeightUpdateInstantiateConfigProposal int = 5
+	DefaultWeightDeactivateContractProposal      int = 5
+	DefaultWeightActivateContractProposal        int = 5
 )
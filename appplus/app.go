@@ -745,6 +745,7 @@ func NewWasmApp(
 			},
 			IBCKeeper:         app.IBCKeeper,
 			WasmConfig:        &wasmConfig,
+			ContractFeeKeeper: app.WasmKeeper,
 			TXCounterStoreKey: keys[wasmplustypes.StoreKey],
 		},
 	)
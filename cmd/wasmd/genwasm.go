@@ -5,6 +5,7 @@ import (
 
 	"github.com/Finschia/finschia-sdk/client"
 
+	"github.com/Finschia/wasmd/x/wasm"
 	wasmcli "github.com/Finschia/wasmd/x/wasm/client/cli"
 )
 
@@ -26,3 +27,35 @@ func AddGenesisWasmMsgCmd(defaultNodeHome string) *cobra.Command {
 	)
 	return txCmd
 }
+
+// GenesisCmd returns the genesis file subcommands
+func GenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Genesis file subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		GenesisWasmCmd(defaultNodeHome),
+	)
+	return cmd
+}
+
+// GenesisWasmCmd returns the subcommands for the wasm section of the genesis file
+func GenesisWasmCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "wasm",
+		Short:                      "Wasm genesis subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	genesisIO := wasmcli.NewDefaultGenesisIO()
+	cmd.AddCommand(
+		wasmcli.GenesisApplyBatchCmd(defaultNodeHome, genesisIO),
+		wasmcli.GenesisMigrateCmd(wasm.AppModuleBasic{}),
+	)
+	return cmd
+}
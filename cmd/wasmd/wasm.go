@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/server"
+	"github.com/Finschia/finschia-sdk/store"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	bankpluskeeper "github.com/Finschia/finschia-sdk/x/bankplus/keeper"
+	paramstypes "github.com/Finschia/finschia-sdk/x/params/types"
+
+	"github.com/Finschia/wasmd/app"
+	"github.com/Finschia/wasmd/x/wasm"
+	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
+)
+
+const (
+	flagVerifyOnly  = "verify-only"
+	flagConcurrency = "concurrency"
+)
+
+// WasmCmd returns the node maintenance subcommands for the wasm module
+func WasmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "wasm",
+		Short:                      "Wasm node maintenance subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		RebuildWasmCacheCmd(),
+	)
+	return cmd
+}
+
+// RebuildWasmCacheCmd verifies and rebuilds the wasmvm cache in <home>/wasm from the application state
+func RebuildWasmCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild-cache",
+		Short: "Verify and rebuild the wasmvm cache from the application state",
+		Long: `Open the existing application database with the configured db_backend and check that the wasm byte code of every stored
+code is present in the wasmvm cache and matches its checksum. The byte code is recompiled into the cache in parallel.
+Pinned codes are pinned again when the node starts. With --verify-only, missing or mismatched codes are reported
+without writing to the cache. The application state is never modified. The node must be stopped while running
+this command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			homeDir := serverCtx.Config.RootDir
+
+			verifyOnly, err := cmd.Flags().GetBool(flagVerifyOnly)
+			if err != nil {
+				return err
+			}
+			concurrency, err := cmd.Flags().GetUint32(flagConcurrency)
+			if err != nil {
+				return err
+			}
+			wasmConfig, err := wasm.ReadWasmConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			db, err := openApplicationDB(filepath.Join(homeDir, "data"), dbm.BackendType(serverCtx.Config.DBBackend))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			storeKey := sdk.NewKVStoreKey(wasmtypes.StoreKey)
+			cms := store.NewCommitMultiStore(db)
+			cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+			if err := cms.LoadLatestVersion(); err != nil {
+				return err
+			}
+			// the cache store is never written so that the application state is not modified
+			ctx := sdk.NewContext(cms.CacheMultiStore(), tmproto.Header{}, false, serverCtx.Logger)
+
+			encodingConfig := app.MakeEncodingConfig()
+			keeper := wasmkeeper.NewKeeper(
+				encodingConfig.Marshaler,
+				storeKey,
+				paramstypes.NewSubspace(nil, nil, nil, nil, wasmtypes.ModuleName),
+				// no other modules are called when the cache is rebuilt
+				nil, bankpluskeeper.BaseKeeper{}, nil, nil, nil, nil, nil, nil, nil, nil,
+				filepath.Join(homeDir, "wasm"),
+				wasmConfig,
+				app.AvailableCapabilities,
+			)
+
+			entries := keeper.RebuildWasmCache(ctx, verifyOnly, concurrency)
+			var failed int
+			for _, e := range entries {
+				if e.Err != nil {
+					failed++
+					cmd.PrintErrf("code ids %v with checksum %X: %s\n", e.CodeIDs, e.Checksum, e.Err)
+				}
+			}
+			action := "rebuilt"
+			if verifyOnly {
+				action = "verified"
+			}
+			cmd.Printf("%s %d of %d wasm codes at height %d\n", action, len(entries)-failed, len(entries), cms.LastCommitID().Version)
+			if failed != 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("%d wasm codes failed", failed)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagVerifyOnly, false, "Only report missing or mismatched wasm codes without writing to the cache")
+	cmd.Flags().Uint32(flagConcurrency, uint32(runtime.NumCPU()), "Max number of wasm codes compiled in parallel")
+	return cmd
+}
+
+// openApplicationDB opens the existing application database in the data dir. It fails instead of creating an empty
+// database when the home dir is wrong. The goleveldb backend is opened read-only.
+func openApplicationDB(dataDir string, backend dbm.BackendType) (dbm.DB, error) {
+	if _, err := os.Stat(filepath.Join(dataDir, "application.db")); err != nil {
+		return nil, fmt.Errorf("application database not found in %s, check --home: %w", dataDir, err)
+	}
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+	return dbm.NewDB("application", backend, dataDir)
+}
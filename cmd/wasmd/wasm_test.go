@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Finschia/finschia-sdk/server"
+	"github.com/Finschia/finschia-sdk/store"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	genutiltest "github.com/Finschia/finschia-sdk/x/genutil/client/testutil"
+	"github.com/Finschia/ostracon/libs/log"
+
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestRebuildWasmCacheCmd(t *testing.T) {
+	specs := map[string]struct {
+		setup  func(t *testing.T, dataDir string)
+		expOut string
+		expErr bool
+	}{
+		"application db verified": {
+			setup: func(t *testing.T, dataDir string) {
+				db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+				require.NoError(t, err)
+				defer db.Close()
+				cms := store.NewCommitMultiStore(db)
+				cms.MountStoreWithDB(sdk.NewKVStoreKey(wasmtypes.StoreKey), sdk.StoreTypeIAVL, nil)
+				require.NoError(t, cms.LoadLatestVersion())
+				cms.Commit()
+			},
+			expOut: "verified 0 of 0 wasm codes at height 1\n",
+		},
+		"missing application db": {
+			setup:  func(t *testing.T, dataDir string) {},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			homeDir := t.TempDir()
+			dataDir := filepath.Join(homeDir, "data")
+			spec.setup(t, dataDir)
+			cfg, err := genutiltest.CreateDefaultTendermintConfig(homeDir)
+			require.NoError(t, err)
+			serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+			ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+			cmd := RebuildWasmCacheCmd()
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&out)
+			cmd.SetArgs([]string{"--" + flagVerifyOnly})
+
+			// when
+			gotErr := cmd.ExecuteContext(ctx)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				_, err := os.Stat(filepath.Join(dataDir, "application.db"))
+				assert.True(t, os.IsNotExist(err), "application db created")
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expOut, out.String())
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/Finschia/finschia-sdk/client/keys"
 	"github.com/Finschia/finschia-sdk/client/rpc"
 	"github.com/Finschia/finschia-sdk/server"
+	serverconfig "github.com/Finschia/finschia-sdk/server/config"
 	servertypes "github.com/Finschia/finschia-sdk/server/types"
 	"github.com/Finschia/finschia-sdk/snapshots"
 	"github.com/Finschia/finschia-sdk/store"
@@ -85,7 +86,8 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
-			return server.InterceptConfigsPreRunHandler(cmd, "", nil)
+			customAppTemplate, customAppConfig := initAppConfig()
+			return server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, customAppConfig)
 		},
 	}
 
@@ -94,6 +96,23 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 	return rootCmd, encodingConfig
 }
 
+// initAppConfig helps to override default appConfig template and configs.
+// return "", nil if no custom configuration is required for the application.
+func initAppConfig() (string, interface{}) {
+	type CustomAppConfig struct {
+		serverconfig.Config
+
+		Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+	}
+
+	customAppConfig := CustomAppConfig{
+		Config: *serverconfig.DefaultConfig(),
+		Wasm:   wasmtypes.DefaultWasmConfig(),
+	}
+	customAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate()
+	return customAppTemplate, customAppConfig
+}
+
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(appplus.ModuleBasics, appplus.DefaultNodeHome),
@@ -112,6 +131,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		encCfg: encodingConfig,
 	}
 	server.AddCommands(rootCmd, appplus.DefaultNodeHome, ac.newApp, ac.appExport, addModuleInitFlags)
+	addModuleExportFlags(rootCmd)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -127,6 +147,14 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	wasm.AddModuleInitFlags(startCmd)
 }
 
+func addModuleExportFlags(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			wasm.AddModuleExportFlags(cmd)
+		}
+	}
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -257,7 +285,11 @@ func (ac appCreator) appExport(
 	}
 
 	loadLatest := height == -1
-	var emptyWasmOpts []wasm.Option
+	exportFilter, err := wasm.ReadGenesisExportFilter(appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	wasmOpts := []wasm.Option{wasmkeeper.WithGenesisExportFilter(exportFilter)}
 	wasmApp = appplus.NewWasmApp(
 		logger,
 		db,
@@ -269,7 +301,7 @@ func (ac appCreator) appExport(
 		ac.encCfg,
 		appplus.GetEnabledProposals(),
 		appOpts,
-		emptyWasmOpts,
+		wasmOpts,
 	)
 
 	if height != -1 {
@@ -94,40 +94,60 @@ var (
 )
 
 type (
-	ProposalType                   = types.ProposalType
-	GenesisState                   = types.GenesisState
-	Code                           = types.Code
-	Contract                       = types.Contract
-	MsgStoreCode                   = types.MsgStoreCode
-	MsgStoreCodeResponse           = types.MsgStoreCodeResponse
-	MsgInstantiateContract         = types.MsgInstantiateContract
-	MsgInstantiateContract2        = types.MsgInstantiateContract2
-	MsgInstantiateContractResponse = types.MsgInstantiateContractResponse
-	MsgExecuteContract             = types.MsgExecuteContract
-	MsgExecuteContractResponse     = types.MsgExecuteContractResponse
-	MsgMigrateContract             = types.MsgMigrateContract
-	MsgMigrateContractResponse     = types.MsgMigrateContractResponse
-	MsgUpdateAdmin                 = types.MsgUpdateAdmin
-	MsgUpdateAdminResponse         = types.MsgUpdateAdminResponse
-	MsgClearAdmin                  = types.MsgClearAdmin
-	MsgWasmIBCCall                 = types.MsgIBCSend
-	MsgClearAdminResponse          = types.MsgClearAdminResponse
-	MsgServer                      = types.MsgServer
-	Model                          = types.Model
-	CodeInfo                       = types.CodeInfo
-	ContractInfo                   = types.ContractInfo
-	CreatedAt                      = types.AbsoluteTxPosition
-	Config                         = types.WasmConfig
-	CodeInfoResponse               = types.CodeInfoResponse
-	MessageHandler                 = keeper.SDKMessageHandler
-	BankEncoder                    = keeper.BankEncoder
-	CustomEncoder                  = keeper.CustomEncoder
-	StakingEncoder                 = keeper.StakingEncoder
-	WasmEncoder                    = keeper.WasmEncoder //nolint:revive
-	MessageEncoders                = keeper.MessageEncoders
-	Keeper                         = keeper.Keeper
-	QueryHandler                   = keeper.QueryHandler
-	CustomQuerier                  = keeper.CustomQuerier
-	QueryPlugins                   = keeper.QueryPlugins
-	Option                         = keeper.Option
+	ProposalType                         = types.ProposalType
+	GenesisState                         = types.GenesisState
+	Code                                 = types.Code
+	Contract                             = types.Contract
+	MsgStoreCode                         = types.MsgStoreCode
+	MsgStoreCodeResponse                 = types.MsgStoreCodeResponse
+	MsgInstantiateContract               = types.MsgInstantiateContract
+	MsgInstantiateContract2              = types.MsgInstantiateContract2
+	MsgInstantiateContractResponse       = types.MsgInstantiateContractResponse
+	MsgExecuteContract                   = types.MsgExecuteContract
+	MsgExecuteContractResponse           = types.MsgExecuteContractResponse
+	MsgMigrateContract                   = types.MsgMigrateContract
+	MsgMigrateContractResponse           = types.MsgMigrateContractResponse
+	MsgUpdateAdmin                       = types.MsgUpdateAdmin
+	MsgUpdateAdminResponse               = types.MsgUpdateAdminResponse
+	MsgClearAdmin                        = types.MsgClearAdmin
+	MsgWasmIBCCall                       = types.MsgIBCSend
+	MsgClearAdminResponse                = types.MsgClearAdminResponse
+	MsgProposeAdmin                      = types.MsgProposeAdmin
+	MsgProposeAdminResponse              = types.MsgProposeAdminResponse
+	MsgAcceptAdmin                       = types.MsgAcceptAdmin
+	MsgAcceptAdminResponse               = types.MsgAcceptAdminResponse
+	MsgCancelAdminProposal               = types.MsgCancelAdminProposal
+	MsgCancelAdminProposalResponse       = types.MsgCancelAdminProposalResponse
+	MsgSetContractTimelock               = types.MsgSetContractTimelock
+	MsgSetContractTimelockResponse       = types.MsgSetContractTimelockResponse
+	MsgCancelTimelockedOperation         = types.MsgCancelTimelockedOperation
+	MsgCancelTimelockedOperationResponse = types.MsgCancelTimelockedOperationResponse
+	MsgMakeContractImmutable             = types.MsgMakeContractImmutable
+	MsgMakeContractImmutableResponse     = types.MsgMakeContractImmutableResponse
+	MsgVerifyCode                        = types.MsgVerifyCode
+	MsgVerifyCodeResponse                = types.MsgVerifyCodeResponse
+	MsgSetCodeStatus                     = types.MsgSetCodeStatus
+	MsgSetCodeStatusResponse             = types.MsgSetCodeStatusResponse
+	MsgPruneCodes                        = types.MsgPruneCodes
+	MsgPruneCodesResponse                = types.MsgPruneCodesResponse
+	MsgSetMigrationPolicy                = types.MsgSetMigrationPolicy
+	MsgSetMigrationPolicyResponse        = types.MsgSetMigrationPolicyResponse
+	MsgServer                            = types.MsgServer
+	Model                                = types.Model
+	CodeInfo                             = types.CodeInfo
+	ContractInfo                         = types.ContractInfo
+	CreatedAt                            = types.AbsoluteTxPosition
+	Config                               = types.WasmConfig
+	CodeInfoResponse                     = types.CodeInfoResponse
+	MessageHandler                       = keeper.SDKMessageHandler
+	BankEncoder                          = keeper.BankEncoder
+	CustomEncoder                        = keeper.CustomEncoder
+	StakingEncoder                       = keeper.StakingEncoder
+	WasmEncoder                          = keeper.WasmEncoder //nolint:revive
+	MessageEncoders                      = keeper.MessageEncoders
+	Keeper                               = keeper.Keeper
+	QueryHandler                         = keeper.QueryHandler
+	CustomQuerier                        = keeper.CustomQuerier
+	QueryPlugins                         = keeper.QueryPlugins
+	Option                               = keeper.Option
 )
@@ -0,0 +1,429 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/Finschia/finschia-sdk/client/flags"
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/ioutils"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+const (
+	batchRefStep  = "step:"
+	batchRefLabel = "label:"
+)
+
+// GenesisBatch is an ordered list of wasm genesis operations.
+//
+// Code ids and contract addresses of earlier steps can be referenced symbolically:
+// "step:1" resolves to the code id or contract address that was the result of step 1 and
+// "label:X" resolves to the address of the contract labelled X.
+type GenesisBatch struct {
+	// RunAs is the default address or key name for all steps
+	RunAs string             `yaml:"run_as"`
+	Steps []GenesisBatchStep `yaml:"steps"`
+}
+
+// GenesisBatchStep is a single operation. Exactly one of the fields must be set.
+type GenesisBatchStep struct {
+	Store       *GenesisBatchStore       `yaml:"store"`
+	Instantiate *GenesisBatchInstantiate `yaml:"instantiate"`
+	Execute     *GenesisBatchExecute     `yaml:"execute"`
+}
+
+// GenesisBatchStore adds a `MsgStoreCode`
+type GenesisBatchStore struct {
+	RunAs string `yaml:"run_as"`
+	// WasmFile is the path to the wasm binary, relative to the batch file
+	WasmFile              string              `yaml:"wasm_file"`
+	InstantiatePermission *types.AccessConfig `yaml:"instantiate_permission"`
+}
+
+// GenesisBatchInstantiate adds a `MsgInstantiateContract`
+type GenesisBatchInstantiate struct {
+	RunAs string `yaml:"run_as"`
+	// CodeID is a code id or a step reference
+	CodeID string `yaml:"code_id"`
+	Label  string `yaml:"label"`
+	// Msg is the init message as JSON string or yaml mapping
+	Msg interface{} `yaml:"msg"`
+	// Admin is an address or a contract reference
+	Admin   string `yaml:"admin"`
+	NoAdmin bool   `yaml:"no_admin"`
+	Funds   string `yaml:"funds"`
+}
+
+// GenesisBatchExecute adds a `MsgExecuteContract`
+type GenesisBatchExecute struct {
+	RunAs string `yaml:"run_as"`
+	// Contract is an address or a contract reference
+	Contract string `yaml:"contract"`
+	// Msg is the execute message as JSON string or yaml mapping
+	Msg   interface{} `yaml:"msg"`
+	Funds string      `yaml:"funds"`
+}
+
+// GenesisBatchResult is the outcome of a single batch step
+type GenesisBatchResult struct {
+	Step            int    `json:"step"`
+	Operation       string `json:"operation"`
+	CodeID          uint64 `json:"code_id,omitempty"`
+	ContractAddress string `json:"contract_address,omitempty"`
+}
+
+// GenesisApplyBatchCmd cli command to add the `MsgStoreCode`, `MsgInstantiateContract` and `MsgExecuteContract`
+// operations of a batch file to the wasm section of the genesis that are executed on block 0.
+// The genesis is only written when all steps succeed.
+func GenesisApplyBatchCmd(defaultNodeHome string, genesisMutator GenesisMutator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply [batch.yaml]",
+		Short: "Add an ordered batch of store, instantiate and execute operations",
+		Long: `Add an ordered batch of store, instantiate and execute operations to the genesis.
+Later steps can refer to the results of earlier steps: "step:1" is the code id or contract address of step 1
+and "label:X" is the address of the contract labelled X. Example:
+
+run_as: validator
+steps:
+  - store:
+      wasm_file: ./hackatom.wasm
+  - instantiate:
+      code_id: step:1
+      label: my contract
+      msg: {"verifier": "link1...", "beneficiary": "link1..."}
+      no_admin: true
+  - execute:
+      contract: label:my contract
+      msg: {"release": {}}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			batch, err := readGenesisBatch(args[0])
+			if err != nil {
+				return err
+			}
+			var results []GenesisBatchResult
+			err = genesisMutator.AlterWasmModuleState(cmd, func(state *types.GenesisState, appState map[string]json.RawMessage) error {
+				var err error
+				results, err = applyGenesisBatch(cmd, batch, filepath.Dir(args[0]), state, appState)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			return printJSONOutput(cmd, results)
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func readGenesisBatch(file string) (GenesisBatch, error) {
+	var batch GenesisBatch
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return batch, err
+	}
+	if err := yaml.UnmarshalStrict(bz, &batch); err != nil {
+		return batch, fmt.Errorf("batch file: %w", err)
+	}
+	if len(batch.Steps) == 0 {
+		return batch, errors.New("batch file: no steps")
+	}
+	return batch, nil
+}
+
+func applyGenesisBatch(cmd *cobra.Command, batch GenesisBatch, baseDir string, state *types.GenesisState, appState map[string]json.RawMessage) ([]GenesisBatchResult, error) {
+	results := make([]GenesisBatchResult, 0, len(batch.Steps))
+	// funds sent by the previous steps are not available to later steps of the same sender
+	spent := make(map[string]sdk.Coins)
+	for i, step := range batch.Steps {
+		var (
+			result GenesisBatchResult
+			err    error
+		)
+		switch {
+		case step.Store != nil && step.Instantiate == nil && step.Execute == nil:
+			result, err = applyBatchStore(cmd, batch, baseDir, *step.Store, state)
+		case step.Instantiate != nil && step.Store == nil && step.Execute == nil:
+			result, err = applyBatchInstantiate(cmd, batch, results, *step.Instantiate, state, appState, spent)
+		case step.Execute != nil && step.Store == nil && step.Instantiate == nil:
+			result, err = applyBatchExecute(cmd, batch, results, *step.Execute, state, appState, spent)
+		default:
+			err = errors.New("exactly one of store, instantiate or execute must be set")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("step %d: %w", i+1, err)
+		}
+		result.Step = i + 1
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func applyBatchStore(cmd *cobra.Command, batch GenesisBatch, baseDir string, op GenesisBatchStore, state *types.GenesisState) (GenesisBatchResult, error) {
+	senderAddr, err := batchActorAddress(cmd, batch, op.RunAs)
+	if err != nil {
+		return GenesisBatchResult{}, err
+	}
+	file := op.WasmFile
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(baseDir, file)
+	}
+	wasm, err := os.ReadFile(file)
+	if err != nil {
+		return GenesisBatchResult{}, err
+	}
+	if ioutils.IsWasm(wasm) {
+		if wasm, err = ioutils.GzipIt(wasm); err != nil {
+			return GenesisBatchResult{}, err
+		}
+	} else if !ioutils.IsGzip(wasm) {
+		return GenesisBatchResult{}, fmt.Errorf("invalid wasm file %s. Use wasm binary or gzip", op.WasmFile)
+	}
+	msg := types.MsgStoreCode{
+		Sender:                senderAddr.String(),
+		WASMByteCode:          wasm,
+		InstantiatePermission: op.InstantiatePermission,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return GenesisBatchResult{}, err
+	}
+	state.GenMsgs = append(state.GenMsgs, types.GenesisState_GenMsgs{
+		Sum: &types.GenesisState_GenMsgs_StoreCode{StoreCode: &msg},
+	})
+	codes := GetAllCodes(state)
+	return GenesisBatchResult{Operation: "store", CodeID: codes[len(codes)-1].CodeID}, nil
+}
+
+func applyBatchInstantiate(cmd *cobra.Command, batch GenesisBatch, results []GenesisBatchResult, op GenesisBatchInstantiate, state *types.GenesisState, appState map[string]json.RawMessage, spent map[string]sdk.Coins) (GenesisBatchResult, error) {
+	senderAddr, err := batchActorAddress(cmd, batch, op.RunAs)
+	if err != nil {
+		return GenesisBatchResult{}, err
+	}
+	codeID, err := resolveBatchCodeID(op.CodeID, results)
+	if err != nil {
+		return GenesisBatchResult{}, fmt.Errorf("code id: %w", err)
+	}
+	msgBz, err := batchMsgJSON(op.Msg)
+	if err != nil {
+		return GenesisBatchResult{}, err
+	}
+	funds, err := sdk.ParseCoinsNormalized(op.Funds)
+	if err != nil {
+		return GenesisBatchResult{}, fmt.Errorf("funds: %w", err)
+	}
+	if op.Admin != "" && op.NoAdmin {
+		return GenesisBatchResult{}, errors.New("admin and no_admin are mutually exclusive")
+	}
+	if op.Admin == "" && !op.NoAdmin {
+		return GenesisBatchResult{}, errors.New("you must set an admin or explicitly pass no_admin to make it immutible (wasmd issue #719)")
+	}
+	var admin string
+	if op.Admin != "" {
+		if admin, err = resolveBatchContract(op.Admin, state, results); err != nil {
+			return GenesisBatchResult{}, fmt.Errorf("admin: %w", err)
+		}
+	}
+	msg := types.MsgInstantiateContract{
+		Sender: senderAddr.String(),
+		CodeID: codeID,
+		Label:  op.Label,
+		Msg:    msgBz,
+		Admin:  admin,
+		Funds:  funds,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return GenesisBatchResult{}, err
+	}
+	if err := spendBatchFunds(cmd, appState, spent, senderAddr, msg.Funds); err != nil {
+		return GenesisBatchResult{}, err
+	}
+	var codeInfo *CodeMeta
+	codeInfos := GetAllCodes(state)
+	for i := range codeInfos {
+		if codeInfos[i].CodeID == msg.CodeID {
+			codeInfo = &codeInfos[i]
+			break
+		}
+	}
+	if codeInfo == nil {
+		return GenesisBatchResult{}, fmt.Errorf("unknown code id: %d", msg.CodeID)
+	}
+	if !codeInfo.Info.InstantiateConfig.Allowed(senderAddr) {
+		return GenesisBatchResult{}, fmt.Errorf("permissions were not granted for %s", senderAddr)
+	}
+	state.GenMsgs = append(state.GenMsgs, types.GenesisState_GenMsgs{
+		Sum: &types.GenesisState_GenMsgs_InstantiateContract{InstantiateContract: &msg},
+	})
+	contracts := GetAllContracts(state)
+	return GenesisBatchResult{Operation: "instantiate", CodeID: codeID, ContractAddress: contracts[len(contracts)-1].ContractAddress}, nil
+}
+
+func applyBatchExecute(cmd *cobra.Command, batch GenesisBatch, results []GenesisBatchResult, op GenesisBatchExecute, state *types.GenesisState, appState map[string]json.RawMessage, spent map[string]sdk.Coins) (GenesisBatchResult, error) {
+	senderAddr, err := batchActorAddress(cmd, batch, op.RunAs)
+	if err != nil {
+		return GenesisBatchResult{}, err
+	}
+	contractAddr, err := resolveBatchContract(op.Contract, state, results)
+	if err != nil {
+		return GenesisBatchResult{}, fmt.Errorf("contract: %w", err)
+	}
+	msgBz, err := batchMsgJSON(op.Msg)
+	if err != nil {
+		return GenesisBatchResult{}, err
+	}
+	funds, err := sdk.ParseCoinsNormalized(op.Funds)
+	if err != nil {
+		return GenesisBatchResult{}, fmt.Errorf("funds: %w", err)
+	}
+	msg := types.MsgExecuteContract{
+		Sender:   senderAddr.String(),
+		Contract: contractAddr,
+		Msg:      msgBz,
+		Funds:    funds,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return GenesisBatchResult{}, err
+	}
+	if err := spendBatchFunds(cmd, appState, spent, senderAddr, msg.Funds); err != nil {
+		return GenesisBatchResult{}, err
+	}
+	if !hasContract(state, msg.Contract) {
+		return GenesisBatchResult{}, fmt.Errorf("unknown contract: %s", msg.Contract)
+	}
+	state.GenMsgs = append(state.GenMsgs, types.GenesisState_GenMsgs{
+		Sum: &types.GenesisState_GenMsgs_ExecuteContract{ExecuteContract: &msg},
+	})
+	return GenesisBatchResult{Operation: "execute", ContractAddress: contractAddr}, nil
+}
+
+// spendBatchFunds checks that the genesis balance of the sender covers the funds together with the funds
+// spent in previous steps and adds them to the spent amount
+func spendBatchFunds(cmd *cobra.Command, appState map[string]json.RawMessage, spent map[string]sdk.Coins, sender sdk.AccAddress, funds sdk.Coins) error {
+	total := spent[sender.String()].Add(funds...)
+	switch ok, err := hasAccountBalance(cmd, appState, sender, total); {
+	case err != nil:
+		return err
+	case !ok:
+		return errors.New("sender has not enough account balance")
+	}
+	spent[sender.String()] = total
+	return nil
+}
+
+// batchActorAddress returns the address for the step or the batch default actor
+func batchActorAddress(cmd *cobra.Command, batch GenesisBatch, runAs string) (sdk.AccAddress, error) {
+	if runAs == "" {
+		runAs = batch.RunAs
+	}
+	if runAs == "" {
+		return nil, errors.New("run_as address is required")
+	}
+	return resolveActorAddress(cmd, runAs)
+}
+
+// resolveBatchCodeID returns the code id for a numeric value or a step reference
+func resolveBatchCodeID(ref string, results []GenesisBatchResult) (uint64, error) {
+	if !strings.HasPrefix(ref, batchRefStep) {
+		return strconv.ParseUint(ref, 10, 64)
+	}
+	result, err := batchStepResult(ref, results)
+	if err != nil {
+		return 0, err
+	}
+	if result.CodeID == 0 {
+		return 0, fmt.Errorf("step %d has no code id", result.Step)
+	}
+	return result.CodeID, nil
+}
+
+// resolveBatchContract returns the contract address for a bech32 address, a step or a label reference
+func resolveBatchContract(ref string, state *types.GenesisState, results []GenesisBatchResult) (string, error) {
+	switch {
+	case strings.HasPrefix(ref, batchRefStep):
+		result, err := batchStepResult(ref, results)
+		if err != nil {
+			return "", err
+		}
+		if result.ContractAddress == "" {
+			return "", fmt.Errorf("step %d has no contract address", result.Step)
+		}
+		return result.ContractAddress, nil
+	case strings.HasPrefix(ref, batchRefLabel):
+		label := strings.TrimPrefix(ref, batchRefLabel)
+		var addr string
+		for _, c := range GetAllContracts(state) {
+			if c.Info.Label != label {
+				continue
+			}
+			if addr != "" {
+				return "", fmt.Errorf("label %q is not unique", label)
+			}
+			addr = c.ContractAddress
+		}
+		if addr == "" {
+			return "", fmt.Errorf("unknown label %q", label)
+		}
+		return addr, nil
+	default:
+		if _, err := sdk.AccAddressFromBech32(ref); err != nil {
+			return "", err
+		}
+		return ref, nil
+	}
+}
+
+func batchStepResult(ref string, results []GenesisBatchResult) (GenesisBatchResult, error) {
+	step, err := strconv.Atoi(strings.TrimPrefix(ref, batchRefStep))
+	if err != nil {
+		return GenesisBatchResult{}, fmt.Errorf("invalid step reference %q", ref)
+	}
+	if step < 1 || step > len(results) {
+		return GenesisBatchResult{}, fmt.Errorf("step reference %q must point to an earlier step", ref)
+	}
+	return results[step-1], nil
+}
+
+// batchMsgJSON returns the JSON bytes for a message given as JSON string or yaml mapping
+func batchMsgJSON(msg interface{}) ([]byte, error) {
+	if s, ok := msg.(string); ok {
+		return []byte(s), nil
+	}
+	bz, err := json.Marshal(yamlToJSONValue(msg))
+	if err != nil {
+		return nil, fmt.Errorf("msg: %w", err)
+	}
+	return bz, nil
+}
+
+// yamlToJSONValue converts the yaml generic maps into values that can be marshalled to JSON
+func yamlToJSONValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, v := range x {
+			m[fmt.Sprint(k)] = yamlToJSONValue(v)
+		}
+		return m
+	case []interface{}:
+		for i := range x {
+			x[i] = yamlToJSONValue(x[i])
+		}
+		return x
+	default:
+		return v
+	}
+}
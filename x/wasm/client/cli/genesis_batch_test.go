@@ -0,0 +1,385 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestGenesisApplyBatchCmd(t *testing.T) {
+	const firstContractAddress = "link14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sgf2vn8"
+	minimalWasmGenesis := types.GenesisState{
+		Params: types.DefaultParams(),
+	}
+
+	specs := map[string]struct {
+		srcGenesis   types.GenesisState
+		batch        string
+		expMsgCount  int
+		expContracts []string
+		expError     bool
+	}{
+		"all good with step and label references": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: counter
+      msg: {"count": 1}
+      no_admin: true
+  - execute:
+      contract: label:counter
+      msg: '{"increment":{}}'
+  - execute:
+      contract: step:2
+      msg: {"increment": {}}
+`,
+			expMsgCount:  4,
+			expContracts: []string{firstContractAddress},
+		},
+		"all good with key name and contract as admin": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + defaultTestKeyName + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+      instantiate_permission:
+        permission: Everybody
+  - instantiate:
+      code_id: "1"
+      label: first
+      msg: {}
+      no_admin: true
+  - instantiate:
+      run_as: ` + myWellFundedAccount + `
+      code_id: step:1
+      label: second
+      msg: {}
+      admin: label:first
+`,
+			expMsgCount: 3,
+		},
+		"all good with existing contract in genesis": {
+			srcGenesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				Codes: []types.Code{
+					{
+						CodeID:    1,
+						CodeInfo:  types.CodeInfoFixture(),
+						CodeBytes: wasmIdent,
+					},
+				},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: firstContractAddress,
+						ContractInfo: types.ContractInfoFixture(func(info *types.ContractInfo) {
+							info.Created = nil
+						}),
+						ContractState: []types.Model{},
+					},
+				},
+			},
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - execute:
+      contract: label:any
+      msg: {}
+`,
+			expMsgCount: 1,
+		},
+		"no steps": {
+			srcGenesis: minimalWasmGenesis,
+			batch:      `run_as: ` + myWellFundedAccount,
+			expError:   true,
+		},
+		"unknown field": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+steps:
+  - store:
+      wasm_file: contract.wasm
+      run_as: ` + myWellFundedAccount + `
+      other: 1
+`,
+			expError: true,
+		},
+		"multiple operations in one step": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+    execute:
+      contract: step:1
+      msg: {}
+`,
+			expError: true,
+		},
+		"no run_as": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+steps:
+  - store:
+      wasm_file: contract.wasm
+`,
+			expError: true,
+		},
+		"unknown wasm file": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: unknown.wasm
+`,
+			expError: true,
+		},
+		"forward step reference": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - instantiate:
+      code_id: step:2
+      label: testing
+      msg: {}
+      no_admin: true
+  - store:
+      wasm_file: contract.wasm
+`,
+			expError: true,
+		},
+		"contract reference to store step": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - execute:
+      contract: step:1
+      msg: {}
+`,
+			expError: true,
+		},
+		"unknown label": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      no_admin: true
+  - execute:
+      contract: label:other
+      msg: {}
+`,
+			expError: true,
+		},
+		"ambiguous label": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      no_admin: true
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      no_admin: true
+  - execute:
+      contract: label:testing
+      msg: {}
+`,
+			expError: true,
+		},
+		"unknown code id": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - instantiate:
+      code_id: "1"
+      label: testing
+      msg: {}
+      no_admin: true
+`,
+			expError: true,
+		},
+		"instantiate without admin": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+`,
+			expError: true,
+		},
+		"instantiate with admin and no_admin": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      admin: ` + myWellFundedAccount + `
+      no_admin: true
+`,
+			expError: true,
+		},
+		"instantiate not permitted": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+      instantiate_permission:
+        permission: Nobody
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      no_admin: true
+`,
+			expError: true,
+		},
+		"invalid json msg": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: 'not json'
+      no_admin: true
+`,
+			expError: true,
+		},
+		"not enough balance": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      no_admin: true
+      funds: 100000000000000000000000000stake
+`,
+			expError: true,
+		},
+		"funds of all steps within balance": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      no_admin: true
+      funds: 5000000000stake
+  - execute:
+      contract: step:2
+      msg: {}
+      funds: 5000000000stake
+`,
+			expMsgCount: 3,
+		},
+		"funds of all steps exceed balance": {
+			srcGenesis: minimalWasmGenesis,
+			batch: `
+run_as: ` + myWellFundedAccount + `
+steps:
+  - store:
+      wasm_file: contract.wasm
+  - instantiate:
+      code_id: step:1
+      label: testing
+      msg: {}
+      no_admin: true
+      funds: 6000000000stake
+  - execute:
+      contract: step:2
+      msg: {}
+      funds: 6000000000stake
+`,
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			homeDir := setupGenesis(t, spec.srcGenesis)
+			batchDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(batchDir, "contract.wasm"), wasmIdent, 0o600))
+			batchFile := filepath.Join(batchDir, "batch.yaml")
+			require.NoError(t, os.WriteFile(batchFile, []byte(spec.batch), 0o600))
+
+			cmd := GenesisApplyBatchCmd(homeDir, NewDefaultGenesisIO())
+			cmd.SetArgs([]string{batchFile})
+
+			// when
+			err := executeCmdWithContext(t, homeDir, cmd)
+			// then
+			moduleState := loadModuleState(t, homeDir)
+			if spec.expError {
+				require.Error(t, err)
+				assert.Len(t, moduleState.GenMsgs, len(spec.srcGenesis.GenMsgs))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, moduleState.GenMsgs, spec.expMsgCount)
+			for i, addr := range spec.expContracts {
+				assert.Equal(t, addr, GetAllContracts(&moduleState)[i].ContractAddress)
+			}
+			for _, m := range moduleState.GenMsgs {
+				if msg := m.GetInstantiateContract(); msg != nil {
+					assert.NoError(t, msg.Msg.ValidateBasic())
+				}
+				if msg := m.GetExecuteContract(); msg != nil {
+					assert.NoError(t, msg.Msg.ValidateBasic())
+				}
+			}
+		})
+	}
+}
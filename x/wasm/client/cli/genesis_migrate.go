@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/codec"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+const flagSourceVersion = "source-version"
+
+// GenesisValidator validates the wasm section of a genesis file
+type GenesisValidator interface {
+	ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error
+}
+
+// GenesisMigrateCmd cli command to convert the wasm section of an exported genesis file between module versions.
+// The migrated genesis is validated when the target is the latest version.
+func GenesisMigrateCmd(validator GenesisValidator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [target-version] [genesis-file]",
+		Short: "Migrate the wasm genesis state to the target module version",
+		Long: fmt.Sprintf(`Migrate the wasm section of an exported genesis file from the source to the target module version
+and print the genesis to STDOUT. Versions are module consensus versions; version %d is the amino JSON genesis
+of the releases before the protobuf migration and version %d is the latest.
+
+Example:
+$ wasmd genesis wasm migrate %d genesis.json --%s=1
+`, types.GenesisVersionLegacy, types.LatestGenesisVersion, types.LatestGenesisVersion, flagSourceVersion),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			target, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("target version: %w", err)
+			}
+			source, err := cmd.Flags().GetUint64(flagSourceVersion)
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var genDoc map[string]json.RawMessage
+			if err := json.Unmarshal(bz, &genDoc); err != nil {
+				return fmt.Errorf("genesis file: %w", err)
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(genDoc["app_state"], &appState); err != nil {
+				return fmt.Errorf("app state: %w", err)
+			}
+			wasmState, ok := appState[types.ModuleName]
+			if !ok {
+				return fmt.Errorf("no %s section in app state", types.ModuleName)
+			}
+
+			wasmState, err = types.MigrateGenesisJSON(wasmState, source, target)
+			if err != nil {
+				return err
+			}
+			if target == types.LatestGenesisVersion {
+				if err := validator.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, wasmState); err != nil {
+					return fmt.Errorf("migrated genesis: %w", err)
+				}
+			}
+
+			appState[types.ModuleName] = wasmState
+			if genDoc["app_state"], err = json.Marshal(appState); err != nil {
+				return err
+			}
+			out, err := json.MarshalIndent(genDoc, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return err
+		},
+	}
+	cmd.Flags().Uint64(flagSourceVersion, 0, "The module version of the source genesis")
+	_ = cmd.MarkFlagRequired(flagSourceVersion)
+	return cmd
+}
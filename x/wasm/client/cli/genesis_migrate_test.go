@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/codec"
+
+	"github.com/Finschia/wasmd/x/wasm/keeper"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+type genesisValidatorFn func(cdc codec.JSONCodec, bz json.RawMessage) error
+
+func (f genesisValidatorFn) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return f(cdc, bz)
+}
+
+func TestGenesisMigrateCmd(t *testing.T) {
+	const sampleGenesis = "../../keeper/testdata/genesis.json"
+	validator := genesisValidatorFn(func(cdc codec.JSONCodec, bz json.RawMessage) error {
+		var state types.GenesisState
+		if err := cdc.UnmarshalJSON(bz, &state); err != nil {
+			return err
+		}
+		return state.ValidateBasic()
+	})
+	invalidGenesis := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(invalidGenesis, []byte(`{"app_state": {"wasm": {"params": {}}}}`), 0o600))
+
+	specs := map[string]struct {
+		args      []string
+		expParams *types.Params
+		expErr    bool
+	}{
+		"legacy to latest": {
+			args:      []string{"2", sampleGenesis, "--source-version=0"},
+			expParams: &types.Params{CodeUploadAccess: types.AllowEverybody, InstantiateDefaultPermission: types.AccessTypeEverybody, BlockHookMaxFailures: types.DefaultBlockHookMaxFailures},
+		},
+		"legacy to version 1": {
+			args:      []string{"1", sampleGenesis, "--source-version=0"},
+			expParams: &types.Params{CodeUploadAccess: types.AllowEverybody, InstantiateDefaultPermission: types.AccessTypeEverybody},
+		},
+		"source version required": {
+			args:   []string{"2", sampleGenesis},
+			expErr: true,
+		},
+		"invalid target version": {
+			args:   []string{"latest", sampleGenesis, "--source-version=0"},
+			expErr: true,
+		},
+		"wrong source version": {
+			args:   []string{"2", sampleGenesis, "--source-version=1"},
+			expErr: true,
+		},
+		"invalid migrated genesis": {
+			args:   []string{"2", invalidGenesis, "--source-version=1"},
+			expErr: true,
+		},
+		"unknown file": {
+			args:   []string{"2", "unknown.json", "--source-version=0"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			appCodec := keeper.MakeEncodingConfig(t).Marshaler
+			clientCtx := client.Context{}.WithCodec(appCodec)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd := GenesisMigrateCmd(validator)
+			cmd.SetArgs(spec.args)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+
+			// when
+			gotErr := cmd.ExecuteContext(ctx)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var genDoc struct {
+				ChainID  string                     `json:"chain_id"`
+				AppState map[string]json.RawMessage `json:"app_state"`
+			}
+			require.NoError(t, json.Unmarshal(out.Bytes(), &genDoc))
+			assert.Equal(t, "testing", genDoc.ChainID)
+			assert.Contains(t, genDoc.AppState, "bank")
+			var state types.GenesisState
+			require.NoError(t, appCodec.UnmarshalJSON(genDoc.AppState[types.ModuleName], &state))
+			assert.Equal(t, spec.expParams.CodeUploadAccess.Permission, state.Params.CodeUploadAccess.Permission)
+			assert.Equal(t, spec.expParams.InstantiateDefaultPermission, state.Params.InstantiateDefaultPermission)
+		})
+	}
+}
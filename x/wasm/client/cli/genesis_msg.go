@@ -496,7 +496,12 @@ func getActorAddress(cmd *cobra.Command) (sdk.AccAddress, error) {
 	if len(actorArg) == 0 {
 		return nil, errors.New("run-as address is required")
 	}
+	return resolveActorAddress(cmd, actorArg)
+}
 
+// resolveActorAddress returns the account address for an address or a key name
+// that is looked up in the keyring.
+func resolveActorAddress(cmd *cobra.Command, actorArg string) (sdk.AccAddress, error) {
 	actorAddr, err := sdk.AccAddressFromBech32(actorArg)
 	if err == nil {
 		return actorAddr, nil
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/Finschia/finschia-sdk/client"
 	"github.com/Finschia/finschia-sdk/client/tx"
@@ -712,3 +713,542 @@ $ %s tx gov submit-proposal update-instantiate-config 1:nobody 2:everybody 3:%s1
 	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
 	return cmd
 }
+
+func ProposalRegisterBlockHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-block-hook [contract_addr_bech32] [begin_block|end_block] [gas_limit]",
+		Short: "Submit a proposal to call a contract with sudo in every block",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			phase, err := parseBlockHookPhase(args[1])
+			if err != nil {
+				return err
+			}
+			gasLimit, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("gas limit: %s", err)
+			}
+
+			content := types.RegisterBlockHookProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				Phase:       phase,
+				GasLimit:    gasLimit,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func ProposalDeregisterBlockHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-block-hook [contract_addr_bech32] [begin_block|end_block]",
+		Short: "Submit a proposal to stop calling a contract with sudo in every block",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			phase, err := parseBlockHookPhase(args[1])
+			if err != nil {
+				return err
+			}
+
+			content := types.DeregisterBlockHookProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				Phase:       phase,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func ProposalSetCodeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-status [code_id_int64] [active|deprecated|revoked]",
+		Short: "Submit a proposal to set the lifecycle status of a code",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			src, err := parseSetCodeStatusArgs(args, clientCtx.GetFromAddress().String(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content := types.SetCodeStatusProposal{
+				Title:              proposalTitle,
+				Description:        proposalDescr,
+				CodeID:             src.CodeID,
+				Status:             src.Status,
+				Reason:             src.Reason,
+				SupersededByCodeID: src.SupersededByCodeID,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addCodeStatusFlags(cmd)
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func ProposalPruneCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-codes [code-ids]",
+		Short: "Submit a proposal to remove unused codes",
+		Long: `Submit a proposal to remove the code infos of codes that are not used by any contract, not pinned, not superseded by
+or listed in the migration policy of another code and not the target of a queued timelocked migration. The byte code is
+not removed from the wasmvm cache of the nodes.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			codeIds, err := parsePinCodesArgs(args)
+			if err != nil {
+				return err
+			}
+
+			content := types.PruneCodesProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				CodeIDs:     codeIds,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func ProposalSetCodeStateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-state-limit [code_id_int64] [max_state_bytes]",
+		Short: "Submit a proposal to override the max contract state bytes for the contracts of a code",
+		Long:  "Submit a proposal to override the max contract state bytes for the contracts of a code. A max of 0 removes the override.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id: %s", err)
+			}
+			maxStateBytes, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("max state bytes: %s", err)
+			}
+
+			content := types.SetCodeStateLimitProposal{
+				Title:         proposalTitle,
+				Description:   proposalDescr,
+				CodeID:        codeID,
+				MaxStateBytes: maxStateBytes,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func parseBlockHookPhase(raw string) (types.BlockHookPhase, error) {
+	switch raw {
+	case "begin_block":
+		return types.BlockHookPhaseBeginBlock, nil
+	case "end_block":
+		return types.BlockHookPhaseEndBlock, nil
+	}
+	return types.BlockHookPhaseUnspecified, fmt.Errorf("unknown block hook phase %q: expected begin_block or end_block", raw)
+}
+
+func ProposalSetMigrationPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-migration-policy [code_id_int64]",
+		Short: "Submit a proposal to set the codes that contracts of a code can be migrated to",
+		Long:  "Submit a proposal to set the codes that contracts of a code can be migrated to by code id or checksum. Without any allowed code the policy is removed and all codes are allowed unless all migrations are denied.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id: %s", err)
+			}
+			policy, err := parseMigrationPolicyFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content := types.SetMigrationPolicyProposal{
+				Title:           proposalTitle,
+				Description:     proposalDescr,
+				CodeID:          codeID,
+				MigrationPolicy: policy,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addMigrationPolicyFlags(cmd)
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func ProposalSetIBCRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ibc-rate-limit [contract_addr_bech32]",
+		Short: "Submit a proposal to override the IBC rate limit for the raw IBC packets that a contract sends",
+		Long: "Submit a proposal to override the IBC rate limit for the raw IBC packets that a contract sends on a channel. " +
+			"Without a channel the override applies to all channels of the contract without an own override. A zero limit is disabled.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			channelID, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return fmt.Errorf("channel: %s", err)
+			}
+			limit, err := parseIBCRateLimitFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content := types.SetIBCRateLimitProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				ChannelID:   channelID,
+				Limit:       limit,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagChannel, "", "IBC channel of the contract. Empty applies to all channels")
+	cmd.Flags().Uint64(flagMaxPacketsPerBlock, 0, "Max number of packets within a block, 0 is unlimited")
+	cmd.Flags().Uint64(flagMaxBytesPerWindow, 0, "Max sum of packet data bytes within a window, 0 is unlimited")
+	cmd.Flags().Uint64(flagWindowBlocks, 0, "Length of a window in blocks")
+	cmd.Flags().Bool(flagRemove, false, "Remove the override so that the param applies again")
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func ProposalSetContractAnteHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-ante-hook [contract_addr_bech32] [authentication|fee_sponsorship]",
+		Short: "Submit a proposal to enable or disable an ante hook for a contract",
+		Long: "Submit a proposal to enable or disable an ante hook for a contract. With the authentication hook, the contract " +
+			"authenticates the txs that are signed on behalf of the contract account. With the fee_sponsorship hook, the contract " +
+			"can sponsor the fees of the txs that set the contract as fee granter.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			hook, err := parseAnteHook(args[1])
+			if err != nil {
+				return err
+			}
+			disable, err := cmd.Flags().GetBool(flagDisable)
+			if err != nil {
+				return fmt.Errorf("disable: %s", err)
+			}
+
+			content := types.SetContractAnteHookProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				Hook:        hook,
+				Enabled:     !disable,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flagDisable, false, "Disable the ante hook for the contract")
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+func parseAnteHook(raw string) (types.AnteHook, error) {
+	switch raw {
+	case "authentication":
+		return types.AnteHookAuthentication, nil
+	case "fee_sponsorship":
+		return types.AnteHookFeeSponsorship, nil
+	}
+	return types.AnteHookUnspecified, fmt.Errorf("unknown ante hook %q: expected authentication or fee_sponsorship", raw)
+}
+
+// parseIBCRateLimitFlags returns nil when the override is removed
+func parseIBCRateLimitFlags(flags *flag.FlagSet) (*types.IBCRateLimit, error) {
+	remove, err := flags.GetBool(flagRemove)
+	if err != nil {
+		return nil, fmt.Errorf("remove: %s", err)
+	}
+	var limit types.IBCRateLimit
+	for _, f := range []struct {
+		name string
+		dst  *uint64
+	}{
+		{flagMaxPacketsPerBlock, &limit.MaxPacketsPerBlock},
+		{flagMaxBytesPerWindow, &limit.MaxBytesPerWindow},
+		{flagWindowBlocks, &limit.WindowBlocks},
+	} {
+		if remove && flags.Changed(f.name) {
+			return nil, fmt.Errorf("flag %s can not be combined with %s", f.name, flagRemove)
+		}
+		if *f.dst, err = flags.GetUint64(f.name); err != nil {
+			return nil, fmt.Errorf("flag %s: %s", f.name, err)
+		}
+	}
+	if remove {
+		return nil, nil
+	}
+	return &limit, nil
+}
@@ -95,3 +95,40 @@ func TestParseAccessConfigUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestParseIBCRateLimitFlags(t *testing.T) {
+	specs := map[string]struct {
+		args     []string
+		expLimit *types.IBCRateLimit
+		expErr   bool
+	}{
+		"all set": {
+			args:     []string{"--max-packets-per-block=1", "--max-bytes-per-window=1024", "--window-blocks=10"},
+			expLimit: &types.IBCRateLimit{MaxPacketsPerBlock: 1, MaxBytesPerWindow: 1024, WindowBlocks: 10},
+		},
+		"not set": {
+			args:     []string{},
+			expLimit: &types.IBCRateLimit{},
+		},
+		"remove": {
+			args: []string{"--remove"},
+		},
+		"remove with limit": {
+			args:   []string{"--remove", "--max-packets-per-block=1"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := ProposalSetIBCRateLimitCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			gotLimit, gotErr := parseIBCRateLimitFlags(flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expLimit, gotLimit)
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/Finschia/finschia-sdk/client"
 	"github.com/Finschia/finschia-sdk/client/flags"
@@ -119,3 +122,402 @@ func ClearContractAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// ProposeContractAdminCmd proposes a new admin that has to accept before it becomes the contract admin
+func ProposeContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-contract-admin [contract_addr_bech32] [new_admin_addr_bech32]",
+		Short:   "Propose a new admin for a contract that has to accept the role",
+		Aliases: []string{"propose-admin"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseProposeContractAdminArgs(args, clientCtx.GetFromAddress().String(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagExpiresAtHeight, 0, "Block height at which the proposal expires, 0 for no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseProposeContractAdminArgs(args []string, sender string, flags *flag.FlagSet) (types.MsgProposeAdmin, error) {
+	expiresAtHeight, err := flags.GetUint64(flagExpiresAtHeight)
+	if err != nil {
+		return types.MsgProposeAdmin{}, fmt.Errorf("expires at height: %s", err)
+	}
+	return types.MsgProposeAdmin{
+		Sender:          sender,
+		Contract:        args[0],
+		NewAdmin:        args[1],
+		ExpiresAtHeight: expiresAtHeight,
+	}, nil
+}
+
+// AcceptContractAdminCmd accepts the admin role proposed to the sender
+func AcceptContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-contract-admin [contract_addr_bech32]",
+		Short:   "Accept the admin role for a contract that was proposed to the sender",
+		Aliases: []string{"accept-admin"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelContractAdminProposalCmd removes the pending admin of a contract
+func CancelContractAdminProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-contract-admin-proposal [contract_addr_bech32]",
+		Short:   "Cancel a pending admin proposal for a contract",
+		Aliases: []string{"cancel-admin-proposal"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelAdminProposal{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetContractTimelockCmd sets the number of blocks that migrations and admin changes of a contract are queued
+func SetContractTimelockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-timelock [contract_addr_bech32] [delay_blocks]",
+		Short: "Set the number of blocks that migrations and admin changes of a contract are queued",
+		Long: `Set the number of blocks that migrations and admin changes of a contract are queued before they are executed.
+A higher delay applies immediately while a lower delay is queued itself. Zero disables the timelock.`,
+		Aliases: []string{"set-timelock"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delayBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "delay blocks")
+			}
+			msg := types.MsgSetContractTimelock{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Contract:    args[0],
+				DelayBlocks: delayBlocks,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelTimelockedOperationCmd removes a queued admin operation of a contract
+func CancelTimelockedOperationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-timelocked-operation [contract_addr_bech32] [operation_id]",
+		Short:   "Cancel a queued admin operation of a timelocked contract",
+		Aliases: []string{"cancel-operation"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operationID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "operation id")
+			}
+			msg := types.MsgCancelTimelockedOperation{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Contract:    args[0],
+				OperationID: operationID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MakeContractImmutableCmd permanently disables migrations and admin changes of a contract
+func MakeContractImmutableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-contract-immutable [contract_addr_bech32]",
+		Short: "Permanently disable migrations and admin changes of a contract. Only the admin can execute it",
+		Long: `Permanently disable migrations and admin changes of a contract. The admin is removed together with any proposed
+admin and queued timelocked operations. This can not be undone, not even by governance.`,
+		Aliases: []string{"freeze"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgMakeContractImmutable{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetCodeStatusCmd updates the lifecycle status of a code
+func SetCodeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-code-status [code_id_int64] [active|deprecated|revoked]",
+		Short:   "Set the lifecycle status of a code. Only the code creator can execute it",
+		Long:    "Set the lifecycle status of a code. Deprecated and revoked codes can not be instantiated or migrated to. A revoked code can only be changed by governance.",
+		Aliases: []string{"code-status"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseSetCodeStatusArgs(args, clientCtx.GetFromAddress().String(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addCodeStatusFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseSetCodeStatusArgs(args []string, sender string, flags *flag.FlagSet) (types.MsgSetCodeStatus, error) {
+	codeID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return types.MsgSetCodeStatus{}, sdkerrors.Wrap(err, "code id")
+	}
+	status, err := parseCodeStatus(args[1])
+	if err != nil {
+		return types.MsgSetCodeStatus{}, err
+	}
+	reason, err := flags.GetString(flagStatusReason)
+	if err != nil {
+		return types.MsgSetCodeStatus{}, fmt.Errorf("reason: %s", err)
+	}
+	supersededBy, err := flags.GetUint64(flagSupersededBy)
+	if err != nil {
+		return types.MsgSetCodeStatus{}, fmt.Errorf("superseded by: %s", err)
+	}
+	return types.MsgSetCodeStatus{
+		Sender:             sender,
+		CodeID:             codeID,
+		Status:             status,
+		Reason:             reason,
+		SupersededByCodeID: supersededBy,
+	}, nil
+}
+
+func addCodeStatusFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagStatusReason, "", "Human readable reason for the status")
+	cmd.Flags().Uint64(flagSupersededBy, 0, "Code id that replaces a deprecated or revoked code")
+}
+
+func parseCodeStatus(raw string) (types.CodeStatus, error) {
+	switch raw {
+	case "active":
+		return types.CodeStatusActive, nil
+	case "deprecated":
+		return types.CodeStatusDeprecated, nil
+	case "revoked":
+		return types.CodeStatusRevoked, nil
+	}
+	return types.CodeStatusActive, fmt.Errorf("unknown code status %q: expected active, deprecated or revoked", raw)
+}
+
+// PruneCodesCmd removes codes that are not used by any contract and not pinned
+func PruneCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-codes [code_id_int64]...",
+		Short: "Remove unused codes. Only the code creator can execute it",
+		Long: `Remove the code infos of codes that are not used by any contract, not pinned, not superseded by or listed in the
+migration policy of another code and not the target of a queued timelocked migration. The byte code is not removed from
+the wasmvm cache of the nodes. Only the code creator can execute it.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIDs, err := parsePinCodesArgs(args)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgPruneCodes{
+				Sender:  clientCtx.GetFromAddress().String(),
+				CodeIDs: codeIDs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetMigrationPolicyCmd updates the codes that contracts of a code can be migrated to
+func SetMigrationPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-migration-policy [code_id_int64]",
+		Short: "Set the codes that contracts of a code can be migrated to. Only the code creator can execute it",
+		Long:  "Set the codes that contracts of a code can be migrated to by code id or checksum. Without any allowed code the policy is removed and all codes are allowed unless all migrations are denied.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			policy, err := parseMigrationPolicyFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgSetMigrationPolicy{
+				Sender:          clientCtx.GetFromAddress().String(),
+				CodeID:          codeID,
+				MigrationPolicy: policy,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addMigrationPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addMigrationPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().UintSlice(flagAllowedCodeIDs, []uint{}, "Code ids that contracts can be migrated to")
+	cmd.Flags().StringSlice(flagAllowedChecksums, []string{}, "Hex encoded checksums of codes that contracts can be migrated to")
+	cmd.Flags().Bool(flagDenyAllMigrations, false, "Deny the migration of contracts to any code")
+}
+
+func parseMigrationPolicyFlags(flags *flag.FlagSet) (types.MigrationPolicy, error) {
+	var policy types.MigrationPolicy
+	codeIDs, err := flags.GetUintSlice(flagAllowedCodeIDs)
+	if err != nil {
+		return policy, fmt.Errorf("allowed code ids: %s", err)
+	}
+	for _, id := range codeIDs {
+		policy.CodeIDs = append(policy.CodeIDs, uint64(id))
+	}
+	checksums, err := flags.GetStringSlice(flagAllowedChecksums)
+	if err != nil {
+		return policy, fmt.Errorf("allowed checksums: %s", err)
+	}
+	for _, c := range checksums {
+		checksum, err := hex.DecodeString(c)
+		if err != nil {
+			return policy, sdkerrors.Wrap(err, "checksum")
+		}
+		policy.Checksums = append(policy.Checksums, checksum)
+	}
+	if policy.DenyAll, err = flags.GetBool(flagDenyAllMigrations); err != nil {
+		return policy, fmt.Errorf("deny all migrations: %s", err)
+	}
+	return policy, nil
+}
+
+// VerifyCodeCmd attests that a code matches a reproducible build of its build metadata
+func VerifyCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-code [code_id_int64] [checksum_hex]",
+		Short: "Attest that the code checksum matches a reproducible build of its build metadata. Only allowlisted code verifiers can execute it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			checksum, err := hex.DecodeString(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "checksum")
+			}
+			msg := types.MsgVerifyCode{
+				Sender:   clientCtx.GetFromAddress().String(),
+				CodeID:   codeID,
+				Checksum: checksum,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -42,6 +42,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdLibVersion(),
 		GetCmdQueryParams(),
 		GetCmdBuildAddress(),
+		GetCmdGetIBCPacketUsage(),
+		GetCmdListBlockHooks(),
+		GetCmdGetStorageDeposit(),
+		GetCmdGetPendingAdmin(),
+		GetCmdGetContractTimelock(),
 	)
 	return queryCmd
 }
@@ -70,8 +75,10 @@ func GetCmdLibVersion() *cobra.Command {
 func GetCmdBuildAddress() *cobra.Command {
 	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
-		Use:     "build-address [code-hash] [creator-address] [salt-hex-encoded] [json_encoded_init_args (required when set as fixed)]",
-		Short:   "build contract address",
+		Use:   "build-address [code-hash] [creator-address] [salt-hex-encoded] [json_encoded_init_args (required when set as fixed)]",
+		Short: "build contract address",
+		Long: `Build the predictable address of a contract. The address is built offline with the default address generator.
+When '--address-generator' is set, the address is built by the node with the named address generator that is registered on the chain.`,
 		Aliases: []string{"address"},
 		Args:    cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -90,20 +97,53 @@ func GetCmdBuildAddress() *cobra.Command {
 			case len(salt) == 0:
 				return errors.New("empty salt")
 			}
-
-			if len(args) == 3 {
-				cmd.Println(keeper.BuildContractAddressPredictable(codeHash, creator, salt, []byte{}).String())
+			var msg types.RawContractMessage
+			if len(args) == 4 {
+				msg = types.RawContractMessage(args[3])
+				if err := msg.ValidateBasic(); err != nil {
+					return fmt.Errorf("init message: %s", err)
+				}
+			}
+			addressGenerator, err := cmd.Flags().GetString(flagAddressGenerator)
+			if err != nil {
+				return fmt.Errorf("address generator: %s", err)
+			}
+			if addressGenerator == "" {
+				if msg == nil {
+					msg = []byte{}
+				}
+				cmd.Println(keeper.BuildContractAddressPredictable(codeHash, creator, salt, msg).String())
 				return nil
 			}
-			msg := types.RawContractMessage(args[3])
-			if err := msg.ValidateBasic(); err != nil {
-				return fmt.Errorf("init message: %s", err)
+			if err := types.ValidateAddressGeneratorName(addressGenerator); err != nil {
+				return fmt.Errorf("address generator: %s", err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BuildAddress(
+				context.Background(),
+				&types.QueryBuildAddressRequest{
+					CodeHash:         args[0],
+					CreatorAddress:   args[1],
+					Salt:             args[2],
+					InitArgs:         msg,
+					AddressGenerator: addressGenerator,
+				},
+			)
+			if err != nil {
+				return err
 			}
-			cmd.Println(keeper.BuildContractAddressPredictable(codeHash, creator, salt, msg).String())
+			cmd.Println(res.Address)
 			return nil
 		},
 	}
+	cmd.Flags().String(flagAddressGenerator, "", "An optional name of a registered address generator; the address is built by the node when set")
 	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -304,6 +344,151 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetIBCPacketUsage gets the raw IBC packet quota consumed by a contract on a channel
+func GetCmdGetIBCPacketUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ibc-packet-usage [bech32_address] [channel_id]",
+		Short:   "Prints out the IBC rate limit and the packet quota consumed by a contract on a channel",
+		Long:    "Prints out the IBC rate limit and the packet quota consumed by a contract on a channel",
+		Aliases: []string{"ibc-usage"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IBCPacketUsage(
+				context.Background(),
+				&types.QueryIBCPacketUsageRequest{
+					Address:   args[0],
+					ChannelId: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetStorageDeposit gets the deposit that a contract holds for its state
+func GetCmdGetStorageDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-deposit [bech32_address]",
+		Short: "Prints out the storage deposit and the state size of a contract given its address",
+		Long:  "Prints out the storage deposit and the state size of a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StorageDeposit(
+				context.Background(),
+				&types.QueryStorageDepositRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetPendingAdmin gets the admin proposed for a contract
+func GetCmdGetPendingAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-admin [bech32_address]",
+		Short: "Prints out the admin proposed for a contract given its address",
+		Long:  "Prints out the admin proposed for a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingAdmin(
+				context.Background(),
+				&types.QueryPendingAdminRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetContractTimelock gets the timelock and the queued admin operations of a contract
+func GetCmdGetContractTimelock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-timelock [bech32_address]",
+		Short: "Prints out the timelock and the queued admin operations of a contract given its address",
+		Long:  "Prints out the timelock and the queued admin operations of a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractTimelock(
+				context.Background(),
+				&types.QueryContractTimelockRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "timelocked operations")
+	return cmd
+}
+
 // GetCmdGetContractState dumps full internal state of a given contract
 func GetCmdGetContractState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -318,6 +503,7 @@ func GetCmdGetContractState() *cobra.Command {
 		GetCmdGetContractStateAll(),
 		GetCmdGetContractStateRaw(),
 		GetCmdGetContractStateSmart(),
+		GetCmdGetContractStateSize(),
 	)
 	return cmd
 }
@@ -451,6 +637,41 @@ func GetCmdGetContractStateSmart() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStateSize prints the number of keys and bytes in the state of a contract
+func GetCmdGetContractStateSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "size [bech32_address]",
+		Short: "Prints out the number of keys and bytes in the state of a contract given its address",
+		Long:  "Prints out the number of keys and bytes in the state of a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStateSize(
+				context.Background(),
+				&types.QueryContractStateSizeRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractHistory prints the code history for a given contract
 func GetCmdGetContractHistory() *cobra.Command {
 	cmd := &cobra.Command{
@@ -530,6 +751,41 @@ func GetCmdListPinnedCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListBlockHooks lists the contracts that are called with sudo in every block
+func GetCmdListBlockHooks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-hooks",
+		Short: "List all contracts that are called in begin or end block",
+		Long:  "List all contracts that are called with sudo in begin or end block",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockHooks(
+				context.Background(),
+				&types.QueryBlockHooksRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list block hooks")
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
@@ -297,6 +297,115 @@ func TestGetCmdGetContractStateRaw(t *testing.T) {
 	}
 }
 
+func TestGetCmdGetContractStateSize(t *testing.T) {
+	res := types.QueryContractStateSizeResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	tests := testcase{
+		{"execute success", nil, ctx, nil, argsWithAddr},
+		{"bad status", badStatusError, ctx, nil, argsWithAddr},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, argsWithAddr},
+		{"invalid address", invalidAddrError, ctx, nil, []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdGetContractStateSize()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdGetContractStateSize()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdGetContractStateSize()")
+			}
+		})
+	}
+}
+
+func TestGetCmdGetStorageDeposit(t *testing.T) {
+	res := types.QueryStorageDepositResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	tests := testcase{
+		{"execute success", nil, ctx, nil, argsWithAddr},
+		{"bad status", badStatusError, ctx, nil, argsWithAddr},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, argsWithAddr},
+		{"invalid address", invalidAddrError, ctx, nil, []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdGetStorageDeposit()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdGetStorageDeposit()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdGetStorageDeposit()")
+			}
+		})
+	}
+}
+
+func TestGetCmdGetPendingAdmin(t *testing.T) {
+	res := types.QueryPendingAdminResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	tests := testcase{
+		{"execute success", nil, ctx, nil, argsWithAddr},
+		{"bad status", badStatusError, ctx, nil, argsWithAddr},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, argsWithAddr},
+		{"invalid address", invalidAddrError, ctx, nil, []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdGetPendingAdmin()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdGetPendingAdmin()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdGetPendingAdmin()")
+			}
+		})
+	}
+}
+
+func TestGetCmdGetContractTimelock(t *testing.T) {
+	res := types.QueryContractTimelockResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	tests := testcase{
+		{"execute success", nil, ctx, nil, argsWithAddr},
+		{"bad status", badStatusError, ctx, nil, argsWithAddr},
+		{"invalid request", invalidRequestError, ctx, invalidRequestFlags, argsWithAddr},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, argsWithAddr},
+		{"invalid address", invalidAddrError, ctx, nil, []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdGetContractTimelock()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdGetContractTimelock()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdGetContractTimelock()")
+			}
+		})
+	}
+}
+
 func TestGetCmdGetContractStateSmart(t *testing.T) {
 	res := types.QueryRawContractStateResponse{}
 	bz, err := res.Marshal()
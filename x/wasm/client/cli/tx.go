@@ -27,12 +27,30 @@ const (
 	flagAdmin                     = "admin"
 	flagNoAdmin                   = "no-admin"
 	flagFixMsg                    = "fix-msg"
+	flagAddressGenerator          = "address-generator"
 	flagRunAs                     = "run-as"
 	flagInstantiateByEverybody    = "instantiate-everybody"
 	flagInstantiateNobody         = "instantiate-nobody"
 	flagInstantiateByAddress      = "instantiate-only-address"
 	flagInstantiateByAnyOfAddress = "instantiate-anyof-addresses"
 	flagUnpinCode                 = "unpin-code"
+	flagStatusReason              = "reason"
+	flagSupersededBy              = "superseded-by"
+	flagAllowedCodeIDs            = "allowed-code-ids"
+	flagAllowedChecksums          = "allowed-checksums"
+	flagDenyAllMigrations         = "deny-all-migrations"
+	flagExpiresAtHeight           = "expires-at-height"
+	flagImmutable                 = "immutable"
+	flagBuildSource               = "build-source"
+	flagBuildCommit               = "build-commit"
+	flagBuilder                   = "builder"
+	flagOptimizerVersion          = "optimizer-version"
+	flagChannel                   = "channel"
+	flagMaxPacketsPerBlock        = "max-packets-per-block"
+	flagMaxBytesPerWindow         = "max-bytes-per-window"
+	flagWindowBlocks              = "window-blocks"
+	flagRemove                    = "remove"
+	flagDisable                   = "disable"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -52,6 +70,16 @@ func GetTxCmd() *cobra.Command {
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		ProposeContractAdminCmd(),
+		AcceptContractAdminCmd(),
+		CancelContractAdminProposalCmd(),
+		SetContractTimelockCmd(),
+		CancelTimelockedOperationCmd(),
+		MakeContractImmutableCmd(),
+		SetCodeStatusCmd(),
+		PruneCodesCmd(),
+		SetMigrationPolicyCmd(),
+		VerifyCodeCmd(),
 	)
 	return txCmd
 }
@@ -72,6 +100,9 @@ func StoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if msg.BuildMetadata, err = parseBuildMetadataFlags(cmd.Flags()); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -83,6 +114,10 @@ func StoreCodeCmd() *cobra.Command {
 	cmd.Flags().String(flagInstantiateNobody, "", "Nobody except the governance process can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Deprecated: Only this address can instantiate a contract from the code, optional")
 	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
+	cmd.Flags().String(flagBuildSource, "", "URL of the source repository the code was built from, optional")
+	cmd.Flags().String(flagBuildCommit, "", "Commit of the source the code was built from, required with the build source")
+	cmd.Flags().String(flagBuilder, "", "Docker image of the builder, required with the build source")
+	cmd.Flags().String(flagOptimizerVersion, "", "Version of the wasm optimizer, optional")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -117,6 +152,30 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 	return msg, nil
 }
 
+// parseBuildMetadataFlags returns nil when none of the build metadata flags is set
+func parseBuildMetadataFlags(flags *flag.FlagSet) (*types.CodeBuildMetadata, error) {
+	var metadata types.CodeBuildMetadata
+	for _, f := range []struct {
+		name string
+		dst  *string
+	}{
+		{flagBuildSource, &metadata.Source},
+		{flagBuildCommit, &metadata.Commit},
+		{flagBuilder, &metadata.Builder},
+		{flagOptimizerVersion, &metadata.OptimizerVersion},
+	} {
+		v, err := flags.GetString(f.name)
+		if err != nil {
+			return nil, fmt.Errorf("flag %s: %s", f.name, err)
+		}
+		*f.dst = v
+	}
+	if metadata.Equal(types.CodeBuildMetadata{}) {
+		return nil, nil
+	}
+	return &metadata, nil
+}
+
 func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
 	addrs, err := flags.GetStringSlice(flagInstantiateByAnyOfAddress)
 	if err != nil {
@@ -198,6 +257,9 @@ $ %s wasmd tx wasm instantiate 1 '{"foo":"bar"}' --admin="$(%s keys show mykey -
 			if err != nil {
 				return err
 			}
+			if msg.Immutable, err = cmd.Flags().GetBool(flagImmutable); err != nil {
+				return fmt.Errorf("immutable: %w", err)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -209,6 +271,7 @@ $ %s wasmd tx wasm instantiate 1 '{"foo":"bar"}' --admin="$(%s keys show mykey -
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Address of an admin")
 	cmd.Flags().Bool(flagNoAdmin, false, "You must set this explicitly if you don't want an admin")
+	cmd.Flags().Bool(flagImmutable, false, "Make the contract immutable right after instantiation. Requires --no-admin")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -218,11 +281,12 @@ func InstantiateContract2Cmd() *cobra.Command {
 	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
 		Use: "instantiate2 [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] --amount [coins,optional] " +
-			"--fix-msg [bool,optional]",
+			"--fix-msg [bool,optional] --address-generator [name,optional]",
 		Short: "Instantiate a wasm contract with predictable address",
 		Long: fmt.Sprintf(`Creates a new instance of an uploaded wasm code with the given 'constructor' message.
 Each contract instance has a unique address assigned. They are assigned automatically but in order to have predictable addresses 
 for special use cases, the given 'salt' argument and '--fix-msg' parameters can be used to generate a custom address.
+The '--address-generator' parameter selects an address generator that is registered on the chain instead of the default one.
 
 Predictable address example (also see '%s query wasm build-address -h'):
 $ %s wasmd tx wasm instantiate2 1 '{"foo":"bar"}' $(echo -n "testing" | xxd -ps) --admin="$(%s keys show mykey -a)" \
@@ -244,19 +308,29 @@ $ %s wasmd tx wasm instantiate2 1 '{"foo":"bar"}' $(echo -n "testing" | xxd -ps)
 			if err != nil {
 				return fmt.Errorf("fix msg: %w", err)
 			}
+			addressGenerator, err := cmd.Flags().GetString(flagAddressGenerator)
+			if err != nil {
+				return fmt.Errorf("address generator: %w", err)
+			}
+			immutable, err := cmd.Flags().GetBool(flagImmutable)
+			if err != nil {
+				return fmt.Errorf("immutable: %w", err)
+			}
 			data, err := parseInstantiateArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
 			if err != nil {
 				return err
 			}
 			msg := &types.MsgInstantiateContract2{
-				Sender: data.Sender,
-				Admin:  data.Admin,
-				CodeID: data.CodeID,
-				Label:  data.Label,
-				Msg:    data.Msg,
-				Funds:  data.Funds,
-				Salt:   salt,
-				FixMsg: fixMsg,
+				Sender:           data.Sender,
+				Admin:            data.Admin,
+				CodeID:           data.CodeID,
+				Label:            data.Label,
+				Msg:              data.Msg,
+				Funds:            data.Funds,
+				Salt:             salt,
+				FixMsg:           fixMsg,
+				AddressGenerator: addressGenerator,
+				Immutable:        immutable,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -269,7 +343,9 @@ $ %s wasmd tx wasm instantiate2 1 '{"foo":"bar"}' $(echo -n "testing" | xxd -ps)
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Address of an admin")
 	cmd.Flags().Bool(flagNoAdmin, false, "You must set this explicitly if you don't want an admin")
+	cmd.Flags().Bool(flagImmutable, false, "Make the contract immutable right after instantiation. Requires --no-admin")
 	cmd.Flags().Bool(flagFixMsg, false, "An optional flag to include the json_encoded_init_args for the predictable address generation mode")
+	cmd.Flags().String(flagAddressGenerator, "", "An optional name of a registered address generator for the predictable address generation mode")
 	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -57,3 +57,36 @@ func TestParseAccessConfigFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBuildMetadataFlags(t *testing.T) {
+	specs := map[string]struct {
+		args        []string
+		expMetadata *types.CodeBuildMetadata
+	}{
+		"all set": {
+			args: []string{"--build-source=https://github.com/Finschia/wasmd", "--build-commit=1234abcd", "--builder=cosmwasm/rust-optimizer:0.12.13", "--optimizer-version=0.12.13"},
+			expMetadata: &types.CodeBuildMetadata{
+				Source:           "https://github.com/Finschia/wasmd",
+				Commit:           "1234abcd",
+				Builder:          "cosmwasm/rust-optimizer:0.12.13",
+				OptimizerVersion: "0.12.13",
+			},
+		},
+		"source only": {
+			args:        []string{"--build-source=https://github.com/Finschia/wasmd"},
+			expMetadata: &types.CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd"},
+		},
+		"not set": {
+			args: []string{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := StoreCodeCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			gotMetadata, gotErr := parseBuildMetadataFlags(flags)
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMetadata, gotMetadata)
+		})
+	}
+}
@@ -18,4 +18,12 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalPinCodesCmd),
 	govclient.NewProposalHandler(cli.ProposalUnpinCodesCmd),
 	govclient.NewProposalHandler(cli.ProposalUpdateInstantiateConfigCmd),
+	govclient.NewProposalHandler(cli.ProposalRegisterBlockHookCmd),
+	govclient.NewProposalHandler(cli.ProposalDeregisterBlockHookCmd),
+	govclient.NewProposalHandler(cli.ProposalSetCodeStatusCmd),
+	govclient.NewProposalHandler(cli.ProposalPruneCodesCmd),
+	govclient.NewProposalHandler(cli.ProposalSetCodeStateLimitCmd),
+	govclient.NewProposalHandler(cli.ProposalSetMigrationPolicyCmd),
+	govclient.NewProposalHandler(cli.ProposalSetIBCRateLimitCmd),
+	govclient.NewProposalHandler(cli.ProposalSetContractAnteHookCmd),
 }
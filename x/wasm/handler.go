@@ -39,6 +39,26 @@ func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 			res, err = msgServer.UpdateAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *MsgClearAdmin:
 			res, err = msgServer.ClearAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgProposeAdmin:
+			res, err = msgServer.ProposeAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgAcceptAdmin:
+			res, err = msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgCancelAdminProposal:
+			res, err = msgServer.CancelAdminProposal(sdk.WrapSDKContext(ctx), msg)
+		case *MsgSetContractTimelock:
+			res, err = msgServer.SetContractTimelock(sdk.WrapSDKContext(ctx), msg)
+		case *MsgCancelTimelockedOperation:
+			res, err = msgServer.CancelTimelockedOperation(sdk.WrapSDKContext(ctx), msg)
+		case *MsgMakeContractImmutable:
+			res, err = msgServer.MakeContractImmutable(sdk.WrapSDKContext(ctx), msg)
+		case *MsgVerifyCode:
+			res, err = msgServer.VerifyCode(sdk.WrapSDKContext(ctx), msg)
+		case *MsgSetCodeStatus:
+			res, err = msgServer.SetCodeStatus(sdk.WrapSDKContext(ctx), msg)
+		case *MsgPruneCodes:
+			res, err = msgServer.PruneCodes(sdk.WrapSDKContext(ctx), msg)
+		case *MsgSetMigrationPolicy:
+			res, err = msgServer.SetMigrationPolicy(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -69,6 +69,25 @@ func TestUncompress(t *testing.T) {
 	}
 }
 
+func FuzzUncompress(f *testing.F) {
+	f.Add(asGzip([]byte("hello world")), uint16(100))
+	f.Add(asGzip([]byte("hello world")), uint16(5))
+	f.Add(asGzip(bytes.Repeat([]byte{0}, 1<<12)), uint16(1<<10))
+	f.Add([]byte{0x1f, 0x8b, 0x08}, uint16(100))
+	f.Add([]byte("not gzip"), uint16(100))
+	f.Fuzz(func(t *testing.T, src []byte, limit uint16) {
+		got, err := Uncompress(src, uint64(limit))
+		if err != nil {
+			return
+		}
+		require.LessOrEqual(t, len(got), int(limit))
+		// all data that was unpacked must survive a round trip
+		roundTrip, err := Uncompress(asGzip(got), uint64(len(got)+1<<10))
+		require.NoError(t, err)
+		require.Equal(t, got, roundTrip)
+	})
+}
+
 func asGzip(src []byte) []byte {
 	var buf bytes.Buffer
 	zipper := gzip.NewWriter(&buf)
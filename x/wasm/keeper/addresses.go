@@ -23,11 +23,52 @@ func (k Keeper) ClassicAddressGenerator() AddressGenerator {
 
 // PredicableAddressGenerator generates a predictable contract address
 func PredicableAddressGenerator(creator sdk.AccAddress, salt []byte, msg []byte, fixMsg bool) AddressGenerator {
+	return predictableAddressGenerator(BuildContractAddressPredictable, creator, salt, msg, fixMsg)
+}
+
+// PredictableAddressBuilder builds a predictable contract address from the instantiate2 input.
+// The initMsg is empty when it should not be included in the address.
+type PredictableAddressBuilder func(checksum []byte, creator sdk.AccAddress, salt, initMsg types.RawContractMessage) sdk.AccAddress
+
+// NamedPredictableAddressGenerator generates a predictable contract address with the address builder
+// that is registered for the given name. The default builder is used for an empty name.
+func (k Keeper) NamedPredictableAddressGenerator(name string, creator sdk.AccAddress, salt []byte, msg []byte, fixMsg bool) (AddressGenerator, error) {
+	builder, err := k.PredictableAddressBuilder(name)
+	if err != nil {
+		return nil, err
+	}
+	return predictableAddressGenerator(builder, creator, salt, msg, fixMsg), nil
+}
+
+// PredictableAddressBuilder returns the address builder that is registered for the given name.
+// The default builder is returned for an empty name.
+func (k Keeper) PredictableAddressBuilder(name string) (PredictableAddressBuilder, error) {
+	if name == "" {
+		name = types.DefaultAddressGenerator
+	}
+	builder, ok := k.addressBuilders[name]
+	if !ok {
+		return nil, types.ErrNotFound.Wrapf("address generator %q", name)
+	}
+	return builder, nil
+}
+
+// BuildPredictableAddress builds a predictable contract address with the address builder that is registered for the
+// given name. The default builder is used for an empty name.
+func (k Keeper) BuildPredictableAddress(name string, checksum []byte, creator sdk.AccAddress, salt, initMsg []byte) (sdk.AccAddress, error) {
+	builder, err := k.PredictableAddressBuilder(name)
+	if err != nil {
+		return nil, err
+	}
+	return builder(checksum, creator, salt, initMsg), nil
+}
+
+func predictableAddressGenerator(builder PredictableAddressBuilder, creator sdk.AccAddress, salt []byte, msg []byte, fixMsg bool) AddressGenerator {
 	return func(ctx sdk.Context, _ uint64, checksum []byte) sdk.AccAddress {
 		if !fixMsg { // clear msg to not be included in the address generation
 			msg = []byte{}
 		}
-		return BuildContractAddressPredictable(checksum, creator, salt, msg)
+		return builder(checksum, creator, salt, msg)
 	}
 }
 
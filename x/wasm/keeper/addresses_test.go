@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -9,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
 func TestBuildContractAddress(t *testing.T) {
@@ -44,6 +47,29 @@ func TestBuildContractAddress(t *testing.T) {
 	}
 }
 
+func FuzzBuildContractAddressPredictable(f *testing.F) {
+	f.Add(bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 20), []byte("salt"), []byte(`{"foo":"bar"}`))
+	f.Add([]byte{}, []byte{}, []byte{}, []byte{})
+	f.Fuzz(func(t *testing.T, checksum, creator, salt, msg []byte) {
+		initMsg := types.RawContractMessage(msg)
+		// invalid values are rejected by the callers before an address is built
+		if len(checksum) != 32 || sdk.VerifyAddressFormat(creator) != nil || types.ValidateSalt(salt) != nil ||
+			(len(initMsg) != 0 && initMsg.ValidateBasic() != nil) {
+			return
+		}
+		gotAddr := BuildContractAddressPredictable(checksum, creator, salt, initMsg)
+		require.Len(t, gotAddr, types.ContractAddrLen)
+		require.Equal(t, gotAddr, BuildContractAddressPredictable(checksum, creator, salt, initMsg))
+
+		// the elements are length prefixed so moving a byte from the salt to the creator results in a new address
+		movedCreator := append(append([]byte{}, creator...), salt[0])
+		if len(salt) == 1 || sdk.VerifyAddressFormat(movedCreator) != nil {
+			return
+		}
+		require.NotEqual(t, gotAddr, BuildContractAddressPredictable(checksum, movedCreator, salt[1:], initMsg))
+	})
+}
+
 const goldenMasterPredictableContractAddr = `[
   {
     "in": {
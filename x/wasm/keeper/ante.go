@@ -2,8 +2,16 @@ package keeper
 
 import (
 	"encoding/binary"
+	"encoding/json"
+
+	"github.com/gogo/protobuf/proto"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/types/tx/signing"
+	authsigning "github.com/Finschia/finschia-sdk/x/auth/signing"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
 
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
@@ -54,6 +62,355 @@ func decodeHeightCounter(bz []byte) (int64, uint32) {
 	return int64(sdk.BigEndianToUint64(bz[0:8])), binary.BigEndian.Uint32(bz[8:])
 }
 
+// DefaultContractAuthGasLimit is the max gas a contract can consume to authenticate a tx
+const DefaultContractAuthGasLimit sdk.Gas = 200_000
+
+// ContractAuthSudoMsg is the sudo message that is sent to a contract to authenticate a tx that was signed on behalf
+// of the contract account.
+type ContractAuthSudoMsg struct {
+	Authenticate *AuthenticateMsg `json:"authenticate,omitempty"`
+}
+
+// AuthenticateMsg contains the data a contract needs to verify the signatures of a tx
+type AuthenticateMsg struct {
+	// SignBytes are the bytes that were signed with the sign mode of the signatures
+	SignBytes []byte `json:"sign_bytes"`
+	// Signatures are the raw signatures that were provided for the contract account. A multisig signature
+	// is flattened into its single signatures.
+	Signatures [][]byte `json:"signatures"`
+}
+
+// ContractAuthKeeper defines the subset of the wasm keeper that is required to authenticate contract signers
+type ContractAuthKeeper interface {
+	ContractSudoer
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	HasContractAnteHook(ctx sdk.Context, contractAddress sdk.AccAddress, hook types.AnteHook) bool
+}
+
+// ContractAuthAccountKeeper defines the subset of the account keeper that is required to authenticate contract signers
+type ContractAuthAccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// ContractAuthDecorator ante decorator to authenticate txs that are signed on behalf of contract accounts.
+// Contracts have no public key so that the signatures are verified by the contracts themselves. This enables
+// smart contract wallets with custom authentication like multisig or social recovery.
+// Only contracts that the authentication ante hook is enabled for by governance can authenticate txs.
+type ContractAuthDecorator struct {
+	keeper          ContractAuthKeeper
+	ak              ContractAuthAccountKeeper
+	signModeHandler authsigning.SignModeHandler
+	gasLimit        sdk.Gas
+	sigHandler      sdk.AnteHandler
+}
+
+// NewContractAuthDecorator constructor. The given signature decorators are applied to all txs that are not signed by
+// contracts and should contain the SDK public key and signature verification decorators.
+func NewContractAuthDecorator(
+	keeper ContractAuthKeeper,
+	ak ContractAuthAccountKeeper,
+	signModeHandler authsigning.SignModeHandler,
+	gasLimit sdk.Gas,
+	sigDecorators ...sdk.AnteDecorator,
+) *ContractAuthDecorator {
+	if gasLimit == 0 {
+		panic("gas limit must not be zero")
+	}
+	return &ContractAuthDecorator{
+		keeper:          keeper,
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		gasLimit:        gasLimit,
+		sigHandler:      sdk.ChainAnteDecorators(sigDecorators...),
+	}
+}
+
+// AnteHandle authenticates txs with contract signers by calling `sudo` on each contract with a ContractAuthSudoMsg.
+// The tx is rejected without calling any contract when a contract signer has not the authentication ante hook
+// enabled. Otherwise, it is accepted only when all contracts return without an error. The execution of each contract is limited
+// to the gas limit of the decorator and state changes are persisted only on success.
+// A tx must be signed by contracts only or by regular accounts only. Txs without contract signers are passed
+// to the signature decorators.
+//
+// Simulations consume the max contract authentication gas without calling the contracts as there are no
+// signatures to verify.
+func (d ContractAuthDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+	signers := sigTx.GetSigners()
+	var contractSigners int
+	for _, signer := range signers {
+		if d.keeper.HasContractInfo(ctx, signer) {
+			contractSigners++
+		}
+	}
+	switch contractSigners {
+	case 0:
+		newCtx, err := d.sigHandler(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		return next(newCtx, tx, simulate)
+	case len(signers):
+	default:
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "tx must not mix contract and account signers")
+	}
+	for _, signer := range signers {
+		if !d.keeper.HasContractAnteHook(ctx, signer, types.AnteHookAuthentication) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "contract %s is not enabled to authenticate txs", signer)
+		}
+	}
+
+	// no need to authenticate again on recheck tx
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	if simulate {
+		for range signers {
+			ctx.GasMeter().ConsumeGas(d.gasLimit, "contract authentication")
+		}
+		return next(ctx, tx, simulate)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != len(signers) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+	for i, sig := range sigs {
+		if err := d.authenticate(ctx, tx, signers[i], sig); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d ContractAuthDecorator) authenticate(ctx sdk.Context, tx sdk.Tx, contractAddr sdk.AccAddress, sig signing.SignatureV2) error {
+	if sig.PubKey != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "contract signer %s must not have a public key", contractAddr)
+	}
+	acc := d.ak.GetAccount(ctx, contractAddr)
+	if acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", contractAddr)
+	}
+	if sig.Sequence != acc.GetSequence() {
+		return sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence)
+	}
+	signMode, signatures, err := flattenSignatureData(sig.Data)
+	if err != nil {
+		return err
+	}
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	signerData := authsigning.SignerData{
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+	signBytes, err := d.signModeHandler.GetSignBytes(signMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+	msg, err := json.Marshal(ContractAuthSudoMsg{Authenticate: &AuthenticateMsg{SignBytes: signBytes, Signatures: signatures}})
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	return sudoWithGasLimit(ctx, d.keeper, contractAddr, msg, d.gasLimit, "contract authentication")
+}
+
+// ContractSudoer executes sudo messages on contracts
+type ContractSudoer interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// sudoWithGasLimit executes the sudo message with the given gas limit in an isolated context. State changes and
+// events are persisted only when the contract returns without an error. The gas used is charged to the tx and
+// the contract is rejected when it runs out of gas.
+func sudoWithGasLimit(ctx sdk.Context, k ContractSudoer, contractAddr sdk.AccAddress, msg []byte, gasLimit sdk.Gas, descriptor string) (err error) {
+	sudoCtx, commit := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+	defer func() {
+		ctx.GasMeter().ConsumeGas(sudoCtx.GasMeter().GasConsumedToLimit(), descriptor)
+		if r := recover(); r != nil {
+			rType, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s out of gas in location: %v; gasWanted: %d",
+				descriptor, rType.Descriptor, gasLimit)
+		}
+	}()
+	if _, err := k.Sudo(sudoCtx, contractAddr, msg); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "contract %s rejected tx: %s", contractAddr, err)
+	}
+	commit()
+	ctx.EventManager().EmitEvents(sudoCtx.EventManager().Events())
+	return nil
+}
+
+// flattenSignatureData returns the sign mode and the raw signatures of the given signature data. All signatures of
+// a multisig must use the same sign mode.
+func flattenSignatureData(data signing.SignatureData) (signing.SignMode, [][]byte, error) {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return data.SignMode, [][]byte{data.Signature}, nil
+	case *signing.MultiSignatureData:
+		if len(data.Signatures) == 0 {
+			return 0, nil, sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "empty multisig")
+		}
+		var (
+			signMode   signing.SignMode
+			signatures [][]byte
+		)
+		for i, d := range data.Signatures {
+			mode, sigs, err := flattenSignatureData(d)
+			if err != nil {
+				return 0, nil, err
+			}
+			if i != 0 && mode != signMode {
+				return 0, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "multisig with different sign modes")
+			}
+			signMode = mode
+			signatures = append(signatures, sigs...)
+		}
+		return signMode, signatures, nil
+	default:
+		return 0, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unexpected signature data type %T", data)
+	}
+}
+
+// DefaultContractFeeGasLimit is the max gas a contract can consume to approve sponsoring the fees of a tx
+const DefaultContractFeeGasLimit sdk.Gas = 200_000
+
+// ContractFeeSudoMsg is the sudo message that is sent to a contract that is set as fee granter of a tx to ask whether
+// the contract pays the fees.
+type ContractFeeSudoMsg struct {
+	SponsorFees *SponsorFeesMsg `json:"sponsor_fees,omitempty"`
+}
+
+// SponsorFeesMsg contains the tx data a contract needs to decide on sponsoring the fees
+type SponsorFeesMsg struct {
+	// FeePayer is the bech32 address of the fee payer that signed the tx
+	FeePayer string `json:"fee_payer"`
+	// Fee is the amount that is deducted from the contract balance
+	Fee wasmvmtypes.Coins `json:"fee"`
+	// GasLimit is the gas limit of the tx
+	GasLimit uint64 `json:"gas_limit"`
+	// Msgs are the proto encoded messages of the tx
+	Msgs []SponsoredMsg `json:"msgs"`
+}
+
+// SponsoredMsg is a proto encoded message of a tx with sponsored fees
+type SponsoredMsg struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// ContractFeeKeeper defines the subset of the wasm keeper that is required to deduct fees from contracts
+type ContractFeeKeeper interface {
+	ContractSudoer
+	HasContractAnteHook(ctx sdk.Context, contractAddress sdk.AccAddress, hook types.AnteHook) bool
+	DeductContractFees(ctx sdk.Context, contractAddress sdk.AccAddress, fees sdk.Coins) error
+}
+
+// ContractFeeDecorator ante decorator to deduct the fees of a tx from a contract that sponsors the tx.
+// A tx names a contract as fee payer by setting it as fee granter. Only contracts that the fee sponsorship ante hook
+// is enabled for by governance can sponsor fees.
+type ContractFeeDecorator struct {
+	keeper     ContractFeeKeeper
+	gasLimit   sdk.Gas
+	feeHandler sdk.AnteHandler
+	sigHandler sdk.AnteHandler
+}
+
+// NewContractFeeDecorator constructor. The given fee decorator should be the SDK fee deduction decorator and the
+// signature decorators should contain the SDK public key and signature verification decorators. Txs without a
+// sponsoring contract pass the fee decorator before the signature decorators. Sponsoring contracts are called
+// after the signature decorators only so that they are never executed for unauthenticated txs.
+func NewContractFeeDecorator(keeper ContractFeeKeeper, gasLimit sdk.Gas, feeDecorator sdk.AnteDecorator, sigDecorators ...sdk.AnteDecorator) *ContractFeeDecorator {
+	if gasLimit == 0 {
+		panic("gas limit must not be zero")
+	}
+	// the terminator keeps the chain valid without signature decorators
+	sigChain := append(append([]sdk.AnteDecorator{}, sigDecorators...), sdk.Terminator{})
+	return &ContractFeeDecorator{
+		keeper:     keeper,
+		gasLimit:   gasLimit,
+		feeHandler: sdk.ChainAnteDecorators(append([]sdk.AnteDecorator{feeDecorator}, sigChain...)...),
+		sigHandler: sdk.ChainAnteDecorators(sigChain...),
+	}
+}
+
+// AnteHandle verifies the signatures and then calls `sudo` on the fee granter contract with a ContractFeeSudoMsg.
+// When the contract returns without an error, the fees are deducted from the contract balance. The execution of the
+// contract is limited to the gas limit of the decorator and state changes are persisted only on success.
+// Txs without a fee granter that the fee sponsorship ante hook is enabled for are passed to the fee and signature
+// decorators.
+func (d ContractFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	feePayer, feeGranter := feeTx.FeePayer(), feeTx.FeeGranter()
+	if feeGranter == nil || feeGranter.Equals(feePayer) || !d.keeper.HasContractAnteHook(ctx, feeGranter, types.AnteHookFeeSponsorship) {
+		newCtx, err := d.feeHandler(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		return next(newCtx, tx, simulate)
+	}
+
+	ctx, err := d.sigHandler(ctx, tx, simulate)
+	if err != nil {
+		return ctx, err
+	}
+
+	fee := feeTx.GetFee()
+	msgs := tx.GetMsgs()
+	sponsoredMsgs := make([]SponsoredMsg, len(msgs))
+	for i, msg := range msgs {
+		bz, err := proto.Marshal(msg)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+		sponsoredMsgs[i] = SponsoredMsg{TypeURL: sdk.MsgTypeURL(msg), Value: bz}
+	}
+	msg, err := json.Marshal(ContractFeeSudoMsg{SponsorFees: &SponsorFeesMsg{
+		FeePayer: feePayer.String(),
+		Fee:      ConvertSdkCoinsToWasmCoins(fee),
+		GasLimit: feeTx.GetGas(),
+		Msgs:     sponsoredMsgs,
+	}})
+	if err != nil {
+		return ctx, sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	if err := sudoWithGasLimit(ctx, d.keeper, feeGranter, msg, d.gasLimit, "contract fee approval"); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
+	}
+	if err := d.keeper.DeductContractFees(ctx, feeGranter, fee); err != nil {
+		return ctx, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, feeGranter.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSponsorFees,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, feeGranter.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()),
+		),
+	})
+	return next(ctx, tx, simulate)
+}
+
 // LimitSimulationGasDecorator ante decorator to limit gas in simulation calls
 type LimitSimulationGasDecorator struct {
 	gasLimit *sdk.Gas
@@ -1,20 +1,35 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
+	wasmvm "github.com/Finschia/wasmvm"
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/store"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	txtypes "github.com/Finschia/finschia-sdk/types/tx"
+	"github.com/Finschia/finschia-sdk/types/tx/signing"
+	authsigning "github.com/Finschia/finschia-sdk/x/auth/signing"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	"github.com/Finschia/ostracon/libs/log"
 
 	"github.com/Finschia/wasmd/x/wasm/keeper"
+	"github.com/Finschia/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
@@ -186,3 +201,362 @@ func consumeGasAnteHandler(gasToConsume sdk.Gas) sdk.AnteHandler {
 		return ctx, nil
 	}
 }
+
+func TestContractAuthDecorator(t *testing.T) {
+	const myGasLimit sdk.Gas = 100_000
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	parentCtx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities, keeper.WithWasmEngine(mock))
+	txConfig := keepers.EncodingConfig.TxConfig
+	signModeHandler := txConfig.SignModeHandler()
+
+	contractAddr := keeper.SeedNewContractInstance(t, parentCtx, keepers, mock).Contract
+	accountAddr := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1))
+	contractAcc := keepers.AccountKeeper.GetAccount(parentCtx, contractAddr)
+	require.NotNil(t, contractAcc)
+	require.NoError(t, keepers.ContractKeeper.SetContractAnteHook(parentCtx, contractAddr, types.AnteHookAuthentication, true))
+
+	sendMsg := func(from sdk.AccAddress) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: from.String(), ToAddress: accountAddr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))}
+	}
+	// txs with contract signers are built without public keys
+	buildTx := func(t *testing.T, msgs []sdk.Msg, signerInfos []*txtypes.SignerInfo, sigs [][]byte) sdk.Tx {
+		anys := make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			var err error
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+		}
+		bz, err := (&txtypes.Tx{
+			Body:       &txtypes.TxBody{Messages: anys},
+			AuthInfo:   &txtypes.AuthInfo{SignerInfos: signerInfos, Fee: &txtypes.Fee{GasLimit: 200_000}},
+			Signatures: sigs,
+		}).Marshal()
+		require.NoError(t, err)
+		tx, err := txConfig.TxDecoder()(bz)
+		require.NoError(t, err)
+		return tx
+	}
+	directSignerInfo := func(seq uint64) *txtypes.SignerInfo {
+		return &txtypes.SignerInfo{
+			ModeInfo: &txtypes.ModeInfo{Sum: &txtypes.ModeInfo_Single_{Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}}},
+			Sequence: seq,
+		}
+	}
+	contractTx := func(t *testing.T, seq uint64, sig string) sdk.Tx {
+		return buildTx(t, []sdk.Msg{sendMsg(contractAddr)}, []*txtypes.SignerInfo{directSignerInfo(seq)}, [][]byte{[]byte(sig)})
+	}
+	// the contract approves the signature "valid" for the expected sign bytes only
+	authenticatingSudoFn := func(tx *sdk.Tx, ctx *sdk.Context) func(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64, wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		return func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			var msg keeper.ContractAuthSudoMsg
+			require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+			require.NotNil(t, msg.Authenticate)
+			expSignBytes, err := signModeHandler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+				ChainID:       ctx.ChainID(),
+				AccountNumber: contractAcc.GetAccountNumber(),
+				Sequence:      contractAcc.GetSequence(),
+			}, *tx)
+			require.NoError(t, err)
+			if !bytes.Equal(expSignBytes, msg.Authenticate.SignBytes) || len(msg.Authenticate.Signatures) != 1 ||
+				string(msg.Authenticate.Signatures[0]) != "valid" {
+				return nil, 1, errors.New("invalid signature")
+			}
+			return &wasmvmtypes.Response{Events: []wasmvmtypes.Event{{Type: "authenticated"}}}, 1, nil
+		}
+	}
+
+	specs := map[string]struct {
+		tx           func(t *testing.T) sdk.Tx
+		simulate     bool
+		recheck      bool
+		sudoGas      uint64
+		hookDisabled bool
+		expSigCalled bool
+		expSudo      bool
+		expGas       sdk.Gas
+		expErr       *sdkerrors.Error
+	}{
+		"account signer - signature decorators": {
+			tx: func(t *testing.T) sdk.Tx {
+				return buildTx(t, []sdk.Msg{sendMsg(accountAddr)}, []*txtypes.SignerInfo{directSignerInfo(0)}, [][]byte{[]byte("any")})
+			},
+			expSigCalled: true,
+		},
+		"contract signer - approved": {
+			tx:      func(t *testing.T) sdk.Tx { return contractTx(t, 0, "valid") },
+			expSudo: true,
+		},
+		"contract signer - rejected": {
+			tx:      func(t *testing.T) sdk.Tx { return contractTx(t, 0, "invalid") },
+			expSudo: true,
+			expErr:  sdkerrors.ErrUnauthorized,
+		},
+		"contract signer - hook not enabled": {
+			tx:           func(t *testing.T) sdk.Tx { return contractTx(t, 0, "valid") },
+			sudoGas:      1,
+			hookDisabled: true,
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+		"contract signer - wrong sequence": {
+			tx:     func(t *testing.T) sdk.Tx { return contractTx(t, 1, "valid") },
+			expErr: sdkerrors.ErrWrongSequence,
+		},
+		"contract signer - out of gas": {
+			tx:      func(t *testing.T) sdk.Tx { return contractTx(t, 0, "valid") },
+			sudoGas: myGasLimit * keeper.DefaultGasMultiplier,
+			expSudo: true,
+			expGas:  myGasLimit,
+			expErr:  sdkerrors.ErrUnauthorized,
+		},
+		"contract signer - with public key": {
+			tx: func(t *testing.T) sdk.Tx {
+				pk, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+				require.NoError(t, err)
+				signerInfo := directSignerInfo(0)
+				signerInfo.PublicKey = pk
+				return buildTx(t, []sdk.Msg{sendMsg(contractAddr)}, []*txtypes.SignerInfo{signerInfo}, [][]byte{[]byte("valid")})
+			},
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		"contract and account signers": {
+			tx: func(t *testing.T) sdk.Tx {
+				return buildTx(t, []sdk.Msg{sendMsg(contractAddr), sendMsg(accountAddr)},
+					[]*txtypes.SignerInfo{directSignerInfo(0), directSignerInfo(0)}, [][]byte{[]byte("valid"), []byte("any")})
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"contract signer - simulation consumes gas limit": {
+			tx:       func(t *testing.T) sdk.Tx { return contractTx(t, 0, "") },
+			simulate: true,
+			expGas:   myGasLimit,
+		},
+		"contract signer - recheck skipped": {
+			tx:      func(t *testing.T) sdk.Tx { return contractTx(t, 0, "invalid") },
+			recheck: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithIsReCheckTx(spec.recheck).WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+			if spec.hookDisabled {
+				require.NoError(t, keepers.ContractKeeper.SetContractAnteHook(ctx, contractAddr, types.AnteHookAuthentication, false))
+			}
+			tx := spec.tx(t)
+			var sudoCalled bool
+			sudoFn := authenticatingSudoFn(&tx, &ctx)
+			mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				sudoCalled = true
+				if spec.sudoGas != 0 {
+					return &wasmvmtypes.Response{}, spec.sudoGas, nil
+				}
+				return sudoFn(codeID, env, sudoMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+			}
+			var sigCalled, nextCalled bool
+			sigDecorator := sdk.AnteDecorator(anteDecoratorFunc(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+				sigCalled = true
+				return next(ctx, tx, simulate)
+			}))
+			nextAnte := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			// when
+			ante := keeper.NewContractAuthDecorator(keepers.WasmKeeper, keepers.AccountKeeper, signModeHandler, myGasLimit, sigDecorator)
+			_, gotErr := ante.AnteHandle(ctx, tx, spec.simulate, nextAnte)
+
+			// then
+			assert.Equal(t, spec.expSigCalled, sigCalled)
+			assert.Equal(t, spec.expSudo, sudoCalled)
+			if spec.expGas != 0 {
+				// plus the gas for the contract lookup
+				assert.InDelta(t, spec.expGas, ctx.GasMeter().GasConsumed(), 5_000)
+			}
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.False(t, nextCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+			if spec.expSudo {
+				assert.NotEmpty(t, ctx.EventManager().Events())
+			}
+		})
+	}
+}
+
+type anteDecoratorFunc func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error)
+
+func (f anteDecoratorFunc) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return f(ctx, tx, simulate, next)
+}
+
+func TestContractFeeDecorator(t *testing.T) {
+	const myGasLimit sdk.Gas = 100_000
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	parentCtx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities, keeper.WithWasmEngine(mock))
+	txConfig := keepers.EncodingConfig.TxConfig
+
+	contractAddr := keeper.SeedNewContractInstance(t, parentCtx, keepers, mock).Contract
+	keepers.Faucet.Fund(parentCtx, contractAddr, sdk.NewInt64Coin("denom", 100))
+	require.NoError(t, keepers.ContractKeeper.SetContractAnteHook(parentCtx, contractAddr, types.AnteHookFeeSponsorship, true))
+	feePayer := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 100))
+	otherAddr := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 100))
+	feeCollector := keepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	myMsg := &banktypes.MsgSend{FromAddress: feePayer.String(), ToAddress: otherAddr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))}
+	myMsgBz, err := myMsg.Marshal()
+	require.NoError(t, err)
+	buildTx := func(t *testing.T, granter sdk.AccAddress, fee sdk.Coins) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(myMsg))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetFeeGranter(granter)
+		txBuilder.SetGasLimit(200_000)
+		return txBuilder.GetTx()
+	}
+	// the contract sponsors the fees of bank send messages only
+	sponsoringSudoFn := func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		var msg keeper.ContractFeeSudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+		require.NotNil(t, msg.SponsorFees)
+		assert.Equal(t, feePayer.String(), msg.SponsorFees.FeePayer)
+		assert.Equal(t, uint64(200_000), msg.SponsorFees.GasLimit)
+		require.Len(t, msg.SponsorFees.Msgs, 1)
+		if msg.SponsorFees.Msgs[0].TypeURL != sdk.MsgTypeURL(&banktypes.MsgSend{}) {
+			return nil, 1, errors.New("not sponsored")
+		}
+		assert.Equal(t, myMsgBz, msg.SponsorFees.Msgs[0].Value)
+		return &wasmvmtypes.Response{Events: []wasmvmtypes.Event{{Type: "sponsored"}}}, 1, nil
+	}
+
+	specs := map[string]struct {
+		granter      sdk.AccAddress
+		fee          sdk.Coins
+		sudoFn       func(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64, wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error)
+		hookDisabled bool
+		sigErr       bool
+		expFeeCalled bool
+		expDeducted  sdk.Coins
+		expErr       *sdkerrors.Error
+	}{
+		"no fee granter - fee decorators": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expFeeCalled: true,
+		},
+		"account fee granter - fee decorators": {
+			granter:      otherAddr,
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expFeeCalled: true,
+		},
+		"contract fee granter - sponsored": {
+			granter:     contractAddr,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			sudoFn:      sponsoringSudoFn,
+			expDeducted: sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+		},
+		"contract fee granter - hook not enabled": {
+			granter:      contractAddr,
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			hookDisabled: true,
+			expFeeCalled: true,
+		},
+		"contract fee granter - invalid signature": {
+			granter: contractAddr,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			sigErr:  true,
+			expErr:  sdkerrors.ErrUnauthorized,
+		},
+		"contract fee granter - zero fees sponsored": {
+			granter: contractAddr,
+			sudoFn:  sponsoringSudoFn,
+		},
+		"contract fee granter - rejected": {
+			granter: contractAddr,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			sudoFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				return nil, 1, errors.New("testing")
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"contract fee granter - out of gas": {
+			granter: contractAddr,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			sudoFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				return &wasmvmtypes.Response{}, myGasLimit * keeper.DefaultGasMultiplier, nil
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"contract fee granter - insufficient funds": {
+			granter: contractAddr,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("denom", 101)),
+			sudoFn:  sponsoringSudoFn,
+			expErr:  sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+			if spec.hookDisabled {
+				require.NoError(t, keepers.ContractKeeper.SetContractAnteHook(ctx, contractAddr, types.AnteHookFeeSponsorship, false))
+			}
+			var feeCalled, sigCalled, nextCalled bool
+			// contracts are called for txs with verified signatures only
+			mock.SudoFn = nil
+			if spec.sudoFn != nil {
+				mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					require.True(t, sigCalled)
+					return spec.sudoFn(codeID, env, sudoMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+				}
+			}
+			feeDecorator := sdk.AnteDecorator(anteDecoratorFunc(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+				feeCalled = true
+				return next(ctx, tx, simulate)
+			}))
+			sigDecorator := sdk.AnteDecorator(anteDecoratorFunc(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+				sigCalled = true
+				if spec.sigErr {
+					return ctx, sdkerrors.ErrUnauthorized
+				}
+				return next(ctx, tx, simulate)
+			}))
+			nextAnte := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+			contractBalance := keepers.BankKeeper.GetAllBalances(ctx, contractAddr)
+
+			// when
+			ante := keeper.NewContractFeeDecorator(keepers.WasmKeeper, myGasLimit, feeDecorator, sigDecorator)
+			_, gotErr := ante.AnteHandle(ctx, buildTx(t, spec.granter, spec.fee), false, nextAnte)
+
+			// then
+			assert.Equal(t, spec.expFeeCalled, feeCalled)
+			assert.True(t, sigCalled)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.False(t, nextCalled)
+				assert.Equal(t, contractBalance, keepers.BankKeeper.GetAllBalances(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+			assert.Equal(t, contractBalance.Sub(spec.expDeducted).String(), keepers.BankKeeper.GetAllBalances(ctx, contractAddr).String())
+			assert.Equal(t, spec.expDeducted.String(), keepers.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+			if spec.sudoFn != nil {
+				var sponsorEvents int
+				for _, e := range ctx.EventManager().Events() {
+					if e.Type == types.EventTypeSponsorFees {
+						sponsorEvents++
+					}
+				}
+				assert.Equal(t, 1, sponsorEvents)
+			}
+		})
+	}
+}
@@ -11,6 +11,11 @@ type AuthorizationPolicy interface {
 	CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool
 	CanModifyContract(admin, actor sdk.AccAddress) bool
 	CanModifyCodeAccessConfig(creator, actor sdk.AccAddress, isSubset bool) bool
+	CanModifyCodeStatus(creator, actor sdk.AccAddress, current types.CodeStatus) bool
+	CanPruneCode(creator, actor sdk.AccAddress) bool
+	CanModifyMigrationPolicy(creator, actor sdk.AccAddress) bool
+	CanBypassTimelock(actor sdk.AccAddress) bool
+	CanOverrideImmutable(actor sdk.AccAddress) bool
 }
 
 type DefaultAuthorizationPolicy struct{}
@@ -31,6 +36,31 @@ func (p DefaultAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk
 	return creator != nil && creator.Equals(actor) && isSubset
 }
 
+// CanModifyCodeStatus allows the code creator to change the status unless the code was revoked.
+func (p DefaultAuthorizationPolicy) CanModifyCodeStatus(creator, actor sdk.AccAddress, current types.CodeStatus) bool {
+	return creator != nil && creator.Equals(actor) && current != types.CodeStatusRevoked
+}
+
+// CanPruneCode allows the code creator to prune the code.
+func (p DefaultAuthorizationPolicy) CanPruneCode(creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
+// CanModifyMigrationPolicy allows the code creator to change the migration policy of the code.
+func (p DefaultAuthorizationPolicy) CanModifyMigrationPolicy(creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
+// CanBypassTimelock returns false so that admin operations on timelocked contracts are queued.
+func (p DefaultAuthorizationPolicy) CanBypassTimelock(sdk.AccAddress) bool {
+	return false
+}
+
+// CanOverrideImmutable returns false so that immutable contracts stay immutable.
+func (p DefaultAuthorizationPolicy) CanOverrideImmutable(sdk.AccAddress) bool {
+	return false
+}
+
 type GovAuthorizationPolicy struct{}
 
 func (p GovAuthorizationPolicy) CanCreateCode(types.AccessConfig, sdk.AccAddress) bool {
@@ -48,3 +78,25 @@ func (p GovAuthorizationPolicy) CanModifyContract(sdk.AccAddress, sdk.AccAddress
 func (p GovAuthorizationPolicy) CanModifyCodeAccessConfig(sdk.AccAddress, sdk.AccAddress, bool) bool {
 	return true
 }
+
+func (p GovAuthorizationPolicy) CanModifyCodeStatus(sdk.AccAddress, sdk.AccAddress, types.CodeStatus) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanPruneCode(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanModifyMigrationPolicy(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanBypassTimelock(sdk.AccAddress) bool {
+	return true
+}
+
+// CanOverrideImmutable returns true. Immutable contracts are modified only when the immutable override param is
+// enabled in addition.
+func (p GovAuthorizationPolicy) CanOverrideImmutable(sdk.AccAddress) bool {
+	return true
+}
@@ -181,6 +181,47 @@ func TestDefaultAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 	}
 }
 
+func TestDefaultAuthzPolicyCanModifyCodeStatus(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		current types.CodeStatus
+		exp     bool
+	}{
+		"same as actor - active": {
+			creator: myActorAddress,
+			current: types.CodeStatusActive,
+			exp:     true,
+		},
+		"same as actor - deprecated": {
+			creator: myActorAddress,
+			current: types.CodeStatusDeprecated,
+			exp:     true,
+		},
+		"same as actor - revoked": {
+			creator: myActorAddress,
+			current: types.CodeStatusRevoked,
+			exp:     false,
+		},
+		"different creator": {
+			creator: otherAddress,
+			exp:     false,
+		},
+		"no creator": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanModifyCodeStatus(spec.creator, myActorAddress, spec.current)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestGovAuthzPolicyCanCreateCode(t *testing.T) {
 	myActorAddress := RandomAccountAddress(t)
 	otherAddress := RandomAccountAddress(t)
@@ -310,3 +351,101 @@ func TestGovAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGovAuthzPolicyCanModifyCodeStatus(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		current types.CodeStatus
+	}{
+		"same as actor - revoked": {
+			creator: myActorAddress,
+			current: types.CodeStatusRevoked,
+		},
+		"different creator": {
+			creator: otherAddress,
+		},
+		"no creator": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := GovAuthorizationPolicy{}
+			got := policy.CanModifyCodeStatus(spec.creator, myActorAddress, spec.current)
+			assert.True(t, got)
+		})
+	}
+}
+
+func TestDefaultAuthzPolicyCanPruneCode(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		exp     bool
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+			exp:     true,
+		},
+		"different creator": {
+			creator: otherAddress,
+			exp:     false,
+		},
+		"no creator": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanPruneCode(spec.creator, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+	assert.True(t, GovAuthorizationPolicy{}.CanPruneCode(otherAddress, myActorAddress))
+}
+
+func TestDefaultAuthzPolicyCanModifyMigrationPolicy(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		exp     bool
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+			exp:     true,
+		},
+		"different creator": {
+			creator: otherAddress,
+			exp:     false,
+		},
+		"no creator": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanModifyMigrationPolicy(spec.creator, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+	assert.True(t, GovAuthorizationPolicy{}.CanModifyMigrationPolicy(otherAddress, myActorAddress))
+}
+
+func TestAuthzPolicyCanBypassTimelock(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	assert.False(t, DefaultAuthorizationPolicy{}.CanBypassTimelock(myActorAddress))
+	assert.True(t, GovAuthorizationPolicy{}.CanBypassTimelock(myActorAddress))
+}
+
+func TestAuthzPolicyCanOverrideImmutable(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	assert.False(t, DefaultAuthorizationPolicy{}.CanOverrideImmutable(myActorAddress))
+	assert.True(t, GovAuthorizationPolicy{}.CanOverrideImmutable(myActorAddress))
+}
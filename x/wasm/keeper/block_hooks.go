@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// BlockHookSudoMsg is the sudo message that is sent to a contract registered as block hook
+type BlockHookSudoMsg struct {
+	BeginBlock *struct{} `json:"begin_block,omitempty"`
+	EndBlock   *struct{} `json:"end_block,omitempty"`
+}
+
+func newBlockHookSudoMsg(phase types.BlockHookPhase) BlockHookSudoMsg {
+	switch phase {
+	case types.BlockHookPhaseBeginBlock:
+		return BlockHookSudoMsg{BeginBlock: &struct{}{}}
+	case types.BlockHookPhaseEndBlock:
+		return BlockHookSudoMsg{EndBlock: &struct{}{}}
+	}
+	panic(fmt.Sprintf("unsupported block hook phase: %s", phase))
+}
+
+func (k Keeper) getBlockHookMaxFailures(ctx sdk.Context) uint32 {
+	var a uint32
+	k.paramSpace.Get(ctx, types.ParamStoreKeyBlockHookFailures, &a)
+	return a
+}
+
+func (k Keeper) getBlockHookMaxGasLimit(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamStoreKeyBlockHookGasLimit, &a)
+	return a
+}
+
+// GetBlockHook returns the block hook of the contract in the given phase or nil when not registered
+func (k Keeper) GetBlockHook(ctx sdk.Context, phase types.BlockHookPhase, contractAddr sdk.AccAddress) *types.BlockHook {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBlockHookKey(phase, contractAddr))
+	if bz == nil {
+		return nil
+	}
+	var hook types.BlockHook
+	k.cdc.MustUnmarshal(bz, &hook)
+	return &hook
+}
+
+// IterateBlockHooks iterates through all registered block hooks ordered by phase and contract address.
+// The callback method can return true to abort early.
+func (k Keeper) IterateBlockHooks(ctx sdk.Context, cb func(types.BlockHook) bool) {
+	k.iterateBlockHooks(ctx, types.BlockHookPrefix, cb)
+}
+
+func (k Keeper) iterateBlockHooks(ctx sdk.Context, keyPrefix []byte, cb func(types.BlockHook) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var hook types.BlockHook
+		k.cdc.MustUnmarshal(iter.Value(), &hook)
+		// cb returns true to stop early
+		if cb(hook) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeBlockHook(ctx sdk.Context, contractAddr sdk.AccAddress, hook types.BlockHook) {
+	ctx.KVStore(k.storeKey).Set(types.GetBlockHookKey(hook.Phase, contractAddr), k.cdc.MustMarshal(&hook))
+}
+
+// registerBlockHook registers the contract to be called with sudo in every block of the given phase.
+// An existing registration is replaced and its failure count is reset. The gas limit must not exceed the
+// block hook max gas limit param.
+func (k Keeper) registerBlockHook(ctx sdk.Context, contractAddr sdk.AccAddress, phase types.BlockHookPhase, gasLimit uint64) error {
+	hook := types.BlockHook{ContractAddress: contractAddr.String(), Phase: phase, GasLimit: gasLimit}
+	if err := hook.ValidateBasic(); err != nil {
+		return err
+	}
+	if maxGasLimit := k.getBlockHookMaxGasLimit(ctx); gasLimit > maxGasLimit {
+		return sdkerrors.Wrapf(types.ErrLimit, "gas limit above %d", maxGasLimit)
+	}
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	k.storeBlockHook(ctx, contractAddr, hook)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterBlockHook,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, hook.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyBlockHookPhase, phase.String()),
+		sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(gasLimit, 10)),
+	))
+	return nil
+}
+
+// deregisterBlockHook removes the block hook of the contract in the given phase
+func (k Keeper) deregisterBlockHook(ctx sdk.Context, contractAddr sdk.AccAddress, phase types.BlockHookPhase) error {
+	key := types.GetBlockHookKey(phase, contractAddr)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(types.ErrNotFound, "block hook")
+	}
+	store.Delete(key)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeregisterBlockHook,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyBlockHookPhase, phase.String()),
+	))
+	return nil
+}
+
+// ExecuteBlockHooks calls all contracts that are registered for the given phase with a sudo message.
+// Every call is limited by the gas limit of the hook and runs in an isolated context so that a failing
+// contract does not halt the chain. A hook is deregistered after the max consecutive failures param
+// unless it is zero.
+func (k Keeper) ExecuteBlockHooks(ctx sdk.Context, phase types.BlockHookPhase) {
+	var hooks []types.BlockHook
+	k.iterateBlockHooks(ctx, types.GetBlockHookPhasePrefix(phase), func(hook types.BlockHook) bool {
+		hooks = append(hooks, hook)
+		return false
+	})
+	if len(hooks) == 0 {
+		return
+	}
+	msg, err := json.Marshal(newBlockHookSudoMsg(phase))
+	if err != nil {
+		panic(err)
+	}
+	maxFailures := k.getBlockHookMaxFailures(ctx)
+	for _, hook := range hooks {
+		contractAddr := sdk.MustAccAddressFromBech32(hook.ContractAddress)
+		if err := k.executeBlockHook(ctx, contractAddr, hook.GasLimit, msg); err != nil {
+			hook.ConsecutiveFailures++
+			k.Logger(ctx).Info("block hook failed", "contract", hook.ContractAddress, "phase", phase.String(), "error", err.Error())
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeBlockHookFailed,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, hook.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyBlockHookPhase, phase.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			if maxFailures != 0 && hook.ConsecutiveFailures >= maxFailures {
+				if err := k.deregisterBlockHook(ctx, contractAddr, phase); err != nil {
+					panic(err)
+				}
+				continue
+			}
+		} else {
+			if hook.ConsecutiveFailures == 0 {
+				continue
+			}
+			hook.ConsecutiveFailures = 0
+		}
+		k.storeBlockHook(ctx, contractAddr, hook)
+	}
+}
+
+// executeBlockHook calls the contract with the sudo message in an isolated context that is limited by the gas
+// limit. State changes and events are persisted only when the contract returns without an error.
+func (k Keeper) executeBlockHook(ctx sdk.Context, contractAddr sdk.AccAddress, gasLimit sdk.Gas, msg []byte) (err error) {
+	hookCtx, commit := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			rType, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gasWanted: %d", rType.Descriptor, gasLimit)
+		}
+	}()
+	if _, err := k.Sudo(hookCtx, contractAddr, msg); err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(hookCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmvm "github.com/Finschia/wasmvm"
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestRegisterBlockHook(t *testing.T) {
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(mock))
+	k := keepers.WasmKeeper
+	contractAddr := SeedNewContractInstance(t, parentCtx, keepers, mock).Contract
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		phase    types.BlockHookPhase
+		gasLimit uint64
+		existing *types.BlockHook
+		expErr   error
+	}{
+		"begin block": {
+			contract: contractAddr,
+			phase:    types.BlockHookPhaseBeginBlock,
+			gasLimit: 100_000,
+		},
+		"end block": {
+			contract: contractAddr,
+			phase:    types.BlockHookPhaseEndBlock,
+			gasLimit: 100_000,
+		},
+		"replaces existing and resets failures": {
+			contract: contractAddr,
+			phase:    types.BlockHookPhaseBeginBlock,
+			gasLimit: 100_000,
+			existing: &types.BlockHook{ContractAddress: contractAddr.String(), Phase: types.BlockHookPhaseBeginBlock, GasLimit: 1, ConsecutiveFailures: 2},
+		},
+		"unknown contract": {
+			contract: RandomAccountAddress(t),
+			phase:    types.BlockHookPhaseBeginBlock,
+			gasLimit: 100_000,
+			expErr:   types.ErrNotFound,
+		},
+		"unspecified phase": {
+			contract: contractAddr,
+			gasLimit: 100_000,
+			expErr:   types.ErrEmpty,
+		},
+		"zero gas limit": {
+			contract: contractAddr,
+			phase:    types.BlockHookPhaseBeginBlock,
+			expErr:   types.ErrEmpty,
+		},
+		"max gas limit": {
+			contract: contractAddr,
+			phase:    types.BlockHookPhaseBeginBlock,
+			gasLimit: types.DefaultBlockHookMaxGasLimit,
+		},
+		"gas limit above max": {
+			contract: contractAddr,
+			phase:    types.BlockHookPhaseBeginBlock,
+			gasLimit: types.DefaultBlockHookMaxGasLimit + 1,
+			expErr:   types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			if spec.existing != nil {
+				k.storeBlockHook(ctx, contractAddr, *spec.existing)
+			}
+			// when
+			gotErr := k.registerBlockHook(ctx, spec.contract, spec.phase, spec.gasLimit)
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.BlockHook{ContractAddress: spec.contract.String(), Phase: spec.phase, GasLimit: spec.gasLimit}
+			assert.Equal(t, &exp, k.GetBlockHook(ctx, spec.phase, spec.contract))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeRegisterBlockHook, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestDeregisterBlockHook(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	myAddr := RandomAccountAddress(t)
+	k.storeBlockHook(parentCtx, myAddr, types.BlockHook{ContractAddress: myAddr.String(), Phase: types.BlockHookPhaseEndBlock, GasLimit: 1})
+
+	specs := map[string]struct {
+		phase  types.BlockHookPhase
+		expErr error
+	}{
+		"registered": {
+			phase: types.BlockHookPhaseEndBlock,
+		},
+		"not registered in phase": {
+			phase:  types.BlockHookPhaseBeginBlock,
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			// when
+			gotErr := k.deregisterBlockHook(ctx, myAddr, spec.phase)
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetBlockHook(ctx, spec.phase, myAddr))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeDeregisterBlockHook, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestExecuteBlockHooks(t *testing.T) {
+	const myGasLimit = 100_000
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(mock))
+	k := keepers.WasmKeeper
+	contractAddr := SeedNewContractInstance(t, parentCtx, keepers, mock).Contract
+
+	type sudoFn func(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64, wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error)
+	succeeding := func(expMsg string) sudoFn {
+		return func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			assert.JSONEq(t, expMsg, string(sudoMsg))
+			store.Set([]byte("called"), []byte("true"))
+			return &wasmvmtypes.Response{Events: []wasmvmtypes.Event{{Type: "hooked"}}}, 1, nil
+		}
+	}
+	failing := func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		store.Set([]byte("called"), []byte("true"))
+		return nil, 1, errors.New("testing")
+	}
+	outOfGas := func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, gasLimit uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		store.Set([]byte("called"), []byte("true"))
+		return &wasmvmtypes.Response{}, gasLimit + 1, nil
+	}
+
+	specs := map[string]struct {
+		phase       types.BlockHookPhase
+		failures    uint32
+		maxFailures uint32
+		sudoFn      sudoFn
+		expHook     *types.BlockHook
+		expCalled   bool
+		expEvents   []string
+	}{
+		"begin block succeeds": {
+			phase:       types.BlockHookPhaseBeginBlock,
+			maxFailures: 3,
+			sudoFn:      succeeding(`{"begin_block":{}}`),
+			expHook:     &types.BlockHook{Phase: types.BlockHookPhaseBeginBlock, GasLimit: myGasLimit},
+			expCalled:   true,
+			expEvents:   []string{types.EventTypeSudo, "wasm-hooked"},
+		},
+		"end block succeeds": {
+			phase:       types.BlockHookPhaseEndBlock,
+			maxFailures: 3,
+			sudoFn:      succeeding(`{"end_block":{}}`),
+			expHook:     &types.BlockHook{Phase: types.BlockHookPhaseEndBlock, GasLimit: myGasLimit},
+			expCalled:   true,
+			expEvents:   []string{types.EventTypeSudo, "wasm-hooked"},
+		},
+		"success resets failures": {
+			phase:       types.BlockHookPhaseBeginBlock,
+			failures:    2,
+			maxFailures: 3,
+			sudoFn:      succeeding(`{"begin_block":{}}`),
+			expHook:     &types.BlockHook{Phase: types.BlockHookPhaseBeginBlock, GasLimit: myGasLimit},
+			expCalled:   true,
+			expEvents:   []string{types.EventTypeSudo, "wasm-hooked"},
+		},
+		"contract error": {
+			phase:       types.BlockHookPhaseBeginBlock,
+			maxFailures: 3,
+			sudoFn:      failing,
+			expHook:     &types.BlockHook{Phase: types.BlockHookPhaseBeginBlock, GasLimit: myGasLimit, ConsecutiveFailures: 1},
+			expEvents:   []string{types.EventTypeBlockHookFailed},
+		},
+		"out of gas": {
+			phase:       types.BlockHookPhaseEndBlock,
+			maxFailures: 3,
+			sudoFn:      outOfGas,
+			expHook:     &types.BlockHook{Phase: types.BlockHookPhaseEndBlock, GasLimit: myGasLimit, ConsecutiveFailures: 1},
+			expEvents:   []string{types.EventTypeBlockHookFailed},
+		},
+		"max failures reached - deregistered": {
+			phase:       types.BlockHookPhaseBeginBlock,
+			failures:    2,
+			maxFailures: 3,
+			sudoFn:      failing,
+			expEvents:   []string{types.EventTypeBlockHookFailed, types.EventTypeDeregisterBlockHook},
+		},
+		"max failures zero - never deregistered": {
+			phase:     types.BlockHookPhaseBeginBlock,
+			failures:  10,
+			sudoFn:    failing,
+			expHook:   &types.BlockHook{Phase: types.BlockHookPhaseBeginBlock, GasLimit: myGasLimit, ConsecutiveFailures: 11},
+			expEvents: []string{types.EventTypeBlockHookFailed},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			params := types.DefaultParams()
+			params.BlockHookMaxFailures = spec.maxFailures
+			k.SetParams(ctx, params)
+			k.storeBlockHook(ctx, contractAddr, types.BlockHook{
+				ContractAddress:     contractAddr.String(),
+				Phase:               spec.phase,
+				GasLimit:            myGasLimit,
+				ConsecutiveFailures: spec.failures,
+			})
+			mock.SudoFn = spec.sudoFn
+			// when
+			k.ExecuteBlockHooks(ctx, spec.phase)
+			// then
+			if spec.expHook != nil {
+				spec.expHook.ContractAddress = contractAddr.String()
+			}
+			assert.Equal(t, spec.expHook, k.GetBlockHook(ctx, spec.phase, contractAddr))
+			assert.Equal(t, spec.expCalled, k.QueryRaw(ctx, contractAddr, []byte("called")) != nil)
+			var gotEvents []string
+			for _, e := range em.Events() {
+				gotEvents = append(gotEvents, e.Type)
+			}
+			assert.Equal(t, spec.expEvents, gotEvents)
+		})
+	}
+}
+
+func TestExecuteBlockHooksOnlyCallsPhase(t *testing.T) {
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(mock))
+	k := keepers.WasmKeeper
+	beginAddr := SeedNewContractInstance(t, ctx, keepers, mock).Contract
+	endAddr := SeedNewContractInstance(t, ctx, keepers, mock).Contract
+	require.NoError(t, k.registerBlockHook(ctx, beginAddr, types.BlockHookPhaseBeginBlock, 100_000))
+	require.NoError(t, k.registerBlockHook(ctx, endAddr, types.BlockHookPhaseEndBlock, 100_000))
+
+	var called []string
+	mock.SudoFn = func(_ wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		var msg BlockHookSudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+		called = append(called, env.Contract.Address)
+		return &wasmvmtypes.Response{}, 1, nil
+	}
+	k.ExecuteBlockHooks(ctx, types.BlockHookPhaseBeginBlock)
+	assert.Equal(t, []string{beginAddr.String()}, called)
+
+	called = nil
+	k.ExecuteBlockHooks(ctx, types.BlockHookPhaseEndBlock)
+	assert.Equal(t, []string{endAddr.String()}, called)
+}
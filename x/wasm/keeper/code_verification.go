@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// IsCodeVerifier returns true when the address is allowlisted by the code verifiers param
+func (k Keeper) IsCodeVerifier(ctx sdk.Context, actor sdk.AccAddress) bool {
+	var verifiers []string
+	k.paramSpace.Get(ctx, types.ParamStoreKeyCodeVerifiers, &verifiers)
+	for _, v := range verifiers {
+		if v == actor.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// setCodeBuildMetadata attaches the build metadata to a code. Only the code creator can set it, and only once so
+// that verifications always refer to the same metadata.
+func (k Keeper) setCodeBuildMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata types.CodeBuildMetadata) error {
+	if err := metadata.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "build metadata")
+	}
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if info.Creator != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not set build metadata")
+	}
+	if info.BuildMetadata != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "build metadata already set")
+	}
+	info.BuildMetadata = &metadata
+	k.storeCodeInfo(ctx, codeID, *info)
+	return nil
+}
+
+// verifyCode records the attestation of an allowlisted verifier that the checksum of the code matches a reproducible
+// build of its build metadata
+func (k Keeper) verifyCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, checksum []byte) error {
+	if !k.IsCodeVerifier(ctx, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not a code verifier")
+	}
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if info.BuildMetadata == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code has no build metadata")
+	}
+	if !bytes.Equal(info.CodeHash, checksum) {
+		return sdkerrors.Wrap(types.ErrInvalid, "checksum does not match the code")
+	}
+	if info.IsVerifiedBy(caller) {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "verifier %s", caller)
+	}
+	info.Verifications = append(info.Verifications, types.CodeVerification{
+		Verifier: caller.String(),
+		Height:   uint64(ctx.BlockHeight()),
+	})
+	k.storeCodeInfo(ctx, codeID, *info)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVerifyCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyVerifier, caller.String()),
+	))
+	return nil
+}
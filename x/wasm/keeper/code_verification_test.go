@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestSetCodeBuildMetadata(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	metadata := types.CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"}
+
+	specs := map[string]struct {
+		codeID   uint64
+		caller   sdk.AccAddress
+		metadata types.CodeBuildMetadata
+		preSet   bool
+		expErr   *sdkerrors.Error
+	}{
+		"creator": {
+			codeID:   example.CodeID,
+			caller:   example.CreatorAddr,
+			metadata: metadata,
+		},
+		"non creator": {
+			codeID:   example.CodeID,
+			caller:   RandomAccountAddress(t),
+			metadata: metadata,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"already set": {
+			codeID:   example.CodeID,
+			caller:   example.CreatorAddr,
+			metadata: metadata,
+			preSet:   true,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"unknown code": {
+			codeID:   99,
+			caller:   example.CreatorAddr,
+			metadata: metadata,
+			expErr:   types.ErrNotFound,
+		},
+		"invalid metadata": {
+			codeID:   example.CodeID,
+			caller:   example.CreatorAddr,
+			metadata: types.CodeBuildMetadata{Commit: "1234abcd"},
+			expErr:   types.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.preSet {
+				require.NoError(t, keepers.ContractKeeper.SetCodeBuildMetadata(ctx, example.CodeID, example.CreatorAddr, types.CodeBuildMetadata{Source: "https://example.com", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"}))
+			}
+			infoBefore := keepers.WasmKeeper.GetCodeInfo(ctx, example.CodeID)
+
+			// when
+			gotErr := keepers.ContractKeeper.SetCodeBuildMetadata(ctx, spec.codeID, spec.caller, spec.metadata)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Equal(t, infoBefore, keepers.WasmKeeper.GetCodeInfo(ctx, example.CodeID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &spec.metadata, keepers.WasmKeeper.GetCodeInfo(ctx, spec.codeID).BuildMetadata)
+		})
+	}
+}
+
+func TestVerifyCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	withoutMetadata := StoreBurnerExampleContract(t, parentCtx, keepers)
+	metadata := types.CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"}
+	require.NoError(t, keepers.ContractKeeper.SetCodeBuildMetadata(parentCtx, example.CodeID, example.CreatorAddr, metadata))
+
+	verifier, otherVerifier := RandomAccountAddress(t), RandomAccountAddress(t)
+	params := types.DefaultParams()
+	params.CodeVerifiers = []string{verifier.String(), otherVerifier.String()}
+	keepers.WasmKeeper.SetParams(parentCtx, params)
+	require.NoError(t, keepers.ContractKeeper.VerifyCode(parentCtx.WithBlockHeight(5), example.CodeID, otherVerifier, example.Checksum))
+
+	specs := map[string]struct {
+		codeID   uint64
+		caller   sdk.AccAddress
+		checksum []byte
+		expErr   *sdkerrors.Error
+	}{
+		"allowlisted verifier": {
+			codeID:   example.CodeID,
+			caller:   verifier,
+			checksum: example.Checksum,
+		},
+		"not allowlisted": {
+			codeID:   example.CodeID,
+			caller:   example.CreatorAddr,
+			checksum: example.Checksum,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"already verified": {
+			codeID:   example.CodeID,
+			caller:   otherVerifier,
+			checksum: example.Checksum,
+			expErr:   types.ErrDuplicate,
+		},
+		"checksum mismatch": {
+			codeID:   example.CodeID,
+			caller:   verifier,
+			checksum: bytes.Repeat([]byte{1}, 32),
+			expErr:   types.ErrInvalid,
+		},
+		"no build metadata": {
+			codeID:   withoutMetadata.CodeID,
+			caller:   verifier,
+			checksum: withoutMetadata.Checksum,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"unknown code": {
+			codeID:   99,
+			caller:   verifier,
+			checksum: example.Checksum,
+			expErr:   types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithBlockHeight(10)
+			em := sdk.NewEventManager()
+			infoBefore := keepers.WasmKeeper.GetCodeInfo(ctx, spec.codeID)
+
+			// when
+			gotErr := keepers.ContractKeeper.VerifyCode(ctx.WithEventManager(em), spec.codeID, spec.caller, spec.checksum)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Equal(t, infoBefore, keepers.WasmKeeper.GetCodeInfo(ctx, spec.codeID))
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			info := keepers.WasmKeeper.GetCodeInfo(ctx, spec.codeID)
+			assert.Equal(t, []types.CodeVerification{
+				{Verifier: otherVerifier.String(), Height: 5},
+				{Verifier: spec.caller.String(), Height: 10},
+			}, info.Verifications)
+			assert.True(t, info.IsVerifiedBy(spec.caller))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeVerifyCode, em.Events()[0].Type)
+			assert.Equal(t, map[string]string{"code_id": "1", "verifier": spec.caller.String()}, attrsToStringMap(em.Events()[0].Attributes))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// HasContractAnteHook returns true when the ante hook is enabled for the contract
+func (k Keeper) HasContractAnteHook(ctx sdk.Context, contractAddr sdk.AccAddress, hook types.AnteHook) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetContractAnteHookKey(hook, contractAddr))
+}
+
+// IterateContractAnteHooks iterates through all enabled contract ante hooks ordered by hook and contract address.
+// The callback method can return true to abort early.
+func (k Keeper) IterateContractAnteHooks(ctx sdk.Context, cb func(types.ContractAnteHook) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractAnteHookPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		h := types.ContractAnteHook{
+			ContractAddress: sdk.AccAddress(key[1:]).String(),
+			Hook:            types.AnteHook(key[0]),
+		}
+		// cb returns true to stop early
+		if cb(h) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeContractAnteHook(ctx sdk.Context, contractAddr sdk.AccAddress, hook types.AnteHook, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete(types.GetContractAnteHookKey(hook, contractAddr))
+		return
+	}
+	store.Set(types.GetContractAnteHookKey(hook, contractAddr), []byte{1})
+}
+
+// setContractAnteHook enables or disables the ante hook for the contract. Contracts take over a check of the ante
+// handler only when the hook is enabled for them.
+func (k Keeper) setContractAnteHook(ctx sdk.Context, contractAddr sdk.AccAddress, hook types.AnteHook, enabled bool) error {
+	if err := hook.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	k.storeContractAnteHook(ctx, contractAddr, hook, enabled)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateContractAnteHook,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyAnteHook, hook.String()),
+		sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	))
+	return nil
+}
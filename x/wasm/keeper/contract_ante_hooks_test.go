@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestSetContractAnteHook(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	myContractAddr := RandomAccountAddress(t)
+	contractInfo := types.ContractInfoFixture()
+	k.storeContractInfo(ctx, myContractAddr, &contractInfo)
+
+	specs := map[string]struct {
+		contractAddr sdk.AccAddress
+		hook         types.AnteHook
+		enabled      bool
+		expErr       bool
+		expHooks     []types.ContractAnteHook
+	}{
+		"enable": {
+			contractAddr: myContractAddr,
+			hook:         types.AnteHookAuthentication,
+			enabled:      true,
+			expHooks:     []types.ContractAnteHook{{ContractAddress: myContractAddr.String(), Hook: types.AnteHookAuthentication}},
+		},
+		"enable again": {
+			contractAddr: myContractAddr,
+			hook:         types.AnteHookAuthentication,
+			enabled:      true,
+			expHooks:     []types.ContractAnteHook{{ContractAddress: myContractAddr.String(), Hook: types.AnteHookAuthentication}},
+		},
+		"disable": {
+			contractAddr: myContractAddr,
+			hook:         types.AnteHookAuthentication,
+		},
+		"unknown contract": {
+			contractAddr: RandomAccountAddress(t),
+			hook:         types.AnteHookAuthentication,
+			enabled:      true,
+			expErr:       true,
+		},
+		"unspecified hook": {
+			contractAddr: myContractAddr,
+			enabled:      true,
+			expErr:       true,
+		},
+	}
+	// the specs build on each other
+	for _, name := range []string{"enable", "enable again", "disable", "unknown contract", "unspecified hook"} {
+		spec := specs[name]
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.setContractAnteHook(ctx.WithEventManager(em), spec.contractAddr, spec.hook, spec.enabled)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Len(t, em.Events(), 0)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeUpdateContractAnteHook, em.Events()[0].Type)
+			var gotHooks []types.ContractAnteHook
+			k.IterateContractAnteHooks(ctx, func(h types.ContractAnteHook) bool {
+				gotHooks = append(gotHooks, h)
+				return false
+			})
+			assert.Equal(t, spec.expHooks, gotHooks)
+			assert.Equal(t, spec.enabled, k.HasContractAnteHook(ctx, myContractAddr, types.AnteHookAuthentication))
+		})
+	}
+}
@@ -25,13 +25,31 @@ type decoratedKeeper interface {
 
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
+	proposeContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, expiresAtHeight uint64, authZ AuthorizationPolicy) error
+	acceptContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
+	cancelContractAdminProposal(ctx sdk.Context, contractAddress, caller sdk.AccAddress, authZ AuthorizationPolicy) error
+	setContractTimelock(ctx sdk.Context, contractAddress, caller sdk.AccAddress, delayBlocks uint64, authZ AuthorizationPolicy) error
+	queueTimelockedOperation(ctx sdk.Context, contractAddress, caller sdk.AccAddress, op types.TimelockedOperation, authZ AuthorizationPolicy) (bool, error)
+	cancelTimelockedOperation(ctx sdk.Context, contractAddress, caller sdk.AccAddress, operationID uint64, authZ AuthorizationPolicy) error
+	makeContractImmutable(ctx sdk.Context, contractAddress, caller sdk.AccAddress, authZ AuthorizationPolicy) error
 	pinCode(ctx sdk.Context, codeID uint64) error
 	unpinCode(ctx sdk.Context, codeID uint64) error
 	execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, autz AuthorizationPolicy) error
+	registerBlockHook(ctx sdk.Context, contractAddr sdk.AccAddress, phase types.BlockHookPhase, gasLimit uint64) error
+	deregisterBlockHook(ctx sdk.Context, contractAddr sdk.AccAddress, phase types.BlockHookPhase) error
+	setCodeStatus(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, status types.CodeStatus, reason string, supersededBy uint64, authz AuthorizationPolicy) error
+	pruneCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authz AuthorizationPolicy) error
+	setCodeStateLimit(ctx sdk.Context, codeID, maxBytes uint64) error
+	setIBCRateLimit(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, limit *types.IBCRateLimit) error
+	setContractAnteHook(ctx sdk.Context, contractAddr sdk.AccAddress, hook types.AnteHook, enabled bool) error
+	setMigrationPolicy(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, policy types.MigrationPolicy, authz AuthorizationPolicy) error
+	setCodeBuildMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata types.CodeBuildMetadata) error
+	verifyCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, checksum []byte) error
 	ClassicAddressGenerator() AddressGenerator
+	NamedPredictableAddressGenerator(name string, creator sdk.AccAddress, salt []byte, msg []byte, fixMsg bool) (AddressGenerator, error)
 }
 
 type PermissionedKeeper struct {
@@ -67,7 +85,8 @@ func (p PermissionedKeeper) Instantiate(
 	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.nested.ClassicAddressGenerator(), p.authZPolicy)
 }
 
-// Instantiate2 creates an instance of a WASM contract using the predictable address generator
+// Instantiate2 creates an instance of a WASM contract using the predictable address generator that is
+// registered for the given name. The default generator is used for an empty name.
 func (p PermissionedKeeper) Instantiate2(
 	ctx sdk.Context,
 	codeID uint64,
@@ -77,7 +96,12 @@ func (p PermissionedKeeper) Instantiate2(
 	deposit sdk.Coins,
 	salt []byte,
 	fixMsg bool,
+	addressGenerator string,
 ) (sdk.AccAddress, []byte, error) {
+	generator, err := p.nested.NamedPredictableAddressGenerator(addressGenerator, creator, salt, initMsg, fixMsg)
+	if err != nil {
+		return nil, nil, err
+	}
 	return p.nested.instantiate(
 		ctx,
 		codeID,
@@ -86,7 +110,7 @@ func (p PermissionedKeeper) Instantiate2(
 		initMsg,
 		label,
 		deposit,
-		PredicableAddressGenerator(creator, salt, initMsg, fixMsg),
+		generator,
 		p.authZPolicy,
 	)
 }
@@ -95,7 +119,15 @@ func (p PermissionedKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddr
 	return p.nested.execute(ctx, contractAddress, caller, msg, coins)
 }
 
+// Migrate upgrades the contract to a new code. The migration is queued when the contract is timelocked.
 func (p PermissionedKeeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error) {
+	op := types.TimelockedOperation{
+		Type:      types.TimelockedOperationTypeMigrate,
+		Migration: &types.TimelockedMigration{CodeID: newCodeID, Msg: msg},
+	}
+	if queued, err := p.nested.queueTimelockedOperation(ctx, contractAddress, caller, op, p.authZPolicy); err != nil || queued {
+		return nil, err
+	}
 	return p.nested.migrate(ctx, contractAddress, caller, newCodeID, msg, p.authZPolicy)
 }
 
@@ -103,14 +135,59 @@ func (p PermissionedKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress
 	return p.nested.Sudo(ctx, contractAddress, msg)
 }
 
+// UpdateContractAdmin sets a new contract admin. The update is queued when the contract is timelocked.
 func (p PermissionedKeeper) UpdateContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) error {
+	op := types.TimelockedOperation{Type: types.TimelockedOperationTypeUpdateAdmin, NewAdmin: newAdmin.String()}
+	if queued, err := p.nested.queueTimelockedOperation(ctx, contractAddress, caller, op, p.authZPolicy); err != nil || queued {
+		return err
+	}
 	return p.nested.setContractAdmin(ctx, contractAddress, caller, newAdmin, p.authZPolicy)
 }
 
+// ProposeContractAdmin proposes a new admin for the contract that has to accept it
+func (p PermissionedKeeper) ProposeContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress, expiresAtHeight uint64) error {
+	return p.nested.proposeContractAdmin(ctx, contractAddress, caller, newAdmin, expiresAtHeight, p.authZPolicy)
+}
+
+// AcceptContractAdmin sets the caller as contract admin when it was proposed before
+func (p PermissionedKeeper) AcceptContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.acceptContractAdmin(ctx, contractAddress, caller)
+}
+
+// CancelContractAdminProposal removes the proposed admin of the contract
+func (p PermissionedKeeper) CancelContractAdminProposal(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.cancelContractAdminProposal(ctx, contractAddress, caller, p.authZPolicy)
+}
+
+// ClearContractAdmin removes the contract admin. The removal is queued when the contract is timelocked.
 func (p PermissionedKeeper) ClearContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	op := types.TimelockedOperation{Type: types.TimelockedOperationTypeClearAdmin}
+	if queued, err := p.nested.queueTimelockedOperation(ctx, contractAddress, caller, op, p.authZPolicy); err != nil || queued {
+		return err
+	}
 	return p.nested.setContractAdmin(ctx, contractAddress, caller, nil, p.authZPolicy)
 }
 
+// SetContractTimelock sets the number of blocks that migrations and admin changes of the contract are queued.
+// A lower timelock is queued itself when the contract is timelocked.
+func (p PermissionedKeeper) SetContractTimelock(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, delayBlocks uint64) error {
+	op := types.TimelockedOperation{Type: types.TimelockedOperationTypeSetTimelock, DelayBlocks: delayBlocks}
+	if queued, err := p.nested.queueTimelockedOperation(ctx, contractAddress, caller, op, p.authZPolicy); err != nil || queued {
+		return err
+	}
+	return p.nested.setContractTimelock(ctx, contractAddress, caller, delayBlocks, p.authZPolicy)
+}
+
+// CancelTimelockedOperation removes a queued operation of the contract
+func (p PermissionedKeeper) CancelTimelockedOperation(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, operationID uint64) error {
+	return p.nested.cancelTimelockedOperation(ctx, contractAddress, caller, operationID, p.authZPolicy)
+}
+
+// MakeContractImmutable permanently disables migrations and admin changes of the contract. The admin is removed.
+func (p PermissionedKeeper) MakeContractImmutable(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.makeContractImmutable(ctx, contractAddress, caller, p.authZPolicy)
+}
+
 func (p PermissionedKeeper) PinCode(ctx sdk.Context, codeID uint64) error {
 	return p.nested.pinCode(ctx, codeID)
 }
@@ -128,3 +205,54 @@ func (p PermissionedKeeper) SetContractInfoExtension(ctx sdk.Context, contract s
 func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
 	return p.nested.setAccessConfig(ctx, codeID, caller, newConfig, p.authZPolicy)
 }
+
+// RegisterBlockHook registers the contract to be called with sudo in every block of the given phase
+func (p PermissionedKeeper) RegisterBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress, phase types.BlockHookPhase, gasLimit uint64) error {
+	return p.nested.registerBlockHook(ctx, contractAddress, phase, gasLimit)
+}
+
+// DeregisterBlockHook removes the block hook of the contract in the given phase
+func (p PermissionedKeeper) DeregisterBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress, phase types.BlockHookPhase) error {
+	return p.nested.deregisterBlockHook(ctx, contractAddress, phase)
+}
+
+// SetCodeStatus updates the lifecycle status of a code id.
+func (p PermissionedKeeper) SetCodeStatus(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, status types.CodeStatus, reason string, supersededBy uint64) error {
+	return p.nested.setCodeStatus(ctx, codeID, caller, status, reason, supersededBy, p.authZPolicy)
+}
+
+// PruneCode removes a code that is not used by any contract and not pinned
+func (p PermissionedKeeper) PruneCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error {
+	return p.nested.pruneCode(ctx, codeID, caller, p.authZPolicy)
+}
+
+// SetCodeStateLimit overrides the max contract state bytes param for the contracts of a code
+func (p PermissionedKeeper) SetCodeStateLimit(ctx sdk.Context, codeID, maxStateBytes uint64) error {
+	return p.nested.setCodeStateLimit(ctx, codeID, maxStateBytes)
+}
+
+// SetIBCRateLimit overrides the IBC rate limit param for a contract on a channel. A nil limit removes the override.
+func (p PermissionedKeeper) SetIBCRateLimit(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, limit *types.IBCRateLimit) error {
+	return p.nested.setIBCRateLimit(ctx, contractAddr, channelID, limit)
+}
+
+// SetContractAnteHook enables or disables an ante hook for a contract
+func (p PermissionedKeeper) SetContractAnteHook(ctx sdk.Context, contractAddr sdk.AccAddress, hook types.AnteHook, enabled bool) error {
+	return p.nested.setContractAnteHook(ctx, contractAddr, hook, enabled)
+}
+
+// SetMigrationPolicy updates the codes that contracts of a code can be migrated to
+func (p PermissionedKeeper) SetMigrationPolicy(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, policy types.MigrationPolicy) error {
+	return p.nested.setMigrationPolicy(ctx, codeID, caller, policy, p.authZPolicy)
+}
+
+// SetCodeBuildMetadata attaches the build metadata to a code. It can be set only once by the code creator.
+func (p PermissionedKeeper) SetCodeBuildMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata types.CodeBuildMetadata) error {
+	return p.nested.setCodeBuildMetadata(ctx, codeID, caller, metadata)
+}
+
+// VerifyCode attests that the code matches a reproducible build of its build metadata. Only the allowlisted code
+// verifiers can execute it.
+func (p PermissionedKeeper) VerifyCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, checksum []byte) error {
+	return p.nested.verifyCode(ctx, codeID, caller, checksum)
+}
@@ -17,7 +17,11 @@ import (
 )
 
 func TestInstantiate2(t *testing.T) {
-	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	const myAddressGenerator = "my-generator"
+	myAddressBuilder := func(checksum []byte, creator sdk.AccAddress, salt, initMsg types.RawContractMessage) sdk.AccAddress {
+		return BuildContractAddressPredictable(checksum, creator, append([]byte("my"), salt...), initMsg)
+	}
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithAddressGenerator(myAddressGenerator, myAddressBuilder))
 	example := StoreHackatomExampleContract(t, parentCtx, keepers)
 	otherExample := StoreReflectContract(t, parentCtx, keepers)
 	mock := &wasmtesting.MockWasmer{}
@@ -46,6 +50,7 @@ func TestInstantiate2(t *testing.T) {
 			sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
 			[]byte(mySalt),
 			fixMsg,
+			"",
 		)
 		require.NoError(t, err)
 	}
@@ -62,6 +67,7 @@ func TestInstantiate2(t *testing.T) {
 		salt    []byte
 		initMsg json.RawMessage
 		fixMsg  bool
+		addrGen string
 		expErr  error
 	}{
 		"fix msg - generates different address than without fixMsg": {
@@ -141,6 +147,32 @@ func TestInstantiate2(t *testing.T) {
 			initMsg: []byte(fmt.Sprintf(`{"foo":%q}`, strings.Repeat("b", math.MaxInt16+1))), // too long kills CI
 			fixMsg:  true,
 		},
+		"named address generator - generates different address than default": {
+			setup:   exampleWithoutFixMsg,
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: initMsg,
+			addrGen: myAddressGenerator,
+		},
+		"explicit default address generator - reject same address": {
+			setup:   exampleWithoutFixMsg,
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: initMsg,
+			addrGen: types.DefaultAddressGenerator,
+			expErr:  types.ErrDuplicate,
+		},
+		"unknown address generator": {
+			setup:   func(t *testing.T, ctx sdk.Context) {},
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: initMsg,
+			addrGen: "unknown",
+			expErr:  types.ErrNotFound,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -156,6 +188,7 @@ func TestInstantiate2(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin("denom", 2)),
 				spec.salt,
 				spec.fixMsg,
+				spec.addrGen,
 			)
 			if spec.expErr != nil {
 				assert.ErrorIs(t, gotErr, spec.expErr)
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// GetMaxContractStateBytes returns the max total bytes of the state of the contracts of the code. The override of
+// the code is preferred over the param. Zero is unlimited.
+func (k Keeper) GetMaxContractStateBytes(ctx sdk.Context, codeID uint64) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get(types.GetCodeStateLimitKey(codeID)); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	var maxBytes uint64
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxStateBytes, &maxBytes)
+	return maxBytes
+}
+
+// IterateCodeStateLimits iterates through all max contract state bytes overrides ordered by code id.
+// The callback method can return true to abort early.
+func (k Keeper) IterateCodeStateLimits(ctx sdk.Context, cb func(types.CodeStateLimit) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeStateLimitPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		limit := types.CodeStateLimit{
+			CodeID:        sdk.BigEndianToUint64(iter.Key()),
+			MaxStateBytes: sdk.BigEndianToUint64(iter.Value()),
+		}
+		// cb returns true to stop early
+		if cb(limit) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeCodeStateLimit(ctx sdk.Context, codeID, maxBytes uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if maxBytes == 0 {
+		store.Delete(types.GetCodeStateLimitKey(codeID))
+		return
+	}
+	store.Set(types.GetCodeStateLimitKey(codeID), sdk.Uint64ToBigEndian(maxBytes))
+}
+
+// setCodeStateLimit overrides the max contract state bytes param for the contracts of the code.
+// Zero removes the override. The limit is checked on writes only so that existing state is kept.
+func (k Keeper) setCodeStateLimit(ctx sdk.Context, codeID, maxBytes uint64) error {
+	if !k.containsCodeInfo(ctx, codeID) {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	k.storeCodeStateLimit(ctx, codeID, maxBytes)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateCodeStateLimit,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyMaxStateBytes, strconv.FormatUint(maxBytes, 10)),
+	))
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestContractStateLimit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	const codeID = 1
+
+	specs := map[string]struct {
+		paramLimit uint64
+		codeLimit  uint64
+		existing   []types.Model
+		setup      func(store *contractStateStore)
+		expSize    types.ContractStateSize
+		expErr     bool
+	}{
+		"unlimited by default": {
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("barbarbar"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 1, TotalBytes: 12},
+		},
+		"within param limit": {
+			paramLimit: 6,
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 1, TotalBytes: 6},
+		},
+		"exceeds param limit": {
+			paramLimit: 5,
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+			},
+			expErr: true,
+		},
+		"code limit preferred over param": {
+			paramLimit: 5,
+			codeLimit:  6,
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 1, TotalBytes: 6},
+		},
+		"exceeds code limit": {
+			codeLimit: 5,
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+			},
+			expErr: true,
+		},
+		"shrink allowed above limit": {
+			codeLimit: 1,
+			existing:  []types.Model{{Key: []byte("foo"), Value: []byte("barbar")}},
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("b"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 1, TotalBytes: 4},
+		},
+		"delete allowed above limit": {
+			codeLimit: 1,
+			existing:  []types.Model{{Key: []byte("foo"), Value: []byte("bar")}},
+			setup: func(store *contractStateStore) {
+				store.Delete([]byte("foo"))
+			},
+			expSize: types.ContractStateSize{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := types.DefaultParams()
+			params.MaxContractStateBytes = spec.paramLimit
+			k.SetParams(ctx, params)
+			k.storeCodeStateLimit(ctx, codeID, spec.codeLimit)
+			contractAddr := RandomAccountAddress(t)
+			require.NoError(t, k.importContractState(ctx, contractAddr, spec.existing))
+			store := k.newContractStateStore(ctx, contractAddr, codeID)
+			spec.setup(store)
+
+			// when
+			gotErr := store.checkStateChange()
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.True(t, types.ErrContractStateLimit.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expSize, k.GetContractStateSize(ctx, contractAddr))
+		})
+	}
+}
+
+func TestImportContractStateExemptFromLimit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.MaxContractStateBytes = 1
+	k.SetParams(ctx, params)
+	contractAddr := RandomAccountAddress(t)
+
+	require.NoError(t, k.importContractState(ctx, contractAddr, []types.Model{{Key: []byte("foo"), Value: []byte("bar")}}))
+
+	assert.Equal(t, types.ContractStateSize{KeyCount: 1, TotalBytes: 6}, k.GetContractStateSize(ctx, contractAddr))
+}
+
+func TestContractStateLimitOnInstantiate(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	require.NoError(t, k.setCodeStateLimit(ctx, example.CodeID, 1))
+
+	_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "test", nil)
+
+	require.Error(t, err)
+	assert.True(t, types.ErrContractStateLimit.Is(err), err)
+}
+
+func TestSetCodeStateLimit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	params := types.DefaultParams()
+	params.MaxContractStateBytes = 100
+	k.SetParams(parentCtx, params)
+
+	specs := map[string]struct {
+		codeID   uint64
+		maxBytes uint64
+		expMax   uint64
+		expErr   *sdkerrors.Error
+	}{
+		"override set": {
+			codeID:   example.CodeID,
+			maxBytes: 1024,
+			expMax:   1024,
+		},
+		"zero removes override": {
+			codeID: example.CodeID,
+			expMax: 100,
+		},
+		"unknown code": {
+			codeID:   example.CodeID + 1,
+			maxBytes: 1024,
+			expErr:   types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, k.setCodeStateLimit(ctx, example.CodeID, 1))
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.setCodeStateLimit(ctx.WithEventManager(em), spec.codeID, spec.maxBytes)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMax, k.GetMaxContractStateBytes(ctx, spec.codeID))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeUpdateCodeStateLimit, em.Events()[0].Type)
+			var got []types.CodeStateLimit
+			k.IterateCodeStateLimits(ctx, func(l types.CodeStateLimit) bool {
+				got = append(got, l)
+				return false
+			})
+			if spec.maxBytes == 0 {
+				assert.Empty(t, got)
+			} else {
+				assert.Equal(t, []types.CodeStateLimit{{CodeID: spec.codeID, MaxStateBytes: spec.maxBytes}}, got)
+			}
+		})
+	}
+}
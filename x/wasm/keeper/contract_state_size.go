@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// contractStateStore is the prefix store of a contract state that keeps the key count and the total bytes of the
+// contract state up to date on every write. The bookkeeping reads and writes are charged as gas like the write.
+// The state limit and the storage deposit are not checked on the writes but for the change of the state size by
+// checkStateChange after the contract call returned. The size is read on the first write only so that calls without
+// writes are not charged for it.
+type contractStateStore struct {
+	prefix.Store
+	ctx          sdk.Context
+	keeper       Keeper
+	contractAddr sdk.AccAddress
+	codeID       uint64
+	written      bool
+	initialSize  types.ContractStateSize
+	size         types.ContractStateSize
+}
+
+// newContractStateStore returns the prefix store of the contract state with size accounting. The code id
+// selects the max state bytes.
+func (k Keeper) newContractStateStore(ctx sdk.Context, contractAddr sdk.AccAddress, codeID uint64) *contractStateStore {
+	prefixStoreKey := types.GetContractStorePrefix(contractAddr)
+	return &contractStateStore{
+		Store:        prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey),
+		ctx:          ctx,
+		keeper:       k,
+		contractAddr: contractAddr,
+		codeID:       codeID,
+	}
+}
+
+// Set stores the value and updates the state size of the contract
+func (s *contractStateStore) Set(key, value []byte) {
+	old := s.Store.Get(key)
+	s.loadSize()
+	if old == nil {
+		s.size.KeyCount++
+		s.size.TotalBytes += uint64(len(key) + len(value))
+	} else {
+		s.size.TotalBytes = s.size.TotalBytes - uint64(len(old)) + uint64(len(value))
+	}
+	s.Store.Set(key, value)
+	s.keeper.storeContractStateSize(s.ctx, s.contractAddr, s.size)
+}
+
+// Delete removes the key and updates the state size of the contract
+func (s *contractStateStore) Delete(key []byte) {
+	old := s.Store.Get(key)
+	if old == nil {
+		s.Store.Delete(key)
+		return
+	}
+
+	s.loadSize()
+	s.size.KeyCount--
+	s.size.TotalBytes -= uint64(len(key) + len(old))
+	s.Store.Delete(key)
+	s.keeper.storeContractStateSize(s.ctx, s.contractAddr, s.size)
+}
+
+// loadSize reads the state size of the contract on the first write
+func (s *contractStateStore) loadSize() {
+	if s.written {
+		return
+	}
+	s.written = true
+	s.initialSize = s.keeper.GetContractStateSize(s.ctx, s.contractAddr)
+	s.size = s.initialSize
+}
+
+// checkStateChange checks the state limit and adjusts the storage deposit for the change of the state size since
+// the first write. It is called after the contract call returned. On error the caller must discard the writes of
+// the call.
+func (s *contractStateStore) checkStateChange() error {
+	if !s.written {
+		return nil
+	}
+	if err := s.checkStateLimit(s.initialSize, s.size); err != nil {
+		return err
+	}
+	return s.keeper.adjustStorageDeposit(s.ctx, s.contractAddr, s.initialSize.TotalBytes, s.size.TotalBytes)
+}
+
+// checkStateLimit rejects state growth beyond the max state bytes. Writes that do not grow the state are accepted
+// so that a contract above a lowered limit can still clean up.
+func (s *contractStateStore) checkStateLimit(oldSize, newSize types.ContractStateSize) error {
+	if newSize.TotalBytes <= oldSize.TotalBytes {
+		return nil
+	}
+	maxBytes := s.keeper.GetMaxContractStateBytes(s.ctx, s.codeID)
+	if maxBytes == 0 || newSize.TotalBytes <= maxBytes {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrContractStateLimit, "%d bytes exceed max of %d", newSize.TotalBytes, maxBytes)
+}
+
+// rawContractStore returns the prefix store of the contract state that is not gas metered
+func (k Keeper) rawContractStore(ctx sdk.Context, contractAddr sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), types.GetContractStorePrefix(contractAddr))
+}
+
+// GetContractStateSize returns the number of keys and bytes in the state of the contract
+func (k Keeper) GetContractStateSize(ctx sdk.Context, contractAddr sdk.AccAddress) types.ContractStateSize {
+	var size types.ContractStateSize
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractStateSizeKey(contractAddr))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &size)
+	}
+	return size
+}
+
+func (k Keeper) storeContractStateSize(ctx sdk.Context, contractAddr sdk.AccAddress, size types.ContractStateSize) {
+	ctx.KVStore(k.storeKey).Set(types.GetContractStateSizeKey(contractAddr), k.cdc.MustMarshal(&size))
+}
+
+// UpdateContractStateMetrics sets the metrics of the total keys and bytes in the state of all contracts. It is
+// called at the end of the block so that only the state of the block is reported.
+func (k Keeper) UpdateContractStateMetrics(ctx sdk.Context) {
+	var total types.ContractStateSize
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractStateSizePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var size types.ContractStateSize
+		k.cdc.MustUnmarshal(iter.Value(), &size)
+		total.KeyCount += size.KeyCount
+		total.TotalBytes += size.TotalBytes
+	}
+	k.metrics.ContractStateKeys.Set(float64(total.KeyCount))
+	k.metrics.ContractStateBytes.Set(float64(total.TotalBytes))
+}
+
+// computeContractStateSize iterates through the state of the contract to count the keys and bytes
+func (k Keeper) computeContractStateSize(ctx sdk.Context, contractAddr sdk.AccAddress) types.ContractStateSize {
+	var size types.ContractStateSize
+	iter := k.rawContractStore(ctx, contractAddr).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		size.KeyCount++
+		size.TotalBytes += uint64(len(iter.Key()) + len(iter.Value()))
+	}
+	return size
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestContractStateStoreSize(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		setup   func(store *contractStateStore)
+		expSize types.ContractStateSize
+	}{
+		"empty": {
+			setup:   func(*contractStateStore) {},
+			expSize: types.ContractStateSize{},
+		},
+		"set new keys": {
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("a"), []byte("bc"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 2, TotalBytes: 9},
+		},
+		"overwrite key": {
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("foo"), []byte("b"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 1, TotalBytes: 4},
+		},
+		"delete key": {
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("a"), []byte("bc"))
+				store.Delete([]byte("foo"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 1, TotalBytes: 3},
+		},
+		"delete unknown key": {
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Delete([]byte("unknown"))
+			},
+			expSize: types.ContractStateSize{KeyCount: 1, TotalBytes: 6},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			spec.setup(k.newContractStateStore(ctx, contractAddr, 1))
+			assert.Equal(t, spec.expSize, k.GetContractStateSize(ctx, contractAddr))
+			assert.Equal(t, spec.expSize, k.computeContractStateSize(ctx, contractAddr))
+		})
+	}
+}
+
+func TestContractStateStoreSizeCharged(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.newContractStateStore(ctx, contractAddr, 1).Store.Set([]byte("foo"), []byte("bar"))
+	plainGas := ctx.GasMeter().GasConsumed()
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.newContractStateStore(ctx, RandomAccountAddress(t), 1).Set([]byte("foo"), []byte("bar"))
+	assert.Greater(t, ctx.GasMeter().GasConsumed(), plainGas)
+}
+
+func TestUpdateContractStateMetrics(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	keysGauge, bytesGauge := generic.NewGauge("keys"), generic.NewGauge("bytes")
+	k.metrics = NopMetrics()
+	k.metrics.ContractStateKeys, k.metrics.ContractStateBytes = keysGauge, bytesGauge
+
+	k.newContractStateStore(ctx, RandomAccountAddress(t), 1).Set([]byte("foo"), []byte("bar"))
+	k.newContractStateStore(ctx, RandomAccountAddress(t), 1).Set([]byte("a"), []byte("b"))
+	// not reported before the end of the block
+	assert.Zero(t, keysGauge.Value())
+
+	// when
+	k.UpdateContractStateMetrics(ctx)
+
+	// then
+	assert.Equal(t, float64(2), keysGauge.Value())
+	assert.Equal(t, float64(8), bytesGauge.Value())
+}
+
+func TestMigrate1to2ContractStateSize(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	expSize := k.GetContractStateSize(ctx, example.Contract)
+	require.NotZero(t, expSize.KeyCount)
+	// drop the counter as in state before the migration
+	ctx.KVStore(k.storeKey).Delete(types.GetContractStateSizeKey(example.Contract))
+	require.Equal(t, types.ContractStateSize{}, k.GetContractStateSize(ctx, example.Contract))
+
+	// when
+	require.NoError(t, NewMigrator(*k).Migrate1to2(ctx))
+
+	// then
+	assert.Equal(t, expSize, k.GetContractStateSize(ctx, example.Contract))
+}
@@ -18,25 +18,27 @@ type ValidatorSetSource interface {
 //
 // CONTRACT: all types of accounts must have been already initialized/created
 func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, stakingKeeper ValidatorSetSource, msgHandler sdk.Handler) ([]abci.ValidatorUpdate, error) {
-	contractKeeper := NewGovPermissionKeeper(keeper)
 	keeper.SetParams(ctx, data.Params)
 	var maxCodeID uint64
+	if keeper.genesisStreamFile != "" {
+		if len(data.Codes) != 0 || len(data.Contracts) != 0 || len(data.Sequences) != 0 {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, "codes, contracts and sequences must be empty when a genesis stream is imported")
+		}
+		var err error
+		if maxCodeID, _, err = initGenesisStreamFile(ctx, keeper, keeper.genesisStreamFile); err != nil {
+			return nil, sdkerrors.Wrap(err, "genesis stream")
+		}
+	}
+
 	for i, code := range data.Codes {
-		err := keeper.importCode(ctx, code.CodeID, code.CodeInfo, code.CodeBytes)
-		if err != nil {
+		if err := importGenesisCode(ctx, keeper, code); err != nil {
 			return nil, sdkerrors.Wrapf(err, "code %d with id: %d", i, code.CodeID)
 		}
 		if code.CodeID > maxCodeID {
 			maxCodeID = code.CodeID
 		}
-		if code.Pinned {
-			if err := contractKeeper.PinCode(ctx, code.CodeID); err != nil {
-				return nil, sdkerrors.Wrapf(err, "contract number %d", i)
-			}
-		}
 	}
 
-	var maxContractID int
 	for i, contract := range data.Contracts {
 		contractAddr, err := sdk.AccAddressFromBech32(contract.ContractAddress)
 		if err != nil {
@@ -46,7 +48,89 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
-		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
+		keeper.storeStorageDeposit(ctx, contractAddr, contract.StorageDeposit)
+	}
+
+	if err := keeper.checkStorageDepositsFunded(ctx); err != nil {
+		return nil, err
+	}
+
+	for i, hook := range data.BlockHooks {
+		contractAddr, err := sdk.AccAddressFromBech32(hook.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address in block hook number %d", i)
+		}
+		if !keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract of block hook number %d", i)
+		}
+		keeper.storeBlockHook(ctx, contractAddr, hook)
+	}
+
+	for i, limit := range data.CodeStateLimits {
+		if !keeper.containsCodeInfo(ctx, limit.CodeID) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "code of code state limit number %d", i)
+		}
+		keeper.storeCodeStateLimit(ctx, limit.CodeID, limit.MaxStateBytes)
+	}
+
+	for i, pending := range data.PendingAdmins {
+		contractAddr, err := sdk.AccAddressFromBech32(pending.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address in pending admin number %d", i)
+		}
+		if !keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract of pending admin number %d", i)
+		}
+		keeper.storePendingAdmin(ctx, contractAddr, pending)
+	}
+
+	for i, timelock := range data.ContractTimelocks {
+		contractAddr, err := sdk.AccAddressFromBech32(timelock.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address in contract timelock number %d", i)
+		}
+		if !keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract of contract timelock number %d", i)
+		}
+		keeper.storeContractTimelock(ctx, contractAddr, timelock.DelayBlocks)
+	}
+
+	for i, o := range data.IBCRateLimitOverrides {
+		contractAddr, err := sdk.AccAddressFromBech32(o.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address in ibc rate limit override number %d", i)
+		}
+		if !keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract of ibc rate limit override number %d", i)
+		}
+		limit := o.Limit
+		keeper.storeIBCRateLimitOverride(ctx, contractAddr, o.ChannelID, &limit)
+	}
+
+	for i, h := range data.ContractAnteHooks {
+		contractAddr, err := sdk.AccAddressFromBech32(h.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address in contract ante hook number %d", i)
+		}
+		if !keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract of contract ante hook number %d", i)
+		}
+		keeper.storeContractAnteHook(ctx, contractAddr, h.Hook, true)
+	}
+
+	var maxOperationID uint64
+	for i, op := range data.TimelockedOperations {
+		contractAddr, err := sdk.AccAddressFromBech32(op.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address in timelocked operation number %d", i)
+		}
+		if !keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract of timelocked operation number %d", i)
+		}
+		keeper.storeTimelockedOperation(ctx, contractAddr, op)
+		if op.ID > maxOperationID {
+			maxOperationID = op.ID
+		}
 	}
 
 	for i, seq := range data.Sequences {
@@ -61,9 +145,23 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 	if seqVal <= maxCodeID {
 		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastCodeID), seqVal, maxCodeID)
 	}
+	// the instance id is not persisted with the contract and contracts with predictable addresses do not use it.
+	// The next classic contract address for every code must not be taken instead.
 	seqVal = keeper.PeekAutoIncrementID(ctx, types.KeyLastInstanceID)
-	if seqVal <= uint64(maxContractID) {
-		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastInstanceID), seqVal, maxContractID)
+	var seqErr error
+	keeper.IterateCodeInfos(ctx, func(codeID uint64, _ types.CodeInfo) bool {
+		if addr := BuildContractAddressClassic(codeID, seqVal); keeper.HasContractInfo(ctx, addr) {
+			seqErr = sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d conflicts with contract %s", string(types.KeyLastInstanceID), seqVal, addr)
+			return true
+		}
+		return false
+	})
+	if seqErr != nil {
+		return nil, seqErr
+	}
+	seqVal = keeper.PeekAutoIncrementID(ctx, types.KeyLastTimelockedOperationID)
+	if seqVal <= maxOperationID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastTimelockedOperationID), seqVal, maxOperationID)
 	}
 
 	if len(data.GenMsgs) == 0 {
@@ -82,48 +180,195 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 	return stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 }
 
+// GenesisExportFilter limits the state that is written on genesis export.
+// The zero value exports the full state.
+type GenesisExportFilter struct {
+	// ExcludeStateForCodes contains the code ids of contracts that are exported without their state and storage deposit
+	ExcludeStateForCodes []uint64
+	// OnlyContracts limits the exported contracts to the given addresses when not empty
+	OnlyContracts []sdk.AccAddress
+	// StripCodeBytes exports the codes without the wasm byte code. The code info checksum is used as reference
+	// to the byte code in the wasm cache dir on import.
+	StripCodeBytes bool
+}
+
+// GenesisExportFilter returns the filter that limits the state written on genesis export
+func (k Keeper) GenesisExportFilter() GenesisExportFilter {
+	return k.genesisExportFilter
+}
+
+// IncludesContract returns true when the contract is exported
+func (f GenesisExportFilter) IncludesContract(contractAddr sdk.AccAddress) bool {
+	if len(f.OnlyContracts) == 0 {
+		return true
+	}
+	for _, a := range f.OnlyContracts {
+		if a.Equals(contractAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IncludesState returns true when the state of contracts with the given code id is exported
+func (f GenesisExportFilter) IncludesState(codeID uint64) bool {
+	for _, id := range f.ExcludeStateForCodes {
+		if id == codeID {
+			return false
+		}
+	}
+	return true
+}
+
 // ExportGenesis returns a GenesisState for a given context and keeper.
+// The state is limited by the GenesisExportFilter of the keeper. When a genesis stream file
+// is configured, codes, contracts and sequences are written to the file instead.
 func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 	var genState types.GenesisState
 
 	genState.Params = keeper.GetParams(ctx)
+	keeper.IterateBlockHooks(ctx, func(hook types.BlockHook) bool {
+		if keeper.genesisExportFilter.IncludesContract(sdk.MustAccAddressFromBech32(hook.ContractAddress)) {
+			genState.BlockHooks = append(genState.BlockHooks, hook)
+		}
+		return false
+	})
+	keeper.IterateCodeStateLimits(ctx, func(limit types.CodeStateLimit) bool {
+		genState.CodeStateLimits = append(genState.CodeStateLimits, limit)
+		return false
+	})
+	keeper.IteratePendingAdmins(ctx, func(pending types.PendingAdmin) bool {
+		if keeper.genesisExportFilter.IncludesContract(sdk.MustAccAddressFromBech32(pending.ContractAddress)) {
+			genState.PendingAdmins = append(genState.PendingAdmins, pending)
+		}
+		return false
+	})
+	keeper.IterateContractTimelocks(ctx, func(timelock types.ContractTimelock) bool {
+		if keeper.genesisExportFilter.IncludesContract(sdk.MustAccAddressFromBech32(timelock.ContractAddress)) {
+			genState.ContractTimelocks = append(genState.ContractTimelocks, timelock)
+		}
+		return false
+	})
+	keeper.IterateTimelockedOperations(ctx, func(op types.TimelockedOperation) bool {
+		if keeper.genesisExportFilter.IncludesContract(sdk.MustAccAddressFromBech32(op.ContractAddress)) {
+			genState.TimelockedOperations = append(genState.TimelockedOperations, op)
+		}
+		return false
+	})
+	keeper.IterateIBCRateLimitOverrides(ctx, func(o types.IBCRateLimitOverride) bool {
+		if keeper.genesisExportFilter.IncludesContract(sdk.MustAccAddressFromBech32(o.ContractAddress)) {
+			genState.IBCRateLimitOverrides = append(genState.IBCRateLimitOverrides, o)
+		}
+		return false
+	})
+	keeper.IterateContractAnteHooks(ctx, func(h types.ContractAnteHook) bool {
+		if keeper.genesisExportFilter.IncludesContract(sdk.MustAccAddressFromBech32(h.ContractAddress)) {
+			genState.ContractAnteHooks = append(genState.ContractAnteHooks, h)
+		}
+		return false
+	})
 
-	keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
-		bytecode, err := keeper.GetByteCode(ctx, codeID)
-		if err != nil {
+	if keeper.genesisStreamFile != "" {
+		if err := exportGenesisStreamFile(ctx, keeper, keeper.genesisStreamFile); err != nil {
 			panic(err)
 		}
-		genState.Codes = append(genState.Codes, types.Code{
+		return &genState
+	}
+
+	err := exportGenesisRecords(ctx, keeper, func(record types.GenesisStreamRecord) error {
+		switch r := record.Sum.(type) {
+		case *types.GenesisStreamRecord_Code:
+			genState.Codes = append(genState.Codes, *r.Code)
+		case *types.GenesisStreamRecord_Contract:
+			genState.Contracts = append(genState.Contracts, *r.Contract)
+		case *types.GenesisStreamRecord_Model:
+			last := &genState.Contracts[len(genState.Contracts)-1]
+			last.ContractState = append(last.ContractState, *r.Model)
+		case *types.GenesisStreamRecord_Sequence:
+			genState.Sequences = append(genState.Sequences, *r.Sequence)
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &genState
+}
+
+// exportGenesisRecords calls the callback for every code, contract, contract state model and sequence that
+// passes the GenesisExportFilter of the keeper. The state models of a contract follow the contract.
+func exportGenesisRecords(ctx sdk.Context, keeper *Keeper, cb func(types.GenesisStreamRecord) error) error {
+	filter := keeper.genesisExportFilter
+	var err error
+	keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		var bytecode []byte
+		if !filter.StripCodeBytes {
+			if bytecode, err = keeper.GetByteCode(ctx, codeID); err != nil {
+				return true
+			}
+		}
+		err = cb(types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Code{Code: &types.Code{
 			CodeID:    codeID,
 			CodeInfo:  info,
 			CodeBytes: bytecode,
 			Pinned:    keeper.IsPinnedCode(ctx, codeID),
-		})
-		return false
+		}}})
+		return err != nil
 	})
+	if err != nil {
+		return err
+	}
 
 	keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, contract types.ContractInfo) bool {
-		var state []types.Model
-		keeper.IterateContractState(ctx, addr, func(key, value []byte) bool {
-			state = append(state, types.Model{Key: key, Value: value})
+		if !filter.IncludesContract(addr) {
 			return false
-		})
+		}
 		// redact contract info
 		contract.Created = nil
-		genState.Contracts = append(genState.Contracts, types.Contract{
+		record := &types.Contract{
 			ContractAddress: addr.String(),
 			ContractInfo:    contract,
-			ContractState:   state,
+		}
+		// the deposit is held for the state, so it is dropped with the state
+		includesState := filter.IncludesState(contract.CodeID)
+		if includesState {
+			record.StorageDeposit = keeper.GetStorageDeposit(ctx, addr)
+		}
+		err = cb(types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Contract{Contract: record}})
+		if err != nil || !includesState {
+			return err != nil
+		}
+		keeper.IterateContractState(ctx, addr, func(key, value []byte) bool {
+			err = cb(types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Model{Model: &types.Model{Key: key, Value: value}}})
+			return err != nil
 		})
-		return false
+		return err != nil
 	})
+	if err != nil {
+		return err
+	}
 
-	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
-		genState.Sequences = append(genState.Sequences, types.Sequence{
+	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID, types.KeyLastTimelockedOperationID} {
+		err = cb(types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Sequence{Sequence: &types.Sequence{
 			IDKey: k,
 			Value: keeper.PeekAutoIncrementID(ctx, k),
-		})
+		}}})
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return &genState
+// importGenesisCode imports the code and pins it when set
+func importGenesisCode(ctx sdk.Context, keeper *Keeper, code types.Code) error {
+	if err := keeper.importCode(ctx, code.CodeID, code.CodeInfo, code.CodeBytes); err != nil {
+		return err
+	}
+	if code.Pinned {
+		if err := NewGovPermissionKeeper(keeper).PinCode(ctx, code.CodeID); err != nil {
+			return sdkerrors.Wrap(err, "pin")
+		}
+	}
+	return nil
 }
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// maxGenesisStreamRecordSize is the max size of a single genesis stream record
+const maxGenesisStreamRecordSize = 64 << 20
+
+// ExportGenesisStream writes the codes, contracts with their state and sequences as a sequence of
+// length-prefixed protobuf records. Records are written one by one so that the memory used is bounded by
+// the largest record and not by the size of the state. The params and genesis messages are not part of
+// the stream.
+func ExportGenesisStream(ctx sdk.Context, keeper *Keeper, w io.Writer) error {
+	writer := protoio.NewDelimitedWriter(w)
+	return exportGenesisRecords(ctx, keeper, func(record types.GenesisStreamRecord) error {
+		return writer.WriteMsg(&record)
+	})
+}
+
+// InitGenesisStream imports the records of a genesis stream. Each record is validated and imported before the
+// next one is read. It returns the max code id and the number of contracts imported.
+func InitGenesisStream(ctx sdk.Context, keeper *Keeper, r io.Reader) (uint64, int, error) {
+	reader := protoio.NewDelimitedReader(r, maxGenesisStreamRecordSize)
+	var (
+		maxCodeID    uint64
+		numContracts int
+		contractAddr sdk.AccAddress
+	)
+	for i := 0; ; i++ {
+		var record types.GenesisStreamRecord
+		switch err := reader.ReadMsg(&record); {
+		case errors.Is(err, io.EOF):
+			return maxCodeID, numContracts, nil
+		case err != nil:
+			return 0, 0, sdkerrors.Wrapf(types.ErrInvalid, "record %d: %s", i, err)
+		}
+		if err := importGenesisRecord(ctx, keeper, record, &contractAddr); err != nil {
+			return 0, 0, sdkerrors.Wrapf(err, "record %d", i)
+		}
+		switch r := record.Sum.(type) {
+		case *types.GenesisStreamRecord_Code:
+			if r.Code.CodeID > maxCodeID {
+				maxCodeID = r.Code.CodeID
+			}
+		case *types.GenesisStreamRecord_Contract:
+			numContracts++
+		}
+	}
+}
+
+// importGenesisRecord validates and imports a single record. The address of the last imported contract is
+// tracked for the state models that follow it.
+func importGenesisRecord(ctx sdk.Context, keeper *Keeper, record types.GenesisStreamRecord, contractAddr *sdk.AccAddress) error {
+	switch r := record.Sum.(type) {
+	case *types.GenesisStreamRecord_Code:
+		if err := r.Code.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", r.Code.CodeID)
+		}
+		return importGenesisCode(ctx, keeper, *r.Code)
+	case *types.GenesisStreamRecord_Contract:
+		if err := r.Contract.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "contract")
+		}
+		addr := sdk.MustAccAddressFromBech32(r.Contract.ContractAddress)
+		if err := keeper.importContract(ctx, addr, &r.Contract.ContractInfo, r.Contract.ContractState); err != nil {
+			return sdkerrors.Wrapf(err, "contract: %s", addr)
+		}
+		keeper.storeStorageDeposit(ctx, addr, r.Contract.StorageDeposit)
+		*contractAddr = addr
+		return nil
+	case *types.GenesisStreamRecord_Model:
+		if *contractAddr == nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "contract state without contract")
+		}
+		if err := r.Model.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "model")
+		}
+		return keeper.importContractState(ctx, *contractAddr, []types.Model{*r.Model})
+	case *types.GenesisStreamRecord_Sequence:
+		if err := r.Sequence.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "sequence")
+		}
+		return keeper.importAutoIncrementID(ctx, r.Sequence.IDKey, r.Sequence.Value)
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalid, "unknown record type: %T", r)
+	}
+}
+
+func exportGenesisStreamFile(ctx sdk.Context, keeper *Keeper, file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := ExportGenesisStream(ctx, keeper, w); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func initGenesisStreamFile(ctx sdk.Context, keeper *Keeper, file string) (uint64, int, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	return InitGenesisStream(ctx, keeper, bufio.NewReader(f))
+}
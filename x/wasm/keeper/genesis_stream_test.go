@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestGenesisStreamExportImport(t *testing.T) {
+	srcKeeper, srcCtx, _ := setupKeeper(t)
+	srcKeeper.SetParams(srcCtx, types.DefaultParams())
+	seedGenesisStreamState(t, srcKeeper, srcCtx, 3, 10)
+	require.NoError(t, NewGovPermissionKeeper(srcKeeper).PinCode(srcCtx, 2))
+
+	// export as stream
+	streamFile := filepath.Join(t.TempDir(), "wasm_genesis.bin")
+	srcKeeper.genesisStreamFile = streamFile
+	gotState := ExportGenesis(srcCtx, srcKeeper)
+	assert.Equal(t, types.GenesisState{Params: types.DefaultParams()}, *gotState)
+
+	// import into new instance
+	dstKeeper, dstCtx, _ := setupKeeper(t)
+	dstKeeper.genesisStreamFile = streamFile
+	_, err := InitGenesis(dstCtx, dstKeeper, *gotState, &StakingKeeperMock{}, nil)
+	require.NoError(t, err)
+
+	// then the state is the same
+	srcKeeper.genesisStreamFile = ""
+	dstKeeper.genesisStreamFile = ""
+	expState := ExportGenesis(srcCtx, srcKeeper)
+	require.Len(t, expState.Contracts, 3)
+	assert.Equal(t, expState, ExportGenesis(dstCtx, dstKeeper))
+	assert.True(t, dstKeeper.IsPinnedCode(dstCtx, 2))
+}
+
+func TestInitGenesisStream(t *testing.T) {
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	myCode := types.CodeFixture(func(c *types.Code) {
+		c.CodeBytes = wasmCode
+		codeHash := sha256.Sum256(wasmCode)
+		c.CodeInfo.CodeHash = codeHash[:]
+	})
+	myContract := types.ContractFixture(func(c *types.Contract) {
+		c.ContractInfo.Created = nil
+		c.ContractState = nil
+	})
+	codeRecord := &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Code{Code: &myCode}}
+	contractRecord := &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Contract{Contract: &myContract}}
+	modelRecord := &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Model{Model: &types.Model{Key: []byte("foo"), Value: []byte("bar")}}}
+	otherModelRecord := &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Model{Model: &types.Model{Key: []byte("other"), Value: []byte("bar")}}}
+	seqRecord := &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Sequence{Sequence: &types.Sequence{IDKey: types.KeyLastCodeID, Value: 2}}}
+
+	specs := map[string]struct {
+		src             []byte
+		expMaxCodeID    uint64
+		expNumContracts int
+		expErr          bool
+	}{
+		"all good": {
+			src:             mustWriteGenesisRecords(t, codeRecord, contractRecord, modelRecord, otherModelRecord, seqRecord),
+			expMaxCodeID:    1,
+			expNumContracts: 1,
+		},
+		"empty stream": {
+			src: []byte{},
+		},
+		"contract state without contract": {
+			src:    mustWriteGenesisRecords(t, codeRecord, modelRecord),
+			expErr: true,
+		},
+		"duplicate contract state key": {
+			src:    mustWriteGenesisRecords(t, codeRecord, contractRecord, modelRecord, modelRecord),
+			expErr: true,
+		},
+		"contract without code": {
+			src:    mustWriteGenesisRecords(t, contractRecord),
+			expErr: true,
+		},
+		"invalid code": {
+			src:    mustWriteGenesisRecords(t, &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Code{Code: &types.Code{}}}),
+			expErr: true,
+		},
+		"invalid model": {
+			src:    mustWriteGenesisRecords(t, codeRecord, contractRecord, &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Model{Model: &types.Model{}}}),
+			expErr: true,
+		},
+		"invalid sequence": {
+			src:    mustWriteGenesisRecords(t, &types.GenesisStreamRecord{Sum: &types.GenesisStreamRecord_Sequence{Sequence: &types.Sequence{}}}),
+			expErr: true,
+		},
+		"empty record": {
+			src:    mustWriteGenesisRecords(t, &types.GenesisStreamRecord{}),
+			expErr: true,
+		},
+		"truncated record": {
+			src:    mustWriteGenesisRecords(t, codeRecord)[:100],
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			gotMaxCodeID, gotNumContracts, gotErr := InitGenesisStream(ctx, keeper, bytes.NewReader(spec.src))
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMaxCodeID, gotMaxCodeID)
+			assert.Equal(t, spec.expNumContracts, gotNumContracts)
+		})
+	}
+}
+
+func TestInitGenesisWithStreamRejectsJSONState(t *testing.T) {
+	keeper, ctx, _ := setupKeeper(t)
+	keeper.genesisStreamFile = filepath.Join(t.TempDir(), "wasm_genesis.bin")
+	require.NoError(t, os.WriteFile(keeper.genesisStreamFile, nil, 0o600))
+	state := types.GenesisState{
+		Params:    types.DefaultParams(),
+		Sequences: []types.Sequence{{IDKey: types.KeyLastCodeID, Value: 1}},
+	}
+	_, err := InitGenesis(ctx, keeper, state, &StakingKeeperMock{}, nil)
+	require.Error(t, err)
+}
+
+// BenchmarkGenesisExport compares the memory retained by the JSON genesis export with the streamed export
+// for a contract with a growing number of state entries. The streamed export retains no state.
+func BenchmarkGenesisExport(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		keeper, ctx, _ := setupKeeper(b)
+		keeper.SetParams(ctx, types.DefaultParams())
+		seedGenesisStreamState(b, keeper, ctx, 1, n)
+
+		b.Run(fmt.Sprintf("json %d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			var retained uint64
+			for i := 0; i < b.N; i++ {
+				before := heapAlloc()
+				state := ExportGenesis(ctx, keeper)
+				if after := heapAlloc(); after > before {
+					retained += after - before
+				}
+				runtime.KeepAlive(state)
+			}
+			b.ReportMetric(float64(retained)/float64(b.N), "retained-B/op")
+		})
+		b.Run(fmt.Sprintf("stream %d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			var retained uint64
+			for i := 0; i < b.N; i++ {
+				before := heapAlloc()
+				require.NoError(b, ExportGenesisStream(ctx, keeper, io.Discard))
+				if after := heapAlloc(); after > before {
+					retained += after - before
+				}
+			}
+			b.ReportMetric(float64(retained)/float64(b.N), "retained-B/op")
+		})
+	}
+}
+
+// BenchmarkGenesisStreamImport imports a streamed genesis with a growing number of contract state entries
+func BenchmarkGenesisStreamImport(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		keeper, ctx, _ := setupKeeper(b)
+		keeper.SetParams(ctx, types.DefaultParams())
+		seedGenesisStreamState(b, keeper, ctx, 1, n)
+		var buf bytes.Buffer
+		require.NoError(b, ExportGenesisStream(ctx, keeper, &buf))
+
+		b.Run(fmt.Sprintf("stream %d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				dstKeeper, dstCtx, _ := setupKeeper(b)
+				b.StartTimer()
+				_, _, err := InitGenesisStream(dstCtx, dstKeeper, bytes.NewReader(buf.Bytes()))
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// seedGenesisStreamState stores hackatom once per contract and the contracts with the given number of state entries
+func seedGenesisStreamState(t testing.TB, keeper *Keeper, ctx sdk.Context, contracts, models int) {
+	t.Helper()
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	contractKeeper := NewGovPermissionKeeper(keeper)
+	creator := RandomAccountAddress(t)
+	for i := 0; i < contracts; i++ {
+		codeID, _, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+		require.NoError(t, err)
+		contractAddr := keeper.ClassicAddressGenerator()(ctx, codeID, nil)
+		contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+			info.CodeID = codeID
+		})
+		require.NoError(t, keeper.importContract(ctx, contractAddr, &contractInfo, nil))
+		state := make([]types.Model, models)
+		for j := range state {
+			state[j] = types.Model{Key: []byte(fmt.Sprintf("key-%08d", j)), Value: bytes.Repeat([]byte{byte(j)}, 64)}
+		}
+		require.NoError(t, keeper.importContractState(ctx, contractAddr, state))
+	}
+}
+
+func mustWriteGenesisRecords(t *testing.T, records ...*types.GenesisStreamRecord) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := protoio.NewDelimitedWriter(&buf)
+	for _, r := range records {
+		require.NoError(t, w.WriteMsg(r))
+	}
+	return buf.Bytes()
+}
+
+func heapAlloc() uint64 {
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/Finschia/finschia-sdk/store"
 	"github.com/Finschia/finschia-sdk/store/prefix"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	authkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	bankpluskeeper "github.com/Finschia/finschia-sdk/x/bankplus/keeper"
 	distributionkeeper "github.com/Finschia/finschia-sdk/x/distribution/keeper"
@@ -31,6 +32,7 @@ import (
 	stakingkeeper "github.com/Finschia/finschia-sdk/x/staking/keeper"
 	"github.com/Finschia/ostracon/libs/log"
 
+	"github.com/Finschia/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
@@ -85,6 +87,16 @@ func TestGenesisExportImport(t *testing.T) {
 		wasmKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		if i == 0 {
+			wasmKeeper.storeContractTimelock(srcCtx, contractAddr, 10)
+			wasmKeeper.storeTimelockedOperation(srcCtx, contractAddr, types.TimelockedOperation{
+				ID:              wasmKeeper.autoIncrementID(srcCtx, types.KeyLastTimelockedOperationID),
+				ContractAddress: contractAddr.String(),
+				Sender:          RandomBech32AccountAddress(t),
+				ExecuteAtHeight: 100,
+				Type:            types.TimelockedOperationTypeClearAdmin,
+			})
+		}
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -152,6 +164,483 @@ func TestGenesisExportImport(t *testing.T) {
 	}
 }
 
+func TestGenesisExportFilter(t *testing.T) {
+	wasmKeeper, ctx, _ := setupKeeper(t)
+	contractKeeper := NewGovPermissionKeeper(wasmKeeper)
+	wasmKeeper.SetParams(ctx, types.DefaultParams())
+
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	creator := RandomAccountAddress(t)
+	var contracts []sdk.AccAddress
+	for i := 0; i < 2; i++ {
+		codeID, _, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+		require.NoError(t, err)
+		contractAddr := wasmKeeper.ClassicAddressGenerator()(ctx, codeID, nil)
+		contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+			info.CodeID = codeID
+		})
+		wasmKeeper.storeContractInfo(ctx, contractAddr, &contractInfo)
+		require.NoError(t, wasmKeeper.importContractState(ctx, contractAddr, []types.Model{{Key: []byte("foo"), Value: []byte("bar")}}))
+		wasmKeeper.storeBlockHook(ctx, contractAddr, types.BlockHook{ContractAddress: contractAddr.String(), Phase: types.BlockHookPhaseEndBlock, GasLimit: 1})
+		wasmKeeper.storeStorageDeposit(ctx, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+		contracts = append(contracts, contractAddr)
+	}
+
+	specs := map[string]struct {
+		filter       GenesisExportFilter
+		expContracts []sdk.AccAddress
+		expNoState   []sdk.AccAddress
+		expStripped  bool
+	}{
+		"no filter": {
+			expContracts: contracts,
+		},
+		"exclude state for code": {
+			filter:       GenesisExportFilter{ExcludeStateForCodes: []uint64{1}},
+			expContracts: contracts,
+			expNoState:   contracts[:1],
+		},
+		"only contracts": {
+			filter:       GenesisExportFilter{OnlyContracts: contracts[1:]},
+			expContracts: contracts[1:],
+		},
+		"strip code bytes": {
+			filter:       GenesisExportFilter{StripCodeBytes: true},
+			expContracts: contracts,
+			expStripped:  true,
+		},
+		"all": {
+			filter: GenesisExportFilter{
+				ExcludeStateForCodes: []uint64{1, 2},
+				OnlyContracts:        contracts[:1],
+				StripCodeBytes:       true,
+			},
+			expContracts: contracts[:1],
+			expNoState:   contracts[:1],
+			expStripped:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			wasmKeeper.genesisExportFilter = spec.filter
+			// when
+			state := ExportGenesis(ctx, wasmKeeper)
+			// then
+			require.NoError(t, state.ValidateBasic())
+			require.Len(t, state.Codes, 2)
+			for _, c := range state.Codes {
+				assert.Equal(t, spec.expStripped, len(c.CodeBytes) == 0)
+			}
+			var expAddrs, gotAddrs []string
+			for _, a := range spec.expContracts {
+				expAddrs = append(expAddrs, a.String())
+			}
+			for _, c := range state.Contracts {
+				gotAddrs = append(gotAddrs, c.ContractAddress)
+			}
+			assert.ElementsMatch(t, expAddrs, gotAddrs)
+			var gotHookAddrs []string
+			for _, h := range state.BlockHooks {
+				gotHookAddrs = append(gotHookAddrs, h.ContractAddress)
+			}
+			assert.ElementsMatch(t, expAddrs, gotHookAddrs)
+			for _, c := range state.Contracts {
+				var expNoState bool
+				for _, a := range spec.expNoState {
+					expNoState = expNoState || a.String() == c.ContractAddress
+				}
+				assert.Equal(t, expNoState, len(c.ContractState) == 0)
+				// the deposit is dropped with the state
+				assert.Equal(t, expNoState, c.StorageDeposit.Empty())
+			}
+			assert.Len(t, state.Sequences, 3)
+		})
+	}
+}
+
+func TestGenesisBlockHooks(t *testing.T) {
+	srcKeeper, srcCtx, _ := setupKeeper(t)
+	srcKeeper.SetParams(srcCtx, types.DefaultParams())
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, _, err := NewGovPermissionKeeper(srcKeeper).Create(srcCtx, RandomAccountAddress(t), wasmCode, nil)
+	require.NoError(t, err)
+	contractAddr := srcKeeper.ClassicAddressGenerator()(srcCtx, codeID, nil)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.CodeID = codeID
+	})
+	srcKeeper.storeContractInfo(srcCtx, contractAddr, &contractInfo)
+	myHook := types.BlockHook{ContractAddress: contractAddr.String(), Phase: types.BlockHookPhaseBeginBlock, GasLimit: 100_000, ConsecutiveFailures: 1}
+	srcKeeper.storeBlockHook(srcCtx, contractAddr, myHook)
+	exported := ExportGenesis(srcCtx, srcKeeper)
+	require.Equal(t, []types.BlockHook{myHook}, exported.BlockHooks)
+
+	specs := map[string]struct {
+		src    []types.BlockHook
+		expErr *sdkerrors.Error
+	}{
+		"exported hooks": {
+			src: exported.BlockHooks,
+		},
+		"unknown contract": {
+			src:    []types.BlockHook{{ContractAddress: RandomBech32AccountAddress(t), Phase: types.BlockHookPhaseEndBlock, GasLimit: 1}},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			_, err := keeper.wasmVM.Create(wasmCode)
+			require.NoError(t, err)
+			genesis := *exported
+			genesis.BlockHooks = spec.src
+			// when
+			_, gotErr := InitGenesis(ctx, keeper, genesis, &StakingKeeperMock{}, nil)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &myHook, keeper.GetBlockHook(ctx, myHook.Phase, contractAddr))
+		})
+	}
+}
+
+func TestGenesisPendingAdmins(t *testing.T) {
+	srcKeeper, srcCtx, _ := setupKeeper(t)
+	srcKeeper.SetParams(srcCtx, types.DefaultParams())
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, _, err := NewGovPermissionKeeper(srcKeeper).Create(srcCtx, RandomAccountAddress(t), wasmCode, nil)
+	require.NoError(t, err)
+	contractAddr := srcKeeper.ClassicAddressGenerator()(srcCtx, codeID, nil)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.CodeID = codeID
+	})
+	srcKeeper.storeContractInfo(srcCtx, contractAddr, &contractInfo)
+	myPending := types.PendingAdmin{ContractAddress: contractAddr.String(), NewAdmin: RandomBech32AccountAddress(t), ExpiresAtHeight: 100}
+	srcKeeper.storePendingAdmin(srcCtx, contractAddr, myPending)
+	exported := ExportGenesis(srcCtx, srcKeeper)
+	require.Equal(t, []types.PendingAdmin{myPending}, exported.PendingAdmins)
+
+	specs := map[string]struct {
+		src    []types.PendingAdmin
+		expErr *sdkerrors.Error
+	}{
+		"exported pending admins": {
+			src: exported.PendingAdmins,
+		},
+		"unknown contract": {
+			src:    []types.PendingAdmin{{ContractAddress: RandomBech32AccountAddress(t), NewAdmin: RandomBech32AccountAddress(t)}},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			_, err := keeper.wasmVM.Create(wasmCode)
+			require.NoError(t, err)
+			genesis := *exported
+			genesis.PendingAdmins = spec.src
+			// when
+			_, gotErr := InitGenesis(ctx, keeper, genesis, &StakingKeeperMock{}, nil)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &myPending, keeper.GetPendingAdmin(ctx, contractAddr))
+		})
+	}
+}
+
+func TestGenesisIBCRateLimitOverrides(t *testing.T) {
+	srcKeeper, srcCtx, _ := setupKeeper(t)
+	srcKeeper.SetParams(srcCtx, types.DefaultParams())
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, _, err := NewGovPermissionKeeper(srcKeeper).Create(srcCtx, RandomAccountAddress(t), wasmCode, nil)
+	require.NoError(t, err)
+	contractAddr := srcKeeper.ClassicAddressGenerator()(srcCtx, codeID, nil)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.CodeID = codeID
+	})
+	srcKeeper.storeContractInfo(srcCtx, contractAddr, &contractInfo)
+	myLimit := types.IBCRateLimit{MaxPacketsPerBlock: 1}
+	srcKeeper.storeIBCRateLimitOverride(srcCtx, contractAddr, "channel-0", &myLimit)
+	exported := ExportGenesis(srcCtx, srcKeeper)
+	require.Equal(t, []types.IBCRateLimitOverride{{ContractAddress: contractAddr.String(), ChannelID: "channel-0", Limit: myLimit}}, exported.IBCRateLimitOverrides)
+
+	specs := map[string]struct {
+		src    []types.IBCRateLimitOverride
+		expErr *sdkerrors.Error
+	}{
+		"exported overrides": {
+			src: exported.IBCRateLimitOverrides,
+		},
+		"unknown contract": {
+			src:    []types.IBCRateLimitOverride{{ContractAddress: RandomBech32AccountAddress(t), ChannelID: "channel-0", Limit: myLimit}},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			_, err := keeper.wasmVM.Create(wasmCode)
+			require.NoError(t, err)
+			genesis := *exported
+			genesis.IBCRateLimitOverrides = spec.src
+			// when
+			_, gotErr := InitGenesis(ctx, keeper, genesis, &StakingKeeperMock{}, nil)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, myLimit, keeper.GetIBCRateLimit(ctx, contractAddr, "channel-0"))
+		})
+	}
+}
+
+func TestGenesisStorageDeposits(t *testing.T) {
+	srcKeeper, srcCtx, _ := setupKeeper(t)
+	srcKeeper.SetParams(srcCtx, types.DefaultParams())
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, _, err := NewGovPermissionKeeper(srcKeeper).Create(srcCtx, RandomAccountAddress(t), wasmCode, nil)
+	require.NoError(t, err)
+	contractAddr := srcKeeper.ClassicAddressGenerator()(srcCtx, codeID, nil)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.CodeID = codeID
+	})
+	srcKeeper.storeContractInfo(srcCtx, contractAddr, &contractInfo)
+	require.NoError(t, srcKeeper.importContractState(srcCtx, contractAddr, []types.Model{{Key: []byte("foo"), Value: []byte("bar")}}))
+	myDeposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	srcKeeper.storeStorageDeposit(srcCtx, contractAddr, myDeposit)
+	exported := ExportGenesis(srcCtx, srcKeeper)
+	require.Len(t, exported.Contracts, 1)
+	require.Equal(t, myDeposit, exported.Contracts[0].StorageDeposit)
+
+	specs := map[string]struct {
+		moduleBalance sdk.Coins
+		expErr        *sdkerrors.Error
+	}{
+		"covered by module balance": {
+			moduleBalance: myDeposit,
+		},
+		"covered with surplus": {
+			moduleBalance: sdk.NewCoins(sdk.NewInt64Coin("denom", 11), sdk.NewInt64Coin("other", 1)),
+		},
+		"exceeds module balance": {
+			moduleBalance: sdk.NewCoins(sdk.NewInt64Coin("denom", 9)),
+			expErr:        types.ErrInvalid,
+		},
+		"other denom in module balance": {
+			moduleBalance: sdk.NewCoins(sdk.NewInt64Coin("other", 10)),
+			expErr:        types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			keeper.storageDeposits = &wasmtesting.MockStorageDepositKeeper{StorageDepositBalanceFn: func(sdk.Context) sdk.Coins {
+				return spec.moduleBalance
+			}}
+			_, err := keeper.wasmVM.Create(wasmCode)
+			require.NoError(t, err)
+			// when
+			_, gotErr := InitGenesis(ctx, keeper, *exported, &StakingKeeperMock{}, nil)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, myDeposit, keeper.GetStorageDeposit(ctx, contractAddr))
+		})
+	}
+}
+
+func TestGenesisContractAnteHooks(t *testing.T) {
+	srcKeeper, srcCtx, _ := setupKeeper(t)
+	srcKeeper.SetParams(srcCtx, types.DefaultParams())
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, _, err := NewGovPermissionKeeper(srcKeeper).Create(srcCtx, RandomAccountAddress(t), wasmCode, nil)
+	require.NoError(t, err)
+	contractAddr := srcKeeper.ClassicAddressGenerator()(srcCtx, codeID, nil)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.CodeID = codeID
+	})
+	srcKeeper.storeContractInfo(srcCtx, contractAddr, &contractInfo)
+	srcKeeper.storeContractAnteHook(srcCtx, contractAddr, types.AnteHookAuthentication, true)
+	exported := ExportGenesis(srcCtx, srcKeeper)
+	require.Equal(t, []types.ContractAnteHook{{ContractAddress: contractAddr.String(), Hook: types.AnteHookAuthentication}}, exported.ContractAnteHooks)
+
+	specs := map[string]struct {
+		src    []types.ContractAnteHook
+		expErr *sdkerrors.Error
+	}{
+		"exported hooks": {
+			src: exported.ContractAnteHooks,
+		},
+		"unknown contract": {
+			src:    []types.ContractAnteHook{{ContractAddress: RandomBech32AccountAddress(t), Hook: types.AnteHookAuthentication}},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			_, err := keeper.wasmVM.Create(wasmCode)
+			require.NoError(t, err)
+			genesis := *exported
+			genesis.ContractAnteHooks = spec.src
+			// when
+			_, gotErr := InitGenesis(ctx, keeper, genesis, &StakingKeeperMock{}, nil)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, keeper.HasContractAnteHook(ctx, contractAddr, types.AnteHookAuthentication))
+		})
+	}
+}
+
+func TestGenesisContractTimelocks(t *testing.T) {
+	srcKeeper, srcCtx, _ := setupKeeper(t)
+	srcKeeper.SetParams(srcCtx, types.DefaultParams())
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, _, err := NewGovPermissionKeeper(srcKeeper).Create(srcCtx, RandomAccountAddress(t), wasmCode, nil)
+	require.NoError(t, err)
+	contractAddr := srcKeeper.ClassicAddressGenerator()(srcCtx, codeID, nil)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.CodeID = codeID
+	})
+	srcKeeper.storeContractInfo(srcCtx, contractAddr, &contractInfo)
+	srcKeeper.storeContractTimelock(srcCtx, contractAddr, 10)
+	myOp := types.TimelockedOperation{
+		ID:              srcKeeper.autoIncrementID(srcCtx, types.KeyLastTimelockedOperationID),
+		ContractAddress: contractAddr.String(),
+		Sender:          contractInfo.Admin,
+		ExecuteAtHeight: 100,
+		Type:            types.TimelockedOperationTypeClearAdmin,
+	}
+	srcKeeper.storeTimelockedOperation(srcCtx, contractAddr, myOp)
+	exported := ExportGenesis(srcCtx, srcKeeper)
+	require.Equal(t, []types.ContractTimelock{{ContractAddress: contractAddr.String(), DelayBlocks: 10}}, exported.ContractTimelocks)
+	require.Equal(t, []types.TimelockedOperation{myOp}, exported.TimelockedOperations)
+
+	specs := map[string]struct {
+		mutator func(*types.GenesisState)
+		expErr  *sdkerrors.Error
+	}{
+		"exported timelocks": {
+			mutator: func(*types.GenesisState) {},
+		},
+		"timelock of unknown contract": {
+			mutator: func(s *types.GenesisState) {
+				s.ContractTimelocks = []types.ContractTimelock{{ContractAddress: RandomBech32AccountAddress(t), DelayBlocks: 1}}
+			},
+			expErr: types.ErrNotFound,
+		},
+		"operation of unknown contract": {
+			mutator: func(s *types.GenesisState) {
+				op := myOp
+				op.ContractAddress = RandomBech32AccountAddress(t)
+				s.TimelockedOperations = []types.TimelockedOperation{op}
+			},
+			expErr: types.ErrNotFound,
+		},
+		"operation id sequence too low": {
+			mutator: func(s *types.GenesisState) {
+				op := myOp
+				op.ID = 2
+				s.TimelockedOperations = []types.TimelockedOperation{op}
+			},
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			_, err := keeper.wasmVM.Create(wasmCode)
+			require.NoError(t, err)
+			genesis := *exported
+			spec.mutator(&genesis)
+			// when
+			_, gotErr := InitGenesis(ctx, keeper, genesis, &StakingKeeperMock{}, nil)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, uint64(10), keeper.GetContractTimelock(ctx, contractAddr))
+			assert.Equal(t, &myOp, keeper.GetTimelockedOperation(ctx, contractAddr, myOp.ID))
+		})
+	}
+}
+
+func TestGenesisImportStrippedCode(t *testing.T) {
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	checksum := sha256.Sum256(wasmCode)
+	genesis := types.GenesisState{
+		Params: types.DefaultParams(),
+		Codes: []types.Code{{
+			CodeID: 1,
+			CodeInfo: types.CodeInfoFixture(func(info *types.CodeInfo) {
+				info.CodeHash = checksum[:]
+			}),
+		}},
+		Sequences: []types.Sequence{
+			{IDKey: types.KeyLastCodeID, Value: 2},
+			{IDKey: types.KeyLastInstanceID, Value: 1},
+		},
+	}
+	require.NoError(t, genesis.ValidateBasic())
+
+	specs := map[string]struct {
+		inCache bool
+		expErr  *sdkerrors.Error
+	}{
+		"byte code in wasm cache": {
+			inCache: true,
+		},
+		"byte code not in wasm cache": {
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper, ctx, _ := setupKeeper(t)
+			if spec.inCache {
+				_, err := keeper.wasmVM.Create(wasmCode)
+				require.NoError(t, err)
+			}
+			// when
+			_, gotErr := InitGenesis(ctx, keeper, genesis, &StakingKeeperMock{}, nil)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			gotCode, err := keeper.GetByteCode(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, wasmCode, gotCode)
+		})
+	}
+}
+
 func TestGenesisInit(t *testing.T) {
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -304,6 +793,27 @@ func TestGenesisInit(t *testing.T) {
 			},
 			expSuccess: true,
 		},
+		"happy path: contract with predictable address does not use contract id seq": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: BuildContractAddressPredictable(myCodeInfo.CodeHash, RandomAccountAddress(t), []byte("salt"), nil).String(),
+						ContractInfo:    types.ContractInfoFixture(func(c *types.ContractInfo) { c.CodeID = 1 }, types.OnlyGenesisFields),
+					},
+				},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 2},
+					{IDKey: types.KeyLastInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
 		"prevent contracts that points to non existing codeID": {
 			src: types.GenesisState{
 				Contracts: []types.Contract{
@@ -382,7 +892,7 @@ func TestGenesisInit(t *testing.T) {
 				Params: types.DefaultParams(),
 			},
 		},
-		"prevent contract id seq init value == count contracts": {
+		"prevent contract id seq init value of taken contract address": {
 			src: types.GenesisState{
 				Codes: []types.Code{{
 					CodeID:    firstCodeID,
@@ -634,7 +1144,7 @@ func TestSupportedGenMsgTypes(t *testing.T) {
 	assert.Equal(t, sdk.NewCoin(denom, sdk.NewInt(10)), gotBalance)
 }
 
-func setupKeeper(t *testing.T) (*Keeper, sdk.Context, []sdk.StoreKey) {
+func setupKeeper(t testing.TB) (*Keeper, sdk.Context, []sdk.StoreKey) {
 	t.Helper()
 	tempDir, err := os.MkdirTemp("", "wasm")
 	require.NoError(t, err)
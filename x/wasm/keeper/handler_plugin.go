@@ -21,6 +21,13 @@ type msgEncoder interface {
 	Encode(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Msg, error)
 }
 
+// IBCPacketRateLimiter is an extension point to restrict the raw IBC packets that a contract can send on a channel
+type IBCPacketRateLimiter interface {
+	// ConsumeIBCPacketQuota accounts the packet against the quota of the contract on the channel.
+	// An error is returned when the packet exceeds the limits.
+	ConsumeIBCPacketQuota(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, dataLen uint64) error
+}
+
 // MessageRouter ADR 031 request type routing
 type MessageRouter interface {
 	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
@@ -40,6 +47,18 @@ func NewDefaultMessageHandler(
 	unpacker codectypes.AnyUnpacker,
 	portSource types.ICS20TransferPortSource,
 	customEncoders ...*MessageEncoders,
+) Messenger {
+	return newDefaultMessageHandler(router, NewIBCRawPacketHandler(channelKeeper, capabilityKeeper), bankKeeper, unpacker, portSource, customEncoders...)
+}
+
+// newDefaultMessageHandler builds the default handler chain with the given handler for raw IBC packets
+func newDefaultMessageHandler(
+	router MessageRouter,
+	ibcRawPacketHandler Messenger,
+	bankKeeper types.Burner,
+	unpacker codectypes.AnyUnpacker,
+	portSource types.ICS20TransferPortSource,
+	customEncoders ...*MessageEncoders,
 ) Messenger {
 	encoders := DefaultEncoders(unpacker, portSource)
 	for _, e := range customEncoders {
@@ -47,7 +66,7 @@ func NewDefaultMessageHandler(
 	}
 	return NewMessageHandlerChain(
 		NewSDKMessageHandler(router, encoders),
-		NewIBCRawPacketHandler(channelKeeper, capabilityKeeper),
+		ibcRawPacketHandler,
 		NewBurnCoinMessageHandler(bankKeeper),
 	)
 }
@@ -191,6 +210,28 @@ func (h IBCRawPacketHandler) DispatchMsg(ctx sdk.Context, _ sdk.AccAddress, cont
 	return nil, nil, h.channelKeeper.SendPacket(ctx, channelCap, packet)
 }
 
+// IBCPacketRateLimitHandler decorates the handler for IBC.SendPacket messages to account the raw packets
+// against the quotas of the rate limiter before they are published.
+type IBCPacketRateLimitHandler struct {
+	nested      Messenger
+	rateLimiter IBCPacketRateLimiter
+}
+
+func NewIBCPacketRateLimitHandler(nested Messenger, rl IBCPacketRateLimiter) IBCPacketRateLimitHandler {
+	return IBCPacketRateLimitHandler{nested: nested, rateLimiter: rl}
+}
+
+// DispatchMsg consumes the packet quota of the contract on the channel and delegates to the nested handler.
+func (h IBCPacketRateLimitHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+	if msg.IBC == nil || msg.IBC.SendPacket == nil {
+		return nil, nil, types.ErrUnknownMsg
+	}
+	if err := h.rateLimiter.ConsumeIBCPacketQuota(ctx, contractAddr, msg.IBC.SendPacket.ChannelID, uint64(len(msg.IBC.SendPacket.Data))); err != nil {
+		return nil, nil, err
+	}
+	return h.nested.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
 var _ Messenger = MessageHandlerFunc(nil)
 
 // MessageHandlerFunc is a helper to construct a function based message handler.
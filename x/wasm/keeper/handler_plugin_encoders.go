@@ -197,7 +197,8 @@ func EncodeStargateMsg(unpacker codectypes.AnyUnpacker) StargateEncoder {
 			Value:   msg.Value,
 		}
 		var sdkMsg sdk.Msg
-		if err := unpacker.UnpackAny(&any, &sdkMsg); err != nil {
+		// an empty type url is unpacked without error into a nil msg
+		if err := unpacker.UnpackAny(&any, &sdkMsg); err != nil || sdkMsg == nil {
 			return nil, sdkerrors.Wrap(types.ErrInvalidMsg, fmt.Sprintf("Cannot unpack proto message with type URL: %s", msg.TypeURL))
 		}
 		if err := codectypes.UnpackInterfaces(sdkMsg, unpacker); err != nil {
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -380,6 +381,13 @@ func TestEncoding(t *testing.T) {
 			},
 			isError: true,
 		},
+		"stargate encoded empty typeUrl": {
+			sender: addr2,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{},
+			},
+			isError: true,
+		},
 		"IBC transfer with block timeout": {
 			sender:             addr1,
 			srcContractIBCPort: "myIBCPort",
@@ -581,6 +589,33 @@ func TestEncoding(t *testing.T) {
 	}
 }
 
+func FuzzMessageEncodersEncode(f *testing.F) {
+	f.Add([]byte(`{"bank":{"send":{"to_address":"link1","amount":[{"denom":"foo","amount":"1"}]}}}`))
+	f.Add([]byte(`{"wasm":{"execute":{"contract_addr":"link1","msg":"e30=","funds":[]}}}`))
+	f.Add([]byte(`{"stargate":{"type_url":"/cosmos.bank.v1beta1.MsgSend","value":""}}`))
+	f.Add([]byte(`{"ibc":{"transfer":{"channel_id":"channel-0","to_address":"link1","amount":{"denom":"foo","amount":"1"},"timeout":{}}}}`))
+	f.Add([]byte(`{}`))
+	encodingConfig := MakeEncodingConfig(f)
+	encoder := DefaultEncoders(encodingConfig.Marshaler, wasmtesting.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}})
+	sender := RandomAccountAddress(f)
+	f.Fuzz(func(t *testing.T, src []byte) {
+		var msg wasmvmtypes.CosmosMsg
+		if err := json.Unmarshal(src, &msg); err != nil {
+			return
+		}
+		var ctx sdk.Context
+		res, err := encoder.Encode(ctx, sender, "myIBCPort", msg)
+		if err != nil {
+			return
+		}
+		for _, m := range res {
+			require.NotNil(t, m)
+		}
+	})
+}
+
 func TestConvertWasmCoinToSdkCoin(t *testing.T) {
 	specs := map[string]struct {
 		src    wasmvmtypes.Coin
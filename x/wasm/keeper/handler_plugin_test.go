@@ -322,6 +322,75 @@ func TestIBCRawPacketHandler(t *testing.T) {
 	}
 }
 
+func TestIBCPacketRateLimitHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	sendPacketMsg := wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{SendPacket: &wasmvmtypes.SendPacketMsg{
+		ChannelID: "channel-1",
+		Data:      []byte("myData"),
+	}}}
+	specs := map[string]struct {
+		msg          wasmvmtypes.CosmosMsg
+		rateLimitErr error
+		expQuota     uint64
+		expDispatch  bool
+		expErr       *sdkerrors.Error
+	}{
+		"send packet within limits": {
+			msg:         sendPacketMsg,
+			expQuota:    6,
+			expDispatch: true,
+		},
+		"send packet exceeds limits": {
+			msg:          sendPacketMsg,
+			rateLimitErr: types.ErrIBCRateLimitExceeded,
+			expQuota:     6,
+			expErr:       types.ErrIBCRateLimitExceeded,
+		},
+		"other messages are skipped": {
+			msg:    wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{CloseChannel: &wasmvmtypes.CloseChannelMsg{ChannelID: "channel-1"}}},
+			expErr: types.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotQuota uint64
+			var gotChannelID string
+			rateLimiter := wasmtesting.MockIBCPacketRateLimiter{
+				ConsumeIBCPacketQuotaFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, dataLen uint64) error {
+					assert.Equal(t, myContractAddr, contractAddr)
+					gotChannelID, gotQuota = channelID, dataLen
+					return spec.rateLimitErr
+				},
+			}
+			var dispatched bool
+			nested := MessageHandlerFunc(func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+				dispatched = true
+				return nil, nil, nil
+			})
+			// when
+			h := NewIBCPacketRateLimitHandler(nested, rateLimiter)
+			_, _, gotErr := h.DispatchMsg(sdk.Context{}, myContractAddr, "contractsIBCPort", spec.msg)
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+			assert.Equal(t, spec.expDispatch, dispatched)
+			assert.Equal(t, spec.expQuota, gotQuota)
+			if spec.expQuota != 0 {
+				assert.Equal(t, "channel-1", gotChannelID)
+			}
+		})
+	}
+}
+
+func TestDefaultKeeperMessengerRateLimitsIBCPackets(t *testing.T) {
+	_, keepers := CreateDefaultTestInput(t)
+	chain, ok := keepers.WasmKeeper.messenger.(*MessageHandlerChain)
+	require.True(t, ok)
+	require.Len(t, chain.handlers, 3)
+	h, ok := chain.handlers[1].(IBCPacketRateLimitHandler)
+	require.True(t, ok, "got %T", chain.handlers[1])
+	assert.IsType(t, IBCRawPacketHandler{}, h.nested)
+}
+
 func TestBurnCoinMessageHandlerIntegration(t *testing.T) {
 	// testing via full keeper setup so that we are confident the
 	// module permissions are set correct and no other handler
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+var _ IBCPacketRateLimiter = Keeper{}
+
+// GetIBCRateLimit returns the IBC rate limit of the contract on the channel. The override of the channel is
+// preferred over the override of all channels of the contract and that over the param.
+func (k Keeper) GetIBCRateLimit(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string) types.IBCRateLimit {
+	store := ctx.KVStore(k.storeKey)
+	for _, c := range []string{channelID, ""} {
+		if bz := store.Get(types.GetIBCRateLimitOverrideKey(contractAddr, c)); bz != nil {
+			var l types.IBCRateLimit
+			k.cdc.MustUnmarshal(bz, &l)
+			return l
+		}
+	}
+	var l types.IBCRateLimit
+	k.paramSpace.Get(ctx, types.ParamStoreKeyIBCRateLimit, &l)
+	return l
+}
+
+// IterateIBCRateLimitOverrides iterates through all IBC rate limit overrides ordered by contract address and channel.
+// The callback method can return true to abort early.
+func (k Keeper) IterateIBCRateLimitOverrides(ctx sdk.Context, cb func(types.IBCRateLimitOverride) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCRateLimitOverridePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		contractAddr := sdk.AccAddress(key[1 : 1+key[0]])
+		o := types.IBCRateLimitOverride{
+			ContractAddress: contractAddr.String(),
+			ChannelID:       string(key[1+key[0]:]),
+		}
+		k.cdc.MustUnmarshal(iter.Value(), &o.Limit)
+		// cb returns true to stop early
+		if cb(o) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeIBCRateLimitOverride(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, limit *types.IBCRateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	if limit == nil {
+		store.Delete(types.GetIBCRateLimitOverrideKey(contractAddr, channelID))
+		return
+	}
+	store.Set(types.GetIBCRateLimitOverrideKey(contractAddr, channelID), k.cdc.MustMarshal(limit))
+}
+
+// setIBCRateLimit overrides the IBC rate limit param for the contract on the channel. An empty channel applies to
+// all channels of the contract without an own override. A nil limit removes the override.
+func (k Keeper) setIBCRateLimit(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, limit *types.IBCRateLimit) error {
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if err := types.ValidateIBCChannelID(channelID); err != nil {
+		return err
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+	}
+	if limit != nil {
+		if err := limit.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "limit")
+		}
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyMaxPacketsPerBlock, strconv.FormatUint(limit.MaxPacketsPerBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaxBytesPerWindow, strconv.FormatUint(limit.MaxBytesPerWindow, 10)),
+			sdk.NewAttribute(types.AttributeKeyWindowBlocks, strconv.FormatUint(limit.WindowBlocks, 10)),
+		)
+	}
+	k.storeIBCRateLimitOverride(ctx, contractAddr, channelID, limit)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpdateIBCRateLimit, attrs...))
+	return nil
+}
+
+// GetIBCPacketUsage returns the raw IBC packet quota that a contract has consumed on the given channel
+// within the current block and window.
+func (k Keeper) GetIBCPacketUsage(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string) types.IBCPacketUsage {
+	usage := k.getStoredIBCPacketUsage(ctx, contractAddr, channelID)
+	height := ctx.BlockHeight()
+	if usage.BlockHeight != height {
+		usage.BlockHeight = height
+		usage.PacketsInBlock = 0
+	}
+	limit := k.GetIBCRateLimit(ctx, contractAddr, channelID)
+	if limit.WindowBlocks == 0 || usage.WindowStartHeight+int64(limit.WindowBlocks) <= height {
+		usage.WindowStartHeight = height
+		usage.BytesInWindow = 0
+	}
+	return usage
+}
+
+func (k Keeper) getStoredIBCPacketUsage(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string) types.IBCPacketUsage {
+	var usage types.IBCPacketUsage
+	if bz := ctx.KVStore(k.storeKey).Get(types.GetIBCPacketUsageKey(contractAddr, channelID)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+	return usage
+}
+
+// ConsumeIBCPacketQuota accounts a raw IBC packet of the given data length against the contract's quota on the channel.
+// It returns ErrIBCRateLimitExceeded when the packet would exceed the IBC rate limit of the contract on the channel.
+func (k Keeper) ConsumeIBCPacketQuota(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, dataLen uint64) error {
+	limit := k.GetIBCRateLimit(ctx, contractAddr, channelID)
+	if limit.IsDisabled() {
+		return nil
+	}
+	usage := k.GetIBCPacketUsage(ctx, contractAddr, channelID)
+	if limit.MaxPacketsPerBlock != 0 && usage.PacketsInBlock >= limit.MaxPacketsPerBlock {
+		return sdkerrors.Wrapf(types.ErrIBCRateLimitExceeded, "max %d packets per block on channel %s", limit.MaxPacketsPerBlock, channelID)
+	}
+	if limit.MaxBytesPerWindow != 0 && (dataLen > limit.MaxBytesPerWindow || usage.BytesInWindow > limit.MaxBytesPerWindow-dataLen) {
+		return sdkerrors.Wrapf(types.ErrIBCRateLimitExceeded, "max %d bytes per %d blocks on channel %s", limit.MaxBytesPerWindow, limit.WindowBlocks, channelID)
+	}
+	usage.PacketsInBlock++
+	usage.BytesInWindow += dataLen
+
+	// the usage is outdated once the block and the window are over
+	pruneHeight := usage.BlockHeight + 1
+	if windowEnd := usage.WindowStartHeight + int64(limit.WindowBlocks); windowEnd > pruneHeight {
+		pruneHeight = windowEnd
+	}
+	store := ctx.KVStore(k.storeKey)
+	if old := k.getStoredIBCPacketUsage(ctx, contractAddr, channelID); old.PruneHeight != 0 {
+		store.Delete(types.GetIBCPacketUsagePruneQueueKey(old.PruneHeight, contractAddr, channelID))
+	}
+	usage.PruneHeight = pruneHeight
+	store.Set(types.GetIBCPacketUsageKey(contractAddr, channelID), k.cdc.MustMarshal(&usage))
+	store.Set(types.GetIBCPacketUsagePruneQueueKey(pruneHeight, contractAddr, channelID), []byte{})
+	return nil
+}
+
+// PruneIBCPacketUsages removes the IBC packet usages that are outdated from the next block on. A usage is kept for
+// the window of the IBC rate limit at the time of its last packet.
+func (k Keeper) PruneIBCPacketUsages(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	func() {
+		end := types.GetIBCPacketUsagePruneQueuePrefix(ctx.BlockHeight() + 2)
+		iter := store.Iterator(types.IBCPacketUsagePruneQueuePrefix, end)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+	for _, key := range keys {
+		store.Delete(key)
+		// the queue key ends with the usage key without its prefix
+		usageKey := append(sdk.CopyBytes(types.IBCPacketUsagePrefix), key[len(types.IBCPacketUsagePruneQueuePrefix)+8:]...)
+		store.Delete(usageKey)
+	}
+}
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestConsumeIBCPacketQuota(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	const myChannel = "channel-0"
+
+	specs := map[string]struct {
+		limit    types.IBCRateLimit
+		usage    *types.IBCPacketUsage
+		height   int64
+		dataLen  uint64
+		expErr   bool
+		expUsage types.IBCPacketUsage
+	}{
+		"disabled": {
+			height:   10,
+			dataLen:  100,
+			expUsage: types.IBCPacketUsage{BlockHeight: 10, WindowStartHeight: 10},
+		},
+		"first packet": {
+			limit:    types.IBCRateLimit{MaxPacketsPerBlock: 1, MaxBytesPerWindow: 100, WindowBlocks: 5},
+			height:   10,
+			dataLen:  100,
+			expUsage: types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10, BytesInWindow: 100, PruneHeight: 15},
+		},
+		"packets per block exceeded": {
+			limit:    types.IBCRateLimit{MaxPacketsPerBlock: 1},
+			usage:    &types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10},
+			height:   10,
+			expErr:   true,
+			expUsage: types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10},
+		},
+		"packets per block reset in new block": {
+			limit:    types.IBCRateLimit{MaxPacketsPerBlock: 1},
+			usage:    &types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10},
+			height:   11,
+			expUsage: types.IBCPacketUsage{BlockHeight: 11, PacketsInBlock: 1, WindowStartHeight: 11, PruneHeight: 12},
+		},
+		"bytes per window exceeded": {
+			limit:    types.IBCRateLimit{MaxBytesPerWindow: 100, WindowBlocks: 5},
+			usage:    &types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10, BytesInWindow: 60},
+			height:   14,
+			dataLen:  41,
+			expErr:   true,
+			expUsage: types.IBCPacketUsage{BlockHeight: 14, WindowStartHeight: 10, BytesInWindow: 60},
+		},
+		"bytes per window within limit": {
+			limit:    types.IBCRateLimit{MaxBytesPerWindow: 100, WindowBlocks: 5},
+			usage:    &types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10, BytesInWindow: 60},
+			height:   14,
+			dataLen:  40,
+			expUsage: types.IBCPacketUsage{BlockHeight: 14, PacketsInBlock: 1, WindowStartHeight: 10, BytesInWindow: 100, PruneHeight: 15},
+		},
+		"bytes per window reset in new window": {
+			limit:    types.IBCRateLimit{MaxBytesPerWindow: 100, WindowBlocks: 5},
+			usage:    &types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10, BytesInWindow: 100},
+			height:   15,
+			dataLen:  100,
+			expUsage: types.IBCPacketUsage{BlockHeight: 15, PacketsInBlock: 1, WindowStartHeight: 15, BytesInWindow: 100, PruneHeight: 20},
+		},
+		"single packet larger than window": {
+			limit:    types.IBCRateLimit{MaxBytesPerWindow: 100, WindowBlocks: 5},
+			height:   10,
+			dataLen:  101,
+			expErr:   true,
+			expUsage: types.IBCPacketUsage{BlockHeight: 10, WindowStartHeight: 10},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			params := types.DefaultParams()
+			params.IBCRateLimit = spec.limit
+			k.SetParams(ctx, params)
+			if spec.usage != nil {
+				ctx.KVStore(k.storeKey).Set(types.GetIBCPacketUsageKey(myContractAddr, myChannel), k.cdc.MustMarshal(spec.usage))
+			}
+			ctx = ctx.WithBlockHeight(spec.height)
+
+			// when
+			gotErr := k.ConsumeIBCPacketQuota(ctx, myContractAddr, myChannel, spec.dataLen)
+
+			// then
+			if spec.expErr {
+				require.ErrorIs(t, gotErr, types.ErrIBCRateLimitExceeded)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			assert.Equal(t, spec.expUsage, k.GetIBCPacketUsage(ctx, myContractAddr, myChannel))
+			// other channels are not affected
+			assert.Equal(t, types.IBCPacketUsage{BlockHeight: spec.height, WindowStartHeight: spec.height}, k.GetIBCPacketUsage(ctx, myContractAddr, "channel-1"))
+		})
+	}
+}
+
+func TestGetIBCRateLimit(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	paramLimit := types.IBCRateLimit{MaxPacketsPerBlock: 1}
+	contractLimit := types.IBCRateLimit{MaxPacketsPerBlock: 2}
+	channelLimit := types.IBCRateLimit{MaxPacketsPerBlock: 3}
+
+	specs := map[string]struct {
+		overrides map[string]types.IBCRateLimit
+		expLimit  types.IBCRateLimit
+	}{
+		"param": {
+			expLimit: paramLimit,
+		},
+		"contract override": {
+			overrides: map[string]types.IBCRateLimit{"": contractLimit},
+			expLimit:  contractLimit,
+		},
+		"channel override": {
+			overrides: map[string]types.IBCRateLimit{"channel-0": channelLimit},
+			expLimit:  channelLimit,
+		},
+		"channel override preferred over contract override": {
+			overrides: map[string]types.IBCRateLimit{"": contractLimit, "channel-0": channelLimit},
+			expLimit:  channelLimit,
+		},
+		"override of other channel ignored": {
+			overrides: map[string]types.IBCRateLimit{"channel-1": channelLimit},
+			expLimit:  paramLimit,
+		},
+		"override disables param": {
+			overrides: map[string]types.IBCRateLimit{"channel-0": {}},
+			expLimit:  types.IBCRateLimit{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			params := types.DefaultParams()
+			params.IBCRateLimit = paramLimit
+			k.SetParams(ctx, params)
+			for channelID, limit := range spec.overrides {
+				limit := limit
+				k.storeIBCRateLimitOverride(ctx, myContractAddr, channelID, &limit)
+			}
+			// other contracts are not affected
+			k.storeIBCRateLimitOverride(ctx, RandomAccountAddress(t), "channel-0", &types.IBCRateLimit{MaxPacketsPerBlock: 4})
+
+			// when
+			gotLimit := k.GetIBCRateLimit(ctx, myContractAddr, "channel-0")
+
+			// then
+			assert.Equal(t, spec.expLimit, gotLimit)
+		})
+	}
+}
+
+func TestSetIBCRateLimit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	myContractAddr := RandomAccountAddress(t)
+	contractInfo := types.ContractInfoFixture()
+	k.storeContractInfo(ctx, myContractAddr, &contractInfo)
+
+	specs := map[string]struct {
+		contractAddr sdk.AccAddress
+		channelID    string
+		limit        *types.IBCRateLimit
+		expErr       bool
+		expOverrides []types.IBCRateLimitOverride
+	}{
+		"set channel override": {
+			contractAddr: myContractAddr,
+			channelID:    "channel-0",
+			limit:        &types.IBCRateLimit{MaxPacketsPerBlock: 1},
+			expOverrides: []types.IBCRateLimitOverride{
+				{ContractAddress: myContractAddr.String(), ChannelID: "channel-0", Limit: types.IBCRateLimit{MaxPacketsPerBlock: 1}},
+			},
+		},
+		"set contract override": {
+			contractAddr: myContractAddr,
+			limit:        &types.IBCRateLimit{MaxBytesPerWindow: 100, WindowBlocks: 5},
+			expOverrides: []types.IBCRateLimitOverride{
+				{ContractAddress: myContractAddr.String(), Limit: types.IBCRateLimit{MaxBytesPerWindow: 100, WindowBlocks: 5}},
+				{ContractAddress: myContractAddr.String(), ChannelID: "channel-0", Limit: types.IBCRateLimit{MaxPacketsPerBlock: 1}},
+			},
+		},
+		"remove channel override": {
+			contractAddr: myContractAddr,
+			channelID:    "channel-0",
+			expOverrides: []types.IBCRateLimitOverride{
+				{ContractAddress: myContractAddr.String(), Limit: types.IBCRateLimit{MaxBytesPerWindow: 100, WindowBlocks: 5}},
+			},
+		},
+		"unknown contract": {
+			contractAddr: RandomAccountAddress(t),
+			limit:        &types.IBCRateLimit{MaxPacketsPerBlock: 1},
+			expErr:       true,
+		},
+		"invalid channel": {
+			contractAddr: myContractAddr,
+			channelID:    "x",
+			limit:        &types.IBCRateLimit{MaxPacketsPerBlock: 1},
+			expErr:       true,
+		},
+		"invalid limit": {
+			contractAddr: myContractAddr,
+			limit:        &types.IBCRateLimit{MaxBytesPerWindow: 100},
+			expErr:       true,
+		},
+	}
+	// the specs build on each other
+	for _, name := range []string{"set channel override", "set contract override", "remove channel override", "unknown contract", "invalid channel", "invalid limit"} {
+		spec := specs[name]
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.setIBCRateLimit(ctx.WithEventManager(em), spec.contractAddr, spec.channelID, spec.limit)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Len(t, em.Events(), 0)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeUpdateIBCRateLimit, em.Events()[0].Type)
+			var gotOverrides []types.IBCRateLimitOverride
+			k.IterateIBCRateLimitOverrides(ctx, func(o types.IBCRateLimitOverride) bool {
+				gotOverrides = append(gotOverrides, o)
+				return false
+			})
+			assert.Equal(t, spec.expOverrides, gotOverrides)
+		})
+	}
+}
+
+func TestPruneIBCPacketUsages(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	myContractAddr := RandomAccountAddress(t)
+	params := types.DefaultParams()
+	params.IBCRateLimit = types.IBCRateLimit{MaxPacketsPerBlock: 10, MaxBytesPerWindow: 100, WindowBlocks: 5}
+	k.SetParams(ctx, params)
+	// no window for channel-1
+	k.storeIBCRateLimitOverride(ctx, myContractAddr, "channel-1", &types.IBCRateLimit{MaxPacketsPerBlock: 10})
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.ConsumeIBCPacketQuota(ctx, myContractAddr, "channel-0", 1))
+	require.NoError(t, k.ConsumeIBCPacketQuota(ctx, myContractAddr, "channel-1", 1))
+	// a later packet within the window moves the prune height of the usage
+	ctx = ctx.WithBlockHeight(14)
+	require.NoError(t, k.ConsumeIBCPacketQuota(ctx, myContractAddr, "channel-0", 1))
+
+	hasUsage := func(channelID string) bool {
+		return ctx.KVStore(k.storeKey).Has(types.GetIBCPacketUsageKey(myContractAddr, channelID))
+	}
+	countQueue := func() int {
+		var n int
+		iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCPacketUsagePruneQueuePrefix).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			n++
+		}
+		return n
+	}
+	require.Equal(t, 2, countQueue())
+
+	// when pruned before the end of the window
+	k.PruneIBCPacketUsages(ctx.WithBlockHeight(13))
+	// then only the usage without window is gone
+	assert.True(t, hasUsage("channel-0"))
+	assert.False(t, hasUsage("channel-1"))
+	assert.Equal(t, 1, countQueue())
+
+	// when pruned in the last block of the window
+	k.PruneIBCPacketUsages(ctx.WithBlockHeight(14))
+	// then the usage is gone
+	assert.False(t, hasUsage("channel-0"))
+	assert.Equal(t, 0, countQueue())
+	// and the next block starts with a fresh usage
+	assert.Equal(t, types.IBCPacketUsage{BlockHeight: 15, WindowStartHeight: 15}, k.GetIBCPacketUsage(ctx.WithBlockHeight(15), myContractAddr, "channel-0"))
+}
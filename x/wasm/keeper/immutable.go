@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// IsImmutableOverrideAllowed returns true when the emergency param allows governance to modify immutable contracts
+func (k Keeper) IsImmutableOverrideAllowed(ctx sdk.Context) bool {
+	var a bool
+	k.paramSpace.Get(ctx, types.ParamStoreKeyImmutableOverride, &a)
+	return a
+}
+
+// assertContractMutable returns an error when the contract is immutable unless the authorization policy can override
+// it and the emergency param is enabled
+func (k Keeper) assertContractMutable(ctx sdk.Context, contractInfo *types.ContractInfo, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	if !contractInfo.Immutable {
+		return nil
+	}
+	if authZ.CanOverrideImmutable(caller) && k.IsImmutableOverrideAllowed(ctx) {
+		return nil
+	}
+	return sdkerrors.Wrap(types.ErrImmutableContract, "can not modify contract")
+}
+
+// makeContractImmutable marks the contract as immutable and removes the admin, a proposed admin and all queued
+// operations of the contract. It is not timelocked as it only restricts the contract further. The flag can not be
+// removed.
+func (k Keeper) makeContractImmutable(ctx sdk.Context, contractAddr, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if contractInfo.Immutable {
+		return sdkerrors.Wrap(types.ErrImmutableContract, "already immutable")
+	}
+	contractInfo.Immutable = true
+	k.updateContractAdmin(ctx, contractAddr, contractInfo, nil)
+
+	var ops []types.TimelockedOperation
+	func() {
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTimelockedOperationPrefix(contractAddr))
+		iter := prefixStore.Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var op types.TimelockedOperation
+			k.cdc.MustUnmarshal(iter.Value(), &op)
+			ops = append(ops, op)
+		}
+	}()
+	for _, op := range ops {
+		k.deleteTimelockedOperation(ctx, contractAddr, op)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMakeContractImmutable,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestMakeContractImmutable(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	immutableExample := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	require.NoError(t, keepers.ContractKeeper.MakeContractImmutable(parentCtx, immutableExample.Contract, immutableExample.CreatorAddr))
+	govKeeper := NewGovPermissionKeeper(keepers.WasmKeeper)
+
+	specs := map[string]struct {
+		keeper   *PermissionedKeeper
+		contract sdk.AccAddress
+		caller   sdk.AccAddress
+		expErr   *sdkerrors.Error
+	}{
+		"admin": {
+			keeper:   keepers.ContractKeeper.(*PermissionedKeeper),
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+		},
+		"gov": {
+			keeper:   govKeeper,
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+		},
+		"non admin": {
+			keeper:   keepers.ContractKeeper.(*PermissionedKeeper),
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			keeper:   keepers.ContractKeeper.(*PermissionedKeeper),
+			contract: RandomAccountAddress(t),
+			caller:   example.CreatorAddr,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"already immutable": {
+			keeper:   govKeeper,
+			contract: immutableExample.Contract,
+			caller:   RandomAccountAddress(t),
+			expErr:   types.ErrImmutableContract,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t), 0))
+			keepers.WasmKeeper.storeContractTimelock(ctx, example.Contract, 10)
+			require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, example.Contract, example.CreatorAddr))
+			require.NotNil(t, keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, 1))
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := spec.keeper.MakeContractImmutable(ctx.WithEventManager(em), spec.contract, spec.caller)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			info := keepers.WasmKeeper.GetContractInfo(ctx, spec.contract)
+			assert.True(t, info.Immutable)
+			assert.Empty(t, info.Admin)
+			assert.Nil(t, keepers.WasmKeeper.GetPendingAdmin(ctx, spec.contract))
+			assert.Nil(t, keepers.WasmKeeper.GetTimelockedOperation(ctx, spec.contract, 1))
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeUpdateContractAdmin, em.Events()[0].Type)
+			assert.Equal(t, types.EventTypeMakeContractImmutable, em.Events()[1].Type)
+		})
+	}
+}
+
+func TestImmutableContractCanNotBeModified(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	burner := StoreBurnerExampleContract(t, parentCtx, keepers)
+	require.NoError(t, keepers.ContractKeeper.MakeContractImmutable(parentCtx, example.Contract, example.CreatorAddr))
+	govKeeper := NewGovPermissionKeeper(keepers.WasmKeeper)
+	newAdmin := RandomAccountAddress(t)
+
+	ops := map[string]func(ctx sdk.Context, k *PermissionedKeeper, caller sdk.AccAddress) error{
+		"migrate": func(ctx sdk.Context, k *PermissionedKeeper, caller sdk.AccAddress) error {
+			_, err := k.Migrate(ctx, example.Contract, caller, burner.CodeID, BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t))
+			return err
+		},
+		"update admin": func(ctx sdk.Context, k *PermissionedKeeper, caller sdk.AccAddress) error {
+			return k.UpdateContractAdmin(ctx, example.Contract, caller, newAdmin)
+		},
+		"clear admin": func(ctx sdk.Context, k *PermissionedKeeper, caller sdk.AccAddress) error {
+			return k.ClearContractAdmin(ctx, example.Contract, caller)
+		},
+	}
+	specs := map[string]struct {
+		keeper        *PermissionedKeeper
+		caller        sdk.AccAddress
+		allowOverride bool
+		expErr        *sdkerrors.Error
+	}{
+		"former admin": {
+			keeper: keepers.ContractKeeper.(*PermissionedKeeper),
+			caller: example.CreatorAddr,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"former admin with override": {
+			keeper:        keepers.ContractKeeper.(*PermissionedKeeper),
+			caller:        example.CreatorAddr,
+			allowOverride: true,
+			expErr:        sdkerrors.ErrUnauthorized,
+		},
+		"gov": {
+			keeper: govKeeper,
+			caller: RandomAccountAddress(t),
+			expErr: types.ErrImmutableContract,
+		},
+		"gov with override": {
+			keeper:        govKeeper,
+			caller:        RandomAccountAddress(t),
+			allowOverride: true,
+		},
+	}
+	for opName, op := range ops {
+		for name, spec := range specs {
+			t.Run(opName+" by "+name, func(t *testing.T) {
+				ctx, _ := parentCtx.CacheContext()
+				params := types.DefaultParams()
+				params.AllowImmutableOverride = spec.allowOverride
+				keepers.WasmKeeper.SetParams(ctx, params)
+				infoBefore := keepers.WasmKeeper.GetContractInfo(ctx, example.Contract)
+
+				// when
+				gotErr := op(ctx, spec.keeper, spec.caller)
+
+				// then
+				if spec.expErr != nil {
+					assert.True(t, spec.expErr.Is(gotErr), gotErr)
+					assert.Equal(t, infoBefore, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract))
+					return
+				}
+				require.NoError(t, gotErr)
+				// the flag is not removed
+				assert.True(t, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Immutable)
+			})
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// RegisterInvariants registers the wasm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contract-code-ids", ContractCodeIDsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "contract-code-index", ContractCodeIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pinned-codes", PinnedCodesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sequences", SequencesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "storage-deposits", StorageDepositsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the wasm module
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ContractCodeIDsInvariant(k),
+			ContractCodeIndexInvariant(k),
+			PinnedCodesInvariant(k),
+			SequencesInvariant(k),
+			StorageDepositsInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ContractCodeIDsInvariant checks that the code of every contract exists
+func ContractCodeIDsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		k.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+			if !k.containsCodeInfo(ctx, info.CodeID) {
+				count++
+				msg += fmt.Sprintf("\tcontract %s references unknown code id %d\n", addr, info.CodeID)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(
+			types.ModuleName, "contract-code-ids",
+			fmt.Sprintf("amount of contracts with unknown code found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// ContractCodeIndexInvariant checks that every entry of the contracts by code id secondary index points to
+// an existing contract with a matching last code history entry and that every contract is indexed
+func ContractCodeIndexInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		store := ctx.KVStore(k.storeKey)
+		indexStore := prefix.NewStore(store, types.ContractByCodeIDAndCreatedSecondaryIndexPrefix)
+		iter := indexStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()
+			if len(key) <= 8+types.AbsoluteTxPositionLen {
+				count++
+				msg += fmt.Sprintf("\tmalformed index key %X\n", key)
+				continue
+			}
+			codeID := sdk.BigEndianToUint64(key[:8])
+			pos := key[8 : 8+types.AbsoluteTxPositionLen]
+			contractAddr := sdk.AccAddress(key[8+types.AbsoluteTxPositionLen:])
+
+			info := k.GetContractInfo(ctx, contractAddr)
+			if info == nil {
+				count++
+				msg += fmt.Sprintf("\tindex entry for code id %d references unknown contract %s\n", codeID, contractAddr)
+				continue
+			}
+			if info.CodeID != codeID {
+				count++
+				msg += fmt.Sprintf("\tindex entry for code id %d does not match contract %s with code id %d\n", codeID, contractAddr, info.CodeID)
+				continue
+			}
+			entry, found := k.findLastContractHistoryEntry(ctx, contractAddr)
+			if !found || entry.CodeID != codeID || entry.Updated == nil || !bytes.Equal(entry.Updated.Bytes(), pos) {
+				count++
+				msg += fmt.Sprintf("\tindex entry for code id %d does not match last history entry of contract %s\n", codeID, contractAddr)
+			}
+		}
+		iter.Close()
+
+		k.IterateContractInfo(ctx, func(addr sdk.AccAddress, _ types.ContractInfo) bool {
+			entry, found := k.findLastContractHistoryEntry(ctx, addr)
+			switch {
+			case !found:
+				count++
+				msg += fmt.Sprintf("\tcontract %s has no history\n", addr)
+			case entry.Updated == nil || !store.Has(types.GetContractByCreatedSecondaryIndexKey(addr, entry)):
+				count++
+				msg += fmt.Sprintf("\tcontract %s is not indexed by code id %d\n", addr, entry.CodeID)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(
+			types.ModuleName, "contract-code-index",
+			fmt.Sprintf("amount of inconsistent code index entries found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// PinnedCodesInvariant checks that every pinned code exists
+func PinnedCodesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PinnedCodeIndexPrefix)
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			codeID := types.ParsePinnedCodeIndex(iter.Key())
+			if !k.containsCodeInfo(ctx, codeID) {
+				count++
+				msg += fmt.Sprintf("\tpinned code id %d does not exist\n", codeID)
+			}
+		}
+		return sdk.FormatInvariant(
+			types.ModuleName, "pinned-codes",
+			fmt.Sprintf("amount of unknown pinned codes found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// SequencesInvariant checks that the id sequences are ahead of the used ids. The instance id is not persisted
+// with the contract, so the next classic contract address for every code must not be taken instead.
+func SequencesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		nextCodeID := k.PeekAutoIncrementID(ctx, types.KeyLastCodeID)
+		nextInstanceID := k.PeekAutoIncrementID(ctx, types.KeyLastInstanceID)
+		k.IterateCodeInfos(ctx, func(codeID uint64, _ types.CodeInfo) bool {
+			if codeID >= nextCodeID {
+				count++
+				msg += fmt.Sprintf("\tcode id %d is not lower than seq %s with value %d\n", codeID, string(types.KeyLastCodeID), nextCodeID)
+			}
+			if addr := BuildContractAddressClassic(codeID, nextInstanceID); k.HasContractInfo(ctx, addr) {
+				count++
+				msg += fmt.Sprintf("\tnext contract address %s for code id %d is taken with seq %s value %d\n", addr, codeID, string(types.KeyLastInstanceID), nextInstanceID)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(
+			types.ModuleName, "sequences",
+			fmt.Sprintf("amount of sequence conflicts found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// StorageDepositsInvariant checks that the storage deposits of all contracts are covered by the balance of the
+// wasm module account
+func StorageDepositsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.checkStorageDepositsFunded(ctx)
+		msg := "storage deposits are covered by the module balance\n"
+		if err != nil {
+			msg = fmt.Sprintf("\t%s\n", err)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "storage-deposits", msg), err != nil
+	}
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestInvariants(t *testing.T) {
+	specs := map[string]struct {
+		setup     func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress)
+		invariant func(k *Keeper) sdk.Invariant
+		expBroken bool
+	}{
+		"all consistent": {
+			setup:     func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {},
+			invariant: AllInvariants,
+		},
+		"contract with unknown code": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				info := k.GetContractInfo(ctx, contractAddr)
+				info.CodeID = 99
+				k.storeContractInfo(ctx, contractAddr, info)
+			},
+			invariant: ContractCodeIDsInvariant,
+			expBroken: true,
+		},
+		"code index entry without contract": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				entry := k.getLastContractHistoryEntry(ctx, contractAddr)
+				k.addToContractCodeSecondaryIndex(ctx, RandomAccountAddress(t), entry)
+			},
+			invariant: ContractCodeIndexInvariant,
+			expBroken: true,
+		},
+		"code index entry with other code id": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				entry := k.getLastContractHistoryEntry(ctx, contractAddr)
+				entry.CodeID = 99
+				k.addToContractCodeSecondaryIndex(ctx, contractAddr, entry)
+			},
+			invariant: ContractCodeIndexInvariant,
+			expBroken: true,
+		},
+		"code index entry with outdated position": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				entry := k.getLastContractHistoryEntry(ctx, contractAddr)
+				entry.Updated = &types.AbsoluteTxPosition{BlockHeight: 1}
+				k.addToContractCodeSecondaryIndex(ctx, contractAddr, entry)
+			},
+			invariant: ContractCodeIndexInvariant,
+			expBroken: true,
+		},
+		"contract not indexed": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.removeFromContractCodeSecondaryIndex(ctx, contractAddr, k.getLastContractHistoryEntry(ctx, contractAddr))
+			},
+			invariant: ContractCodeIndexInvariant,
+			expBroken: true,
+		},
+		"contract without history": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				ctx.KVStore(k.storeKey).Delete(types.GetContractCodeHistoryElementKey(contractAddr, 1))
+			},
+			invariant: ContractCodeIndexInvariant,
+			expBroken: true,
+		},
+		"pinned unknown code": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				ctx.KVStore(k.storeKey).Set(types.GetPinnedCodeIndexPrefix(99), []byte{})
+			},
+			invariant: PinnedCodesInvariant,
+			expBroken: true,
+		},
+		"code id sequence behind": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				ctx.KVStore(k.storeKey).Set(types.KeyLastCodeID, sdk.Uint64ToBigEndian(1))
+			},
+			invariant: SequencesInvariant,
+			expBroken: true,
+		},
+		"instance id sequence behind": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				ctx.KVStore(k.storeKey).Set(types.KeyLastInstanceID, sdk.Uint64ToBigEndian(1))
+			},
+			invariant: SequencesInvariant,
+			expBroken: true,
+		},
+		"storage deposit not covered by module balance": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.storeStorageDeposit(ctx, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+			},
+			invariant: StorageDepositsInvariant,
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			k := keepers.WasmKeeper
+			spec.setup(t, ctx, k, example.Contract)
+
+			msg, gotBroken := spec.invariant(k)(ctx)
+			assert.Equal(t, spec.expBroken, gotBroken, msg)
+		})
+	}
+}
@@ -55,6 +55,24 @@ type CoinTransferrer interface {
 	TransferCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
+// ContractFeeDeducter deducts the fees of txs that are sponsored by a contract.
+// This is an extension point to attach custom logic
+type ContractFeeDeducter interface {
+	// DeductContractFees sends the fees of a tx that is sponsored by the contract to the fee collector.
+	DeductContractFees(ctx sdk.Context, contractAddr sdk.AccAddress, fees sdk.Coins) error
+}
+
+// StorageDepositKeeper holds the storage deposits that contracts pay for their state.
+// This is an extension point to attach custom logic
+type StorageDepositKeeper interface {
+	// ChargeStorageDeposit sends the storage deposit from the contract to the wasm module account.
+	ChargeStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error
+	// RefundStorageDeposit sends the storage deposit from the wasm module account back to the contract.
+	RefundStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error
+	// StorageDepositBalance returns the balance of the wasm module account that holds the storage deposits.
+	StorageDepositBalance(ctx sdk.Context) sdk.Coins
+}
+
 // AccountPruner handles the balances and data cleanup for accounts that are pruned on contract instantiate.
 // This is an extension point to attach custom logic
 type AccountPruner interface {
@@ -88,6 +106,8 @@ type Keeper struct {
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
+	feeDeducter           ContractFeeDeducter
+	storageDeposits       StorageDepositKeeper
 	portKeeper            types.PortKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmerEngine
@@ -102,6 +122,15 @@ type Keeper struct {
 	maxQueryStackSize    uint32
 	acceptedAccountTypes map[reflect.Type]struct{}
 	accountPruner        AccountPruner
+	// timelockedOperationGasLimit is the max gas that a queued admin operation can consume on execution
+	timelockedOperationGasLimit uint64
+	// snapshotRestoreConcurrency is the max number of wasm codes compiled in parallel on snapshot restore
+	snapshotRestoreConcurrency uint32
+	genesisExportFilter        GenesisExportFilter
+	// genesisStreamFile is used for codes, contracts and sequences on genesis import and export when set
+	genesisStreamFile string
+	// addressBuilders are the predictable address builders that can be selected by name on instantiate2
+	addressBuilders map[string]PredictableAddressBuilder
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -140,17 +169,33 @@ func NewKeeper(
 		wasmVM:               wasmer,
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
+		feeDeducter:          NewBankContractFeeDeducter(bankKeeper),
+		storageDeposits:      NewBankStorageDepositKeeper(bankKeeper),
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
 		portKeeper:           portKeeper,
 		capabilityKeeper:     capabilityKeeper,
-		messenger:            NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource),
 		queryGasLimit:        wasmConfig.SmartQueryGasLimit,
 		paramSpace:           paramSpace,
 		metrics:              NopMetrics(),
 		gasRegister:          NewDefaultWasmGasRegister(),
 		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
 		acceptedAccountTypes: defaultAcceptedAccountTypes,
+
+		timelockedOperationGasLimit: types.DefaultTimelockedOperationGasLimit,
+
+		snapshotRestoreConcurrency: wasmConfig.SnapshotRestoreConcurrency,
+		genesisStreamFile:          wasmConfig.GenesisStreamFile,
+		addressBuilders: map[string]PredictableAddressBuilder{
+			types.DefaultAddressGenerator: BuildContractAddressPredictable,
+		},
 	}
+	keeper.messenger = newDefaultMessageHandler(
+		router,
+		NewIBCPacketRateLimitHandler(NewIBCRawPacketHandler(channelKeeper, capabilityKeeper), keeper),
+		bankKeeper,
+		cdc,
+		portSource,
+	)
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, keeper)
 	for _, o := range opts {
 		o.apply(keeper)
@@ -236,12 +281,23 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 }
 
 func (k Keeper) storeCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo) {
+	if oldInfo := k.GetCodeInfo(ctx, codeID); oldInfo != nil {
+		k.deleteCodeReferences(ctx, codeID, *oldInfo)
+	}
 	store := ctx.KVStore(k.storeKey)
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(&codeInfo))
+	k.addCodeReferences(ctx, codeID, codeInfo)
 }
 
 func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, wasmCode []byte) error {
+	if len(wasmCode) == 0 {
+		// stripped on export, the checksum references the byte code in the wasm cache
+		var err error
+		if wasmCode, err = k.wasmVM.GetCode(codeInfo.CodeHash); err != nil {
+			return sdkerrors.Wrapf(types.ErrNotFound, "code bytes for checksum %X: %s", codeInfo.CodeHash, err)
+		}
+	}
 	if ioutils.IsGzip(wasmCode) {
 		var err error
 		wasmCode, err = ioutils.Uncompress(wasmCode, uint64(types.MaxWasmSize))
@@ -264,6 +320,7 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	k.addCodeReferences(ctx, codeID, codeInfo)
 	return nil
 }
 
@@ -290,6 +347,9 @@ func (k Keeper) instantiate(
 	if codeInfo == nil {
 		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
 	}
+	if !codeInfo.IsActive() {
+		return nil, nil, sdkerrors.Wrapf(types.ErrCodeNotActive, "code status: %s", codeInfo.Status)
+	}
 	if !authPolicy.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
 		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
@@ -344,8 +404,7 @@ func (k Keeper) instantiate(
 
 	// create prefixed data store
 	// 0x03 | BuildContractAddressClassic (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.newContractStateStore(ctx, contractAddress, codeID)
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
@@ -357,6 +416,9 @@ func (k Keeper) instantiate(
 	if err != nil {
 		return nil, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return nil, nil, err
+	}
 
 	// persist instance first
 	createdAt := types.NewAbsoluteTxPosition(ctx)
@@ -425,6 +487,9 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeExecute,
@@ -451,11 +516,20 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
 	}
+	if err := k.assertContractMutable(ctx, contractInfo, caller, authZ); err != nil {
+		return nil, err
+	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	if !newCodeInfo.IsActive() {
+		return nil, sdkerrors.Wrapf(types.ErrCodeNotActive, "code status: %s", newCodeInfo.Status)
+	}
+	if oldCodeInfo := k.GetCodeInfo(ctx, contractInfo.CodeID); oldCodeInfo != nil && !oldCodeInfo.AllowsMigrationTo(newCodeID, newCodeInfo.CodeHash) {
+		return nil, sdkerrors.Wrapf(types.ErrMigrationNotAllowed, "to code %d by policy of code %d", newCodeID, contractInfo.CodeID)
+	}
 
 	if !authZ.CanInstantiateContract(newCodeInfo.InstantiateConfig, caller) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
@@ -482,14 +556,16 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.newContractStateStore(ctx, contractAddress, newCodeID)
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, &prefixStore, cosmwasmAPI, &querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, &querier, k.gasMeter(ctx), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return nil, err
+	}
 
 	// delete old secondary index entry
 	k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.getLastContractHistoryEntry(ctx, contractAddress))
@@ -536,6 +612,9 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeSudo,
@@ -572,6 +651,9 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeReply,
@@ -619,16 +701,24 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	if err := k.assertContractMutable(ctx, contractInfo, caller, authZ); err != nil {
+		return err
+	}
+	k.updateContractAdmin(ctx, contractAddress, contractInfo, newAdmin)
+	return nil
+}
+
+// updateContractAdmin stores the new admin and removes any proposed admin of the contract
+func (k Keeper) updateContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo, newAdmin sdk.AccAddress) {
 	newAdminStr := newAdmin.String()
 	contractInfo.Admin = newAdminStr
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingAdminKey(contractAddress))
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUpdateContractAdmin,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdminStr),
 	))
-
-	return nil
 }
 
 func (k Keeper) appendToContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress, newEntries ...types.ContractCodeHistoryEntry) {
@@ -666,17 +756,26 @@ func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress)
 
 // getLastContractHistoryEntry returns the last element from history. To be used internally only as it panics when none exists
 func (k Keeper) getLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.AccAddress) types.ContractCodeHistoryEntry {
+	r, found := k.findLastContractHistoryEntry(ctx, contractAddr)
+	if !found {
+		// all contracts have a history
+		panic(fmt.Sprintf("no history for %s", contractAddr.String()))
+	}
+	return r
+}
+
+// findLastContractHistoryEntry returns the last element from history and false when none exists
+func (k Keeper) findLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.AccAddress) (types.ContractCodeHistoryEntry, bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractCodeHistoryElementPrefix(contractAddr))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 
 	var r types.ContractCodeHistoryEntry
 	if !iter.Valid() {
-		// all contracts have a history
-		panic(fmt.Sprintf("no history for %s", contractAddr.String()))
+		return r, false
 	}
 	k.cdc.MustUnmarshal(iter.Value(), &r)
-	return r
+	return r, true
 }
 
 // QuerySmart queries the smart contract itself.
@@ -744,24 +843,23 @@ func (k Keeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []
 	return prefixStore.Get(key)
 }
 
-func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, prefix.Store, error) {
+func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, *contractStateStore, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	contractBz := store.Get(types.GetContractAddressKey(contractAddress))
 	if contractBz == nil {
-		return types.ContractInfo{}, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "contract")
+		return types.ContractInfo{}, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "contract")
 	}
 	var contractInfo types.ContractInfo
 	k.cdc.MustUnmarshal(contractBz, &contractInfo)
 
 	codeInfoBz := store.Get(types.GetCodeKey(contractInfo.CodeID))
 	if codeInfoBz == nil {
-		return contractInfo, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "code info")
+		return contractInfo, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.newContractStateStore(ctx, contractAddress, contractInfo.CodeID)
 	return contractInfo, codeInfo, prefixStore, nil
 }
 
@@ -776,6 +874,24 @@ func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress)
 	return &contract
 }
 
+// DeductContractFees sends the fees of a tx that is sponsored by the contract from the contract balance to the
+// fee collector.
+func (k Keeper) DeductContractFees(ctx sdk.Context, contractAddress sdk.AccAddress, fees sdk.Coins) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if fees.IsZero() {
+		return nil
+	}
+	if !fees.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
+	}
+	if err := k.feeDeducter.DeductContractFees(ctx, contractAddress, fees); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	return nil
+}
+
 func (k Keeper) HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(types.GetContractAddressKey(contractAddress))
@@ -818,8 +934,10 @@ func (k Keeper) IterateContractState(ctx sdk.Context, contractAddress sdk.AccAdd
 }
 
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	var unknownCodeID uint64
+	prefixStore := k.newContractStateStore(ctx, contractAddress, unknownCodeID)
+	// the state changes are not checked as the state is neither limited nor charged a deposit on import. The
+	// deposit is imported with the contract.
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
@@ -978,6 +1096,94 @@ func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAd
 	return nil
 }
 
+// setCodeStatus updates the lifecycle status of a code. A superseding code must exist and be active.
+func (k Keeper) setCodeStatus(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, status types.CodeStatus, reason string, supersededBy uint64, authz AuthorizationPolicy) error {
+	if err := types.ValidateCodeStatus(status, supersededBy); err != nil {
+		return err
+	}
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if supersededBy != 0 {
+		if supersededBy == codeID {
+			return sdkerrors.Wrap(types.ErrInvalid, "code can not supersede itself")
+		}
+		newInfo := k.GetCodeInfo(ctx, supersededBy)
+		if newInfo == nil {
+			return sdkerrors.Wrap(types.ErrNotFound, "superseding code info")
+		}
+		if !newInfo.IsActive() {
+			return sdkerrors.Wrap(types.ErrCodeNotActive, "superseding code")
+		}
+	}
+	if !authz.CanModifyCodeStatus(sdk.MustAccAddressFromBech32(info.Creator), caller, info.Status) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify code status")
+	}
+
+	info.Status = status
+	info.StatusReason = reason
+	info.SupersededByCodeID = supersededBy
+	k.storeCodeInfo(ctx, codeID, *info)
+	evt := sdk.NewEvent(
+		types.EventTypeUpdateCodeStatus,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyCodeStatus, status.String()),
+	)
+	if reason != "" {
+		attr := sdk.NewAttribute(types.AttributeKeyStatusReason, reason)
+		evt.Attributes = append(evt.Attributes, attr.ToKVPair())
+	}
+	if supersededBy != 0 {
+		attr := sdk.NewAttribute(types.AttributeKeySupersededByCodeID, strconv.FormatUint(supersededBy, 10))
+		evt.Attributes = append(evt.Attributes, attr.ToKVPair())
+	}
+	ctx.EventManager().EmitEvent(evt)
+	return nil
+}
+
+// setMigrationPolicy updates the codes that contracts of a code can be migrated to. Listed code ids must
+// exist while checksums can reference codes that are stored later. An empty policy is removed.
+func (k Keeper) setMigrationPolicy(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, policy types.MigrationPolicy, authz AuthorizationPolicy) error {
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "migration policy")
+	}
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	for _, id := range policy.CodeIDs {
+		if !k.containsCodeInfo(ctx, id) {
+			return sdkerrors.Wrapf(types.ErrNotFound, "allowed code %d", id)
+		}
+	}
+	if !authz.CanModifyMigrationPolicy(sdk.MustAccAddressFromBech32(info.Creator), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify migration policy")
+	}
+
+	info.MigrationPolicy = nil
+	if !policy.IsEmpty() {
+		info.MigrationPolicy = &policy
+	}
+	k.storeCodeInfo(ctx, codeID, *info)
+	codeIDs := make([]string, len(policy.CodeIDs))
+	for i, id := range policy.CodeIDs {
+		codeIDs[i] = strconv.FormatUint(id, 10)
+	}
+	checksums := make([]string, len(policy.Checksums))
+	for i, c := range policy.Checksums {
+		checksums[i] = c.String()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateMigrationPolicy,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyAllowedCodeIDs, strings.Join(codeIDs, ",")),
+		sdk.NewAttribute(types.AttributeKeyAllowedChecksums, strings.Join(checksums, ",")),
+		sdk.NewAttribute(types.AttributeKeyDenyAll, strconv.FormatBool(policy.DenyAll)),
+	))
+	return nil
+}
+
 // handleContractResponse processes the contract response data by emitting events and sending sub-/messages.
 func (k *Keeper) handleContractResponse(
 	ctx sdk.Context,
@@ -1156,6 +1362,53 @@ func (c BankCoinTransferrer) TransferCoins(parentCtx sdk.Context, fromAddr sdk.A
 	return nil
 }
 
+var _ ContractFeeDeducter = BankContractFeeDeducter{}
+
+// BankContractFeeDeducter default implementation for ContractFeeDeducter that sends the fees to the fee collector
+type BankContractFeeDeducter struct {
+	keeper types.BankKeeper
+}
+
+func NewBankContractFeeDeducter(keeper types.BankKeeper) BankContractFeeDeducter {
+	return BankContractFeeDeducter{
+		keeper: keeper,
+	}
+}
+
+// DeductContractFees sends the fees from the contract to the fee collector as the SDK fee deduction does
+func (d BankContractFeeDeducter) DeductContractFees(ctx sdk.Context, contractAddr sdk.AccAddress, fees sdk.Coins) error {
+	return d.keeper.SendCoinsFromAccountToModule(ctx, contractAddr, authtypes.FeeCollectorName, fees)
+}
+
+var _ StorageDepositKeeper = BankStorageDepositKeeper{}
+
+// BankStorageDepositKeeper default implementation for StorageDepositKeeper that holds the deposits in the wasm module
+// account
+type BankStorageDepositKeeper struct {
+	keeper types.BankKeeper
+}
+
+func NewBankStorageDepositKeeper(keeper types.BankKeeper) BankStorageDepositKeeper {
+	return BankStorageDepositKeeper{
+		keeper: keeper,
+	}
+}
+
+// ChargeStorageDeposit sends the deposit from the contract to the wasm module account
+func (d BankStorageDepositKeeper) ChargeStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error {
+	return d.keeper.SendCoinsFromAccountToModule(ctx, contractAddr, types.ModuleName, amt)
+}
+
+// RefundStorageDeposit sends the deposit from the wasm module account back to the contract
+func (d BankStorageDepositKeeper) RefundStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error {
+	return d.keeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddr, amt)
+}
+
+// StorageDepositBalance returns the balance of the wasm module account
+func (d BankStorageDepositKeeper) StorageDepositBalance(ctx sdk.Context) sdk.Coins {
+	return d.keeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+}
+
 var _ AccountPruner = VestingCoinBurner{}
 
 // VestingCoinBurner default implementation for AccountPruner to burn the coins
@@ -27,6 +27,7 @@ import (
 	vestingtypes "github.com/Finschia/finschia-sdk/x/auth/vesting/types"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	distributiontypes "github.com/Finschia/finschia-sdk/x/distribution/types"
+	tmBytes "github.com/Finschia/ostracon/libs/bytes"
 	"github.com/Finschia/ostracon/libs/rand"
 	wasmvm "github.com/Finschia/wasmvm"
 	wasmvmtypes "github.com/Finschia/wasmvm/types"
@@ -411,7 +412,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x194a1), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1ad90), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -669,7 +670,7 @@ func TestInstantiateWithAccounts(t *testing.T) {
 				}
 			}()
 			// when
-			gotAddr, _, gotErr := keepers.ContractKeeper.Instantiate2(ctx, 1, senderAddr, nil, initMsg, myLabel, spec.deposit, mySalt, false)
+			gotAddr, _, gotErr := keepers.ContractKeeper.Instantiate2(ctx, 1, senderAddr, nil, initMsg, myLabel, spec.deposit, mySalt, false, "")
 			if spec.expErr != nil {
 				assert.ErrorIs(t, gotErr, spec.expErr)
 				return
@@ -702,6 +703,46 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.Nil(t, addr)
 }
 
+func TestInstantiateWithInactiveCode(t *testing.T) {
+	specs := map[string]struct {
+		status types.CodeStatus
+		expErr *sdkerrors.Error
+	}{
+		"active": {
+			status: types.CodeStatusActive,
+		},
+		"deprecated": {
+			status: types.CodeStatusDeprecated,
+			expErr: types.ErrCodeNotActive,
+		},
+		"revoked": {
+			status: types.CodeStatusRevoked,
+			expErr: types.ErrCodeNotActive,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			require.NoError(t, keepers.WasmKeeper.setCodeStatus(ctx, example.CodeID, nil, spec.status, "", 0, GovAuthorizationPolicy{}))
+			initMsgBz := HackatomExampleInitMsg{
+				Verifier:    RandomAccountAddress(t),
+				Beneficiary: RandomAccountAddress(t),
+			}.GetBytes(t)
+
+			// when
+			_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "demo contract", nil)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestInstantiateWithContractDataResponse(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
@@ -1117,6 +1158,18 @@ func TestMigrate(t *testing.T) {
 	require.NoError(t, keeper.SetAccessConfig(parentCtx, restrictedCodeExample.CodeID, restrictedCodeExample.CreatorAddr, types.AllowNobody))
 	require.NotEqual(t, originalCodeID, restrictedCodeExample.CodeID)
 
+	deprecatedCodeExample := StoreHackatomExampleContract(t, parentCtx, keepers)
+	deprecatedCodeID := deprecatedCodeExample.CodeID
+	require.NoError(t, keeper.SetCodeStatus(parentCtx, deprecatedCodeID, deprecatedCodeExample.CreatorAddr, types.CodeStatusDeprecated, "", newCodeID))
+
+	policyCodeExample := StoreHackatomExampleContract(t, parentCtx, keepers)
+	policyCodeID := policyCodeExample.CodeID
+	require.NoError(t, keeper.SetMigrationPolicy(parentCtx, policyCodeID, policyCodeExample.CreatorAddr, types.MigrationPolicy{CodeIDs: []uint64{newCodeID}}))
+
+	denyAllCodeExample := StoreHackatomExampleContract(t, parentCtx, keepers)
+	denyAllCodeID := denyAllCodeExample.CodeID
+	require.NoError(t, keeper.SetMigrationPolicy(parentCtx, denyAllCodeID, denyAllCodeExample.CreatorAddr, types.MigrationPolicy{DenyAll: true}))
+
 	anyAddr := RandomAccountAddress(t)
 	newVerifierAddr := RandomAccountAddress(t)
 	initMsgBz := HackatomExampleInitMsg{
@@ -1203,6 +1256,42 @@ func TestMigrate(t *testing.T) {
 			migrateMsg: migMsgBz,
 			expErr:     sdkerrors.ErrUnauthorized,
 		},
+		"prevent migration when new code is deprecated": {
+			admin:      creator,
+			caller:     creator,
+			initMsg:    initMsgBz,
+			fromCodeID: originalCodeID,
+			toCodeID:   deprecatedCodeID,
+			migrateMsg: migMsgBz,
+			expErr:     types.ErrCodeNotActive,
+		},
+		"all good with code allowed by migration policy": {
+			admin:       creator,
+			caller:      creator,
+			initMsg:     initMsgBz,
+			fromCodeID:  policyCodeID,
+			toCodeID:    newCodeID,
+			migrateMsg:  migMsgBz,
+			expVerifier: newVerifierAddr,
+		},
+		"prevent migration to code not allowed by migration policy": {
+			admin:      creator,
+			caller:     creator,
+			initMsg:    initMsgBz,
+			fromCodeID: policyCodeID,
+			toCodeID:   originalCodeID,
+			migrateMsg: migMsgBz,
+			expErr:     types.ErrMigrationNotAllowed,
+		},
+		"prevent migration with migration policy that denies all": {
+			admin:      creator,
+			caller:     creator,
+			initMsg:    initMsgBz,
+			fromCodeID: denyAllCodeID,
+			toCodeID:   newCodeID,
+			migrateMsg: migMsgBz,
+			expErr:     types.ErrMigrationNotAllowed,
+		},
 		"fail with non existing code id": {
 			admin:      creator,
 			caller:     creator,
@@ -2164,6 +2253,221 @@ func TestSetAccessConfig(t *testing.T) {
 	}
 }
 
+func TestSetCodeStatus(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	nonCreatorAddr := RandomAccountAddress(t)
+	const (
+		codeID         = 1
+		activeCodeID   = 2
+		inactiveCodeID = 3
+	)
+
+	specs := map[string]struct {
+		authz        AuthorizationPolicy
+		current      types.CodeStatus
+		status       types.CodeStatus
+		reason       string
+		supersededBy uint64
+		caller       sdk.AccAddress
+		expErr       *sdkerrors.Error
+		expEvts      map[string]string
+	}{
+		"creator deprecates": {
+			authz:   DefaultAuthorizationPolicy{},
+			status:  types.CodeStatusDeprecated,
+			reason:  "use newer version",
+			caller:  creatorAddr,
+			expEvts: map[string]string{"code_id": "1", "code_status": "CODE_STATUS_DEPRECATED", "reason": "use newer version"},
+		},
+		"creator revokes with superseding code": {
+			authz:        DefaultAuthorizationPolicy{},
+			status:       types.CodeStatusRevoked,
+			supersededBy: activeCodeID,
+			caller:       creatorAddr,
+			expEvts:      map[string]string{"code_id": "1", "code_status": "CODE_STATUS_REVOKED", "superseded_by_code_id": "2"},
+		},
+		"creator reactivates deprecated code": {
+			authz:   DefaultAuthorizationPolicy{},
+			current: types.CodeStatusDeprecated,
+			status:  types.CodeStatusActive,
+			caller:  creatorAddr,
+			expEvts: map[string]string{"code_id": "1", "code_status": "CODE_STATUS_ACTIVE"},
+		},
+		"creator can not change revoked code": {
+			authz:   DefaultAuthorizationPolicy{},
+			current: types.CodeStatusRevoked,
+			status:  types.CodeStatusActive,
+			caller:  creatorAddr,
+			expErr:  sdkerrors.ErrUnauthorized,
+		},
+		"different actor": {
+			authz:  DefaultAuthorizationPolicy{},
+			status: types.CodeStatusDeprecated,
+			caller: nonCreatorAddr,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"gov reactivates revoked code": {
+			authz:   GovAuthorizationPolicy{},
+			current: types.CodeStatusRevoked,
+			status:  types.CodeStatusActive,
+			expEvts: map[string]string{"code_id": "1", "code_status": "CODE_STATUS_ACTIVE"},
+		},
+		"superseding code does not exist": {
+			authz:        GovAuthorizationPolicy{},
+			status:       types.CodeStatusDeprecated,
+			supersededBy: 99,
+			expErr:       types.ErrNotFound,
+		},
+		"superseding code not active": {
+			authz:        GovAuthorizationPolicy{},
+			status:       types.CodeStatusDeprecated,
+			supersededBy: inactiveCodeID,
+			expErr:       types.ErrCodeNotActive,
+		},
+		"superseded by itself": {
+			authz:        GovAuthorizationPolicy{},
+			status:       types.CodeStatusDeprecated,
+			supersededBy: codeID,
+			expErr:       types.ErrInvalid,
+		},
+		"active code superseded": {
+			authz:        GovAuthorizationPolicy{},
+			status:       types.CodeStatusActive,
+			supersededBy: activeCodeID,
+			expErr:       types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			codeInfo := types.NewCodeInfo(nil, creatorAddr, types.AllowEverybody)
+			codeInfo.Status = spec.current
+			k.storeCodeInfo(ctx, codeID, codeInfo)
+			k.storeCodeInfo(ctx, activeCodeID, types.NewCodeInfo(nil, creatorAddr, types.AllowEverybody))
+			inactiveCodeInfo := types.NewCodeInfo(nil, creatorAddr, types.AllowEverybody)
+			inactiveCodeInfo.Status = types.CodeStatusDeprecated
+			k.storeCodeInfo(ctx, inactiveCodeID, inactiveCodeInfo)
+			// when
+			gotErr := k.setCodeStatus(ctx, codeID, spec.caller, spec.status, spec.reason, spec.supersededBy, spec.authz)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and stored
+			gotInfo := k.GetCodeInfo(ctx, codeID)
+			require.NotNil(t, gotInfo)
+			assert.Equal(t, spec.status, gotInfo.Status)
+			assert.Equal(t, spec.reason, gotInfo.StatusReason)
+			assert.Equal(t, spec.supersededBy, gotInfo.SupersededByCodeID)
+			// and event emitted
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, "update_code_status", em.Events()[0].Type)
+			assert.Equal(t, spec.expEvts, attrsToStringMap(em.Events()[0].Attributes))
+		})
+	}
+}
+
+func TestSetMigrationPolicy(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	nonCreatorAddr := RandomAccountAddress(t)
+	checksum := bytes.Repeat([]byte{1}, 32)
+	const (
+		codeID      = 1
+		otherCodeID = 2
+	)
+
+	specs := map[string]struct {
+		authz     AuthorizationPolicy
+		policy    types.MigrationPolicy
+		caller    sdk.AccAddress
+		expPolicy *types.MigrationPolicy
+		expErr    *sdkerrors.Error
+		expEvts   map[string]string
+	}{
+		"creator sets code ids and checksums": {
+			authz:     DefaultAuthorizationPolicy{},
+			policy:    types.MigrationPolicy{CodeIDs: []uint64{otherCodeID}, Checksums: []tmBytes.HexBytes{checksum}},
+			caller:    creatorAddr,
+			expPolicy: &types.MigrationPolicy{CodeIDs: []uint64{otherCodeID}, Checksums: []tmBytes.HexBytes{checksum}},
+			expEvts:   map[string]string{"code_id": "1", "allowed_code_ids": "2", "allowed_checksums": tmBytes.HexBytes(checksum).String(), "deny_all": "false"},
+		},
+		"creator removes policy": {
+			authz:   DefaultAuthorizationPolicy{},
+			caller:  creatorAddr,
+			expEvts: map[string]string{"code_id": "1", "allowed_code_ids": "", "allowed_checksums": "", "deny_all": "false"},
+		},
+		"creator denies all": {
+			authz:     DefaultAuthorizationPolicy{},
+			policy:    types.MigrationPolicy{DenyAll: true},
+			caller:    creatorAddr,
+			expPolicy: &types.MigrationPolicy{DenyAll: true},
+			expEvts:   map[string]string{"code_id": "1", "allowed_code_ids": "", "allowed_checksums": "", "deny_all": "true"},
+		},
+		"different actor": {
+			authz:  DefaultAuthorizationPolicy{},
+			policy: types.MigrationPolicy{CodeIDs: []uint64{otherCodeID}},
+			caller: nonCreatorAddr,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"gov sets policy": {
+			authz:     GovAuthorizationPolicy{},
+			policy:    types.MigrationPolicy{CodeIDs: []uint64{otherCodeID}},
+			expPolicy: &types.MigrationPolicy{CodeIDs: []uint64{otherCodeID}},
+			expEvts:   map[string]string{"code_id": "1", "allowed_code_ids": "2", "allowed_checksums": "", "deny_all": "false"},
+		},
+		"allowed code does not exist": {
+			authz:  GovAuthorizationPolicy{},
+			policy: types.MigrationPolicy{CodeIDs: []uint64{99}},
+			expErr: types.ErrNotFound,
+		},
+		"invalid policy": {
+			authz:  GovAuthorizationPolicy{},
+			policy: types.MigrationPolicy{Checksums: []tmBytes.HexBytes{checksum[1:]}},
+			expErr: types.ErrInvalid,
+		},
+		"deny all with allowed codes": {
+			authz:  GovAuthorizationPolicy{},
+			policy: types.MigrationPolicy{CodeIDs: []uint64{otherCodeID}, DenyAll: true},
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			codeInfo := types.NewCodeInfo(nil, creatorAddr, types.AllowEverybody)
+			codeInfo.MigrationPolicy = &types.MigrationPolicy{CodeIDs: []uint64{codeID}}
+			k.storeCodeInfo(ctx, codeID, codeInfo)
+			k.storeCodeInfo(ctx, otherCodeID, types.NewCodeInfo(nil, creatorAddr, types.AllowEverybody))
+			// when
+			gotErr := k.setMigrationPolicy(ctx, codeID, spec.caller, spec.policy, spec.authz)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and stored
+			gotInfo := k.GetCodeInfo(ctx, codeID)
+			require.NotNil(t, gotInfo)
+			assert.Equal(t, spec.expPolicy, gotInfo.MigrationPolicy)
+			// and event emitted
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, "update_migration_policy", em.Events()[0].Type)
+			assert.Equal(t, spec.expEvts, attrsToStringMap(em.Events()[0].Attributes))
+		})
+	}
+}
+
 func TestAppendToContractHistory(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	var contractAddr sdk.AccAddress = rand.Bytes(types.ContractAddrLen)
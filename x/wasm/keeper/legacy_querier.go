@@ -129,13 +129,9 @@ func queryContractState(ctx sdk.Context, bech, queryMethod string, data []byte,
 //nolint:unparam
 func queryCodeList(ctx sdk.Context, keeper types.ViewKeeper) ([]types.CodeInfoResponse, error) {
 	var info []types.CodeInfoResponse
+	verifiers := keeper.GetParams(ctx).CodeVerifiers
 	keeper.IterateCodeInfos(ctx, func(i uint64, res types.CodeInfo) bool {
-		info = append(info, types.CodeInfoResponse{
-			CodeID:                i,
-			Creator:               res.Creator,
-			DataHash:              res.CodeHash,
-			InstantiatePermission: res.InstantiateConfig,
-		})
+		info = append(info, newCodeInfoResponse(i, res, verifiers))
 		return false
 	})
 	return info, nil
@@ -23,6 +23,8 @@ type Metrics struct {
 	SudoElapsedTimes        metrics.Histogram
 	QuerySmartElapsedTimes  metrics.Histogram
 	QueryRawElapsedTimes    metrics.Histogram
+	ContractStateKeys       metrics.Gauge
+	ContractStateBytes      metrics.Gauge
 }
 
 func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
@@ -63,6 +65,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "query_raw",
 			Help:      "elapsed time of QueryRaw the wasm contract",
 		}, nil),
+		ContractStateKeys: go_prometheus.NewGaugeFrom(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "contract_state_keys",
+			Help:      "number of keys in the state of all wasm contracts",
+		}, nil),
+		ContractStateBytes: go_prometheus.NewGaugeFrom(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "contract_state_bytes",
+			Help:      "total bytes of keys and values in the state of all wasm contracts",
+		}, nil),
 	}
 }
 
@@ -75,6 +89,8 @@ func NopMetrics() *Metrics {
 		SudoElapsedTimes:        discard.NewHistogram(),
 		QuerySmartElapsedTimes:  discard.NewHistogram(),
 		QueryRawElapsedTimes:    discard.NewHistogram(),
+		ContractStateKeys:       discard.NewGauge(),
+		ContractStateBytes:      discard.NewGauge(),
 	}
 }
 
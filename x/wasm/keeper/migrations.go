@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -15,6 +17,42 @@ func NewMigrator(keeper Keeper) Migrator {
 }
 
 // Migrate1to2 migrates from version 1 to 2.
+// It sets the params added in version 2 to their defaults:
+//   - the IBC rate limit is disabled
+//   - the code verifiers are an empty list
+//   - the immutable override is disallowed
+//   - the max contract state bytes are unlimited
+//   - the storage deposit is disabled
+//   - the block hook max failures are set to the default
+//   - the block hook max gas limit is set to the default
+//
+// It also backfills the state size of all existing contracts and indexes the code references and queued
+// migrations that prevent codes from being pruned.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyIBCRateLimit, types.DefaultParams().IBCRateLimit)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyCodeVerifiers, types.DefaultParams().CodeVerifiers)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyImmutableOverride, types.DefaultParams().AllowImmutableOverride)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxStateBytes, types.DefaultParams().MaxContractStateBytes)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStorageDeposit, types.DefaultParams().StorageDepositPerByte)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyBlockHookFailures, types.DefaultParams().BlockHookMaxFailures)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyBlockHookGasLimit, types.DefaultParams().BlockHookMaxGasLimit)
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		m.keeper.storeContractStateSize(ctx, contractAddr, m.keeper.computeContractStateSize(ctx, contractAddr))
+		return false
+	})
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		m.keeper.addCodeReferences(ctx, codeID, info)
+		return false
+	})
+	var migrations []types.TimelockedOperation
+	m.keeper.IterateTimelockedOperations(ctx, func(op types.TimelockedOperation) bool {
+		if op.Migration != nil {
+			migrations = append(migrations, op)
+		}
+		return false
+	})
+	for _, op := range migrations {
+		m.keeper.storeTimelockedOperation(ctx, sdk.MustAccAddressFromBech32(op.ContractAddress), op)
+	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -414,6 +415,59 @@ func TestDispatchSubmessages(t *testing.T) {
 	}
 }
 
+func FuzzDispatchSubmessages(f *testing.F) {
+	f.Add([]byte(`[{"id":1,"msg":{"bank":{"send":{"to_address":"link1","amount":[]}}},"reply_on":"always"}]`))
+	f.Add([]byte(`[{"id":1,"msg":{"custom":"e30="},"gas_limit":10,"reply_on":"success"}]`))
+	f.Add([]byte(`[{"id":1,"msg":{"wasm":{"clear_admin":{"contract_addr":"link1"}}},"reply_on":"error"},{"id":2,"msg":{},"reply_on":"never"}]`))
+	f.Add([]byte(`[{"id":1,"msg":{},"reply_on":"unknown"}]`))
+	// the mock engine fails bank messages and consumes gas for the size of custom messages
+	msgHandler := &wasmtesting.MockMessageHandler{
+		DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+			if msg.Bank != nil {
+				return nil, nil, errors.New("test, ignore")
+			}
+			ctx.GasMeter().ConsumeGas(uint64(len(msg.Custom)), "testing")
+			return []sdk.Event{sdk.NewEvent("message"), sdk.NewEvent("dispatched")}, [][]byte{msg.Custom}, nil
+		},
+	}
+	replyer := &mockReplyer{
+		replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+			if reply.ID%2 == 0 {
+				return nil, errors.New("test, ignore")
+			}
+			return []byte(fmt.Sprintf("reply %d", reply.ID)), nil
+		},
+	}
+	contractAddr := RandomAccountAddress(f)
+	f.Fuzz(func(t *testing.T, src []byte) {
+		var msgs []wasmvmtypes.SubMsg
+		if err := json.Unmarshal(src, &msgs); err != nil || len(msgs) > 100 {
+			return
+		}
+		const gasLimit = 1_000_000
+		var mockStore wasmtesting.MockCommitMultiStore
+		em := sdk.NewEventManager()
+		ctx := sdk.Context{}.WithMultiStore(&mockStore).
+			WithGasMeter(sdk.NewGasMeter(gasLimit)).
+			WithEventManager(em).WithLogger(log.NewNopLogger())
+		defer func() {
+			// running out of gas on the parent gas meter is the only expected panic
+			if r := recover(); r != nil {
+				_, ok := r.(sdk.ErrorOutOfGas)
+				require.True(t, ok, "unexpected panic: %v", r)
+			}
+		}()
+		_, err := NewMessageDispatcher(msgHandler, replyer).DispatchSubmessages(ctx, contractAddr, "any_port", msgs)
+		if err != nil {
+			return
+		}
+		require.LessOrEqual(t, len(mockStore.Committed), len(msgs))
+		for _, e := range em.Events() {
+			require.NotEqual(t, "message", e.Type)
+		}
+	})
+}
+
 type mockReplyer struct {
 	replyFn func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
 }
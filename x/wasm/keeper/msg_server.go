@@ -33,6 +33,11 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 	if err != nil {
 		return nil, err
 	}
+	if msg.BuildMetadata != nil {
+		if err := m.keeper.SetCodeBuildMetadata(ctx, codeID, senderAddr, *msg.BuildMetadata); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgStoreCodeResponse{
 		CodeID:   codeID,
@@ -57,11 +62,20 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 			return nil, sdkerrors.Wrap(err, "admin")
 		}
 	}
+	if msg.Immutable {
+		// the sender is the admin until the contract is made immutable below
+		adminAddr = senderAddr
+	}
 
 	contractAddr, data, err := m.keeper.Instantiate(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds)
 	if err != nil {
 		return nil, err
 	}
+	if msg.Immutable {
+		if err := m.keeper.MakeContractImmutable(ctx, contractAddr, senderAddr); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgInstantiateContractResponse{
 		Address: contractAddr.String(),
@@ -86,11 +100,20 @@ func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgIns
 			return nil, sdkerrors.Wrap(err, "admin")
 		}
 	}
+	if msg.Immutable {
+		// the sender is the admin until the contract is made immutable below
+		adminAddr = senderAddr
+	}
 
-	contractAddr, data, err := m.keeper.Instantiate2(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, msg.Salt, msg.FixMsg)
+	contractAddr, data, err := m.keeper.Instantiate2(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, msg.Salt, msg.FixMsg, msg.AddressGenerator)
 	if err != nil {
 		return nil, err
 	}
+	if msg.Immutable {
+		if err := m.keeper.MakeContractImmutable(ctx, contractAddr, senderAddr); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgInstantiateContract2Response{
 		Address: contractAddr.String(),
@@ -195,3 +218,213 @@ func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (
 
 	return &types.MsgClearAdminResponse{}, nil
 }
+
+func (m msgServer) ProposeAdmin(goCtx context.Context, msg *types.MsgProposeAdmin) (*types.MsgProposeAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "new admin")
+	}
+
+	if err := m.keeper.ProposeContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr, msg.ExpiresAtHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeAdminResponse{}, nil
+}
+
+func (m msgServer) AcceptAdmin(goCtx context.Context, msg *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.AcceptContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptAdminResponse{}, nil
+}
+
+func (m msgServer) CancelAdminProposal(goCtx context.Context, msg *types.MsgCancelAdminProposal) (*types.MsgCancelAdminProposalResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.CancelContractAdminProposal(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAdminProposalResponse{}, nil
+}
+
+func (m msgServer) SetContractTimelock(goCtx context.Context, msg *types.MsgSetContractTimelock) (*types.MsgSetContractTimelockResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.SetContractTimelock(ctx, contractAddr, senderAddr, msg.DelayBlocks); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractTimelockResponse{}, nil
+}
+
+func (m msgServer) CancelTimelockedOperation(goCtx context.Context, msg *types.MsgCancelTimelockedOperation) (*types.MsgCancelTimelockedOperationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.CancelTimelockedOperation(ctx, contractAddr, senderAddr, msg.OperationID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelTimelockedOperationResponse{}, nil
+}
+
+func (m msgServer) SetCodeStatus(goCtx context.Context, msg *types.MsgSetCodeStatus) (*types.MsgSetCodeStatusResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := m.keeper.SetCodeStatus(ctx, msg.CodeID, senderAddr, msg.Status, msg.Reason, msg.SupersededByCodeID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCodeStatusResponse{}, nil
+}
+
+func (m msgServer) PruneCodes(goCtx context.Context, msg *types.MsgPruneCodes) (*types.MsgPruneCodesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	for _, codeID := range msg.CodeIDs {
+		if err := m.keeper.PruneCode(ctx, codeID, senderAddr); err != nil {
+			return nil, sdkerrors.Wrapf(err, "code id: %d", codeID)
+		}
+	}
+
+	return &types.MsgPruneCodesResponse{}, nil
+}
+
+func (m msgServer) SetMigrationPolicy(goCtx context.Context, msg *types.MsgSetMigrationPolicy) (*types.MsgSetMigrationPolicyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := m.keeper.SetMigrationPolicy(ctx, msg.CodeID, senderAddr, msg.MigrationPolicy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMigrationPolicyResponse{}, nil
+}
+
+func (m msgServer) VerifyCode(goCtx context.Context, msg *types.MsgVerifyCode) (*types.MsgVerifyCodeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := m.keeper.VerifyCode(ctx, msg.CodeID, senderAddr, msg.Checksum); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVerifyCodeResponse{}, nil
+}
+
+func (m msgServer) MakeContractImmutable(goCtx context.Context, msg *types.MsgMakeContractImmutable) (*types.MsgMakeContractImmutableResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.MakeContractImmutable(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMakeContractImmutableResponse{}, nil
+}
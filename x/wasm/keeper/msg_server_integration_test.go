@@ -691,3 +691,105 @@ func TestClearAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestInstantiateImmutableContract(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+
+	// setup
+	_, _, sender := testdata.KeyTestPubAddr()
+	storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+		m.Sender = sender.String()
+		m.WASMByteCode = wasmContract
+	})
+	rsp, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(ctx, storeMsg)
+	require.NoError(t, err)
+	var storeCodeResult types.MsgStoreCodeResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeCodeResult))
+
+	// when
+	initMsg := types.MsgInstantiateContractFixture(func(m *types.MsgInstantiateContract) {
+		m.Sender = myAddress.String()
+		m.Admin = ""
+		m.CodeID = storeCodeResult.CodeID
+		m.Msg = []byte(`{}`)
+		m.Funds = sdk.Coins{}
+		m.Immutable = true
+	})
+	rsp, err = wasmApp.MsgServiceRouter().Handler(initMsg)(ctx, initMsg)
+
+	// then
+	require.NoError(t, err)
+	var instantiateContractResult types.MsgInstantiateContractResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &instantiateContractResult))
+	contractAddress := instantiateContractResult.Address
+	info := wasmApp.WasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(contractAddress))
+	require.NotNil(t, info)
+	assert.True(t, info.Immutable)
+	assert.Empty(t, info.Admin)
+	assert.Equal(t, "make_contract_immutable", rsp.Events[len(rsp.Events)-1].Type)
+
+	// and the sender can not modify the contract anymore
+	updateMsg := &types.MsgUpdateAdmin{
+		Sender:   myAddress.String(),
+		NewAdmin: myAddress.String(),
+		Contract: contractAddress,
+	}
+	_, err = wasmApp.MsgServiceRouter().Handler(updateMsg)(ctx, updateMsg)
+	require.Error(t, err)
+}
+
+func TestStoreAndVerifyCodeWithBuildMetadata(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, verifier := testdata.KeyTestPubAddr()
+	params := wasmApp.WasmKeeper.GetParams(ctx)
+	params.CodeVerifiers = []string{verifier.String()}
+	wasmApp.WasmKeeper.SetParams(ctx, params)
+	metadata := types.CodeBuildMetadata{
+		Source:           "https://github.com/Finschia/wasmd",
+		Commit:           "1234abcd",
+		Builder:          "cosmwasm/rust-optimizer:0.12.13",
+		OptimizerVersion: "0.12.13",
+	}
+
+	// when
+	storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+		m.Sender = sender.String()
+		m.WASMByteCode = wasmContract
+		m.BuildMetadata = &metadata
+	})
+	rsp, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(ctx, storeMsg)
+
+	// then
+	require.NoError(t, err)
+	var storeCodeResult types.MsgStoreCodeResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeCodeResult))
+	info := wasmApp.WasmKeeper.GetCodeInfo(ctx, storeCodeResult.CodeID)
+	require.NotNil(t, info)
+	assert.Equal(t, &metadata, info.BuildMetadata)
+	assert.Empty(t, info.Verifications)
+
+	// and when verified by an allowlisted verifier
+	verifyMsg := &types.MsgVerifyCode{
+		Sender:   verifier.String(),
+		CodeID:   storeCodeResult.CodeID,
+		Checksum: storeCodeResult.Checksum,
+	}
+	_, err = wasmApp.MsgServiceRouter().Handler(verifyMsg)(ctx, verifyMsg)
+
+	// then
+	require.NoError(t, err)
+	info = wasmApp.WasmKeeper.GetCodeInfo(ctx, storeCodeResult.CodeID)
+	require.NotNil(t, info)
+	assert.True(t, info.IsVerifiedBy(verifier))
+
+	// and others can not verify
+	verifyMsg.Sender = sender.String()
+	_, err = wasmApp.MsgServiceRouter().Handler(verifyMsg)(ctx, verifyMsg)
+	require.Error(t, err)
+}
@@ -100,6 +100,28 @@ func WithCoinTransferrer(x CoinTransferrer) Option {
 	})
 }
 
+// WithContractFeeDeducter is an optional constructor parameter to set a custom type that deducts the fees of txs
+// sponsored by contracts
+func WithContractFeeDeducter(x ContractFeeDeducter) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.feeDeducter = x
+	})
+}
+
+// WithStorageDepositKeeper is an optional constructor parameter to set a custom type that holds the storage deposits
+// of contracts
+func WithStorageDepositKeeper(x StorageDepositKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.storageDeposits = x
+	})
+}
+
 // WithAccountPruner is an optional constructor parameter to set a custom type that handles balances and data cleanup
 // for accounts pruned on contract instantiate
 func WithAccountPruner(x AccountPruner) Option {
@@ -144,6 +166,44 @@ func WithMaxQueryStackSize(m uint32) Option {
 	})
 }
 
+// WithTimelockedOperationGasLimit overwrites the default max gas that a queued admin operation can consume on execution
+func WithTimelockedOperationGasLimit(limit uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.timelockedOperationGasLimit = limit
+	})
+}
+
+// WithSnapshotRestoreConcurrency overwrites the max number of wasm codes that are compiled in parallel on snapshot restore
+func WithSnapshotRestoreConcurrency(n uint32) Option {
+	return optsFn(func(k *Keeper) {
+		k.snapshotRestoreConcurrency = n
+	})
+}
+
+// WithGenesisExportFilter limits the codes, contracts and contract state that are written on genesis export
+func WithGenesisExportFilter(f GenesisExportFilter) Option {
+	return optsFn(func(k *Keeper) {
+		k.genesisExportFilter = f
+	})
+}
+
+// WithAddressGenerator registers an address builder that can be selected by name on instantiate2, for example to
+// derive contract addresses that are compatible with another chain. Names must be unique.
+func WithAddressGenerator(name string, builder PredictableAddressBuilder) Option {
+	if err := types.ValidateAddressGeneratorName(name); err != nil {
+		panic(err)
+	}
+	if builder == nil {
+		panic(types.ErrEmpty.Wrap("address builder"))
+	}
+	return optsFn(func(k *Keeper) {
+		if _, exists := k.addressBuilders[name]; exists {
+			panic(types.ErrDuplicate.Wrapf("address generator %q", name))
+		}
+		k.addressBuilders[name] = builder
+	})
+}
+
 // WithAcceptedAccountTypesOnContractInstantiation sets the accepted account types. Account types of this list won't be overwritten or cause a failure
 // when they exist for an address on contract instantiation.
 //
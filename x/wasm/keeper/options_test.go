@@ -66,6 +66,18 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, &wasmtesting.MockCoinTransferrer{}, k.bank)
 			},
 		},
+		"contract fee deducter": {
+			srcOpt: WithContractFeeDeducter(&wasmtesting.MockContractFeeDeducter{}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.IsType(t, &wasmtesting.MockContractFeeDeducter{}, k.feeDeducter)
+			},
+		},
+		"storage deposit keeper": {
+			srcOpt: WithStorageDepositKeeper(&wasmtesting.MockStorageDepositKeeper{}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.IsType(t, &wasmtesting.MockStorageDepositKeeper{}, k.storageDeposits)
+			},
+		},
 		"costs": {
 			srcOpt: WithGasRegister(&wasmtesting.MockGasRegister{}),
 			verify: func(t *testing.T, k Keeper) {
@@ -86,6 +98,18 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, uint32(1), k.maxQueryStackSize)
 			},
 		},
+		"snapshot restore concurrency": {
+			srcOpt: WithSnapshotRestoreConcurrency(8),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, uint32(8), k.snapshotRestoreConcurrency)
+			},
+		},
+		"genesis export filter": {
+			srcOpt: WithGenesisExportFilter(GenesisExportFilter{ExcludeStateForCodes: []uint64{1}, StripCodeBytes: true}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, GenesisExportFilter{ExcludeStateForCodes: []uint64{1}, StripCodeBytes: true}, k.genesisExportFilter)
+			},
+		},
 		"accepted account types": {
 			srcOpt: WithAcceptedAccountTypesOnContractInstantiation(&authtypes.BaseAccount{}, &vestingtypes.ContinuousVestingAccount{}),
 			verify: func(t *testing.T, k Keeper) {
@@ -102,6 +126,13 @@ func TestConstructorOptions(t *testing.T) {
 				assert.Equal(t, VestingCoinBurner{}, k.accountPruner)
 			},
 		},
+		"address generator": {
+			srcOpt: WithAddressGenerator("custom", BuildContractAddressPredictable),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Contains(t, k.addressBuilders, "custom")
+				assert.Contains(t, k.addressBuilders, types.DefaultAddressGenerator)
+			},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -111,6 +142,27 @@ func TestConstructorOptions(t *testing.T) {
 	}
 }
 
+func TestWithAddressGeneratorPanics(t *testing.T) {
+	newKeeper := func(opts ...Option) Keeper {
+		return NewKeeper(nil, nil, paramtypes.NewSubspace(nil, nil, nil, nil, ""), authkeeper.AccountKeeper{}, bankpluskeeper.BaseKeeper{}, stakingkeeper.Keeper{}, distributionkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, "tempDir", types.DefaultWasmConfig(), AvailableCapabilities, opts...)
+	}
+	specs := map[string]func(){
+		"invalid name": func() { WithAddressGenerator("Invalid Name", BuildContractAddressPredictable) },
+		"nil builder":  func() { WithAddressGenerator("custom", nil) },
+		"default name": func() {
+			newKeeper(WithAddressGenerator(types.DefaultAddressGenerator, BuildContractAddressPredictable))
+		},
+		"duplicate name": func() {
+			newKeeper(WithAddressGenerator("custom", BuildContractAddressPredictable), WithAddressGenerator("custom", BuildContractAddressPredictable))
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Panics(t, spec)
+		})
+	}
+}
+
 func setApiDefaults() {
 	costHumanize = DefaultGasCostHumanAddress * DefaultGasMultiplier
 	costCanonical = DefaultGasCostCanonicalAddress * DefaultGasMultiplier
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// GetPendingAdmin returns the proposed admin of the contract or nil when no admin was proposed
+func (k Keeper) GetPendingAdmin(ctx sdk.Context, contractAddr sdk.AccAddress) *types.PendingAdmin {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingAdminKey(contractAddr))
+	if bz == nil {
+		return nil
+	}
+	var pending types.PendingAdmin
+	k.cdc.MustUnmarshal(bz, &pending)
+	return &pending
+}
+
+// IteratePendingAdmins iterates through all proposed admins ordered by contract address.
+// The callback method can return true to abort early.
+func (k Keeper) IteratePendingAdmins(ctx sdk.Context, cb func(types.PendingAdmin) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAdminPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pending types.PendingAdmin
+		k.cdc.MustUnmarshal(iter.Value(), &pending)
+		// cb returns true to stop early
+		if cb(pending) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storePendingAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, pending types.PendingAdmin) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingAdminKey(contractAddr), k.cdc.MustMarshal(&pending))
+}
+
+// proposeContractAdmin stores the new admin that has to accept before it becomes the contract admin.
+// An existing proposal is replaced.
+func (k Keeper) proposeContractAdmin(ctx sdk.Context, contractAddr, caller, newAdmin sdk.AccAddress, expiresAtHeight uint64, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := k.assertContractMutable(ctx, contractInfo, caller, authZ); err != nil {
+		return err
+	}
+	pending := types.PendingAdmin{
+		ContractAddress: contractAddr.String(),
+		NewAdmin:        newAdmin.String(),
+		ExpiresAtHeight: expiresAtHeight,
+	}
+	if err := pending.ValidateBasic(); err != nil {
+		return err
+	}
+	if pending.IsExpired(ctx.BlockHeight()) {
+		return sdkerrors.Wrap(types.ErrInvalid, "expiry height must be in the future")
+	}
+	k.storePendingAdmin(ctx, contractAddr, pending)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposeContractAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, pending.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyPendingAdmin, pending.NewAdmin),
+		sdk.NewAttribute(types.AttributeKeyExpiresAtHeight, strconv.FormatUint(expiresAtHeight, 10)),
+	))
+	return nil
+}
+
+// acceptContractAdmin sets the caller as contract admin when it was proposed and the proposal did not expire.
+// The admin update is queued when the contract is timelocked.
+func (k Keeper) acceptContractAdmin(ctx sdk.Context, contractAddr, caller sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	pending := k.GetPendingAdmin(ctx, contractAddr)
+	if pending == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "pending admin")
+	}
+	if pending.NewAdmin != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not the pending admin")
+	}
+	if pending.IsExpired(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrPendingAdminExpired, "at height %d", pending.ExpiresAtHeight)
+	}
+	if err := k.assertContractMutable(ctx, contractInfo, caller, DefaultAuthorizationPolicy{}); err != nil {
+		return err
+	}
+	// the admin update of a timelocked contract is queued on behalf of the admin that proposed it
+	op := types.TimelockedOperation{Type: types.TimelockedOperationTypeUpdateAdmin, NewAdmin: pending.NewAdmin}
+	queued, err := k.queueTimelockedOperation(ctx, contractAddr, contractInfo.AdminAddr(), op, DefaultAuthorizationPolicy{})
+	if err != nil {
+		return err
+	}
+	if queued {
+		ctx.KVStore(k.storeKey).Delete(types.GetPendingAdminKey(contractAddr))
+		return nil
+	}
+	k.updateContractAdmin(ctx, contractAddr, contractInfo, caller)
+	return nil
+}
+
+// cancelContractAdminProposal removes the proposed admin of the contract
+func (k Keeper) cancelContractAdminProposal(ctx sdk.Context, contractAddr, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	key := types.GetPendingAdminKey(contractAddr)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(types.ErrNotFound, "pending admin")
+	}
+	store.Delete(key)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelContractAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
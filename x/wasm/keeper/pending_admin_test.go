@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestProposeContractAdmin(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	parentCtx = parentCtx.WithBlockHeight(10)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newAdmin := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		contract  sdk.AccAddress
+		caller    sdk.AccAddress
+		expiresAt uint64
+		expErr    *sdkerrors.Error
+	}{
+		"admin can propose": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+		},
+		"with expiry in the future": {
+			contract:  example.Contract,
+			caller:    example.CreatorAddr,
+			expiresAt: 11,
+		},
+		"expiry at current height": {
+			contract:  example.Contract,
+			caller:    example.CreatorAddr,
+			expiresAt: 10,
+			expErr:    types.ErrInvalid,
+		},
+		"non admin": {
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			contract: RandomAccountAddress(t),
+			caller:   example.CreatorAddr,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := keepers.ContractKeeper.ProposeContractAdmin(ctx.WithEventManager(em), spec.contract, spec.caller, newAdmin, spec.expiresAt)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Nil(t, keepers.WasmKeeper.GetPendingAdmin(ctx, spec.contract))
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.PendingAdmin{
+				ContractAddress: spec.contract.String(),
+				NewAdmin:        newAdmin.String(),
+				ExpiresAtHeight: spec.expiresAt,
+			}
+			assert.Equal(t, &exp, keepers.WasmKeeper.GetPendingAdmin(ctx, spec.contract))
+			// admin not changed yet
+			assert.Equal(t, example.CreatorAddr.String(), keepers.WasmKeeper.GetContractInfo(ctx, spec.contract).Admin)
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeProposeContractAdmin, em.Events()[0].Type)
+			assert.Equal(t, newAdmin.String(), attrsToStringMap(em.Events()[0].Attributes)[types.AttributeKeyPendingAdmin])
+		})
+	}
+}
+
+func TestAcceptContractAdmin(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	parentCtx = parentCtx.WithBlockHeight(10)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newAdmin := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		expiresAt   uint64
+		skipPropose bool
+		caller      sdk.AccAddress
+		height      int64
+		expErr      *sdkerrors.Error
+	}{
+		"pending admin accepts": {
+			caller: newAdmin,
+			height: 10,
+		},
+		"accepted before expiry": {
+			expiresAt: 11,
+			caller:    newAdmin,
+			height:    10,
+		},
+		"expired": {
+			expiresAt: 11,
+			caller:    newAdmin,
+			height:    11,
+			expErr:    types.ErrPendingAdminExpired,
+		},
+		"other account": {
+			caller: RandomAccountAddress(t),
+			height: 10,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"current admin": {
+			caller: example.CreatorAddr,
+			height: 10,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"nothing proposed": {
+			skipPropose: true,
+			caller:      newAdmin,
+			height:      10,
+			expErr:      types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if !spec.skipPropose {
+				require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin, spec.expiresAt))
+			}
+			ctx = ctx.WithBlockHeight(spec.height)
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := keepers.ContractKeeper.AcceptContractAdmin(ctx.WithEventManager(em), example.Contract, spec.caller)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Equal(t, example.CreatorAddr.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, newAdmin.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+			assert.Nil(t, keepers.WasmKeeper.GetPendingAdmin(ctx, example.Contract))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeUpdateContractAdmin, em.Events()[0].Type)
+			assert.Equal(t, newAdmin.String(), attrsToStringMap(em.Events()[0].Attributes)[types.AttributeKeyNewAdmin])
+		})
+	}
+}
+
+func TestPendingAdminOnImmutableContract(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	require.NoError(t, keepers.ContractKeeper.MakeContractImmutable(parentCtx, example.Contract, example.CreatorAddr))
+	// an admin set by governance with the immutable override
+	info := keepers.WasmKeeper.GetContractInfo(parentCtx, example.Contract)
+	info.Admin = example.CreatorAddr.String()
+	keepers.WasmKeeper.storeContractInfo(parentCtx, example.Contract, info)
+	newAdmin := RandomAccountAddress(t)
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"propose": func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin, 0)
+		},
+		"accept": func(ctx sdk.Context) error {
+			keepers.WasmKeeper.storePendingAdmin(ctx, example.Contract, types.PendingAdmin{
+				ContractAddress: example.Contract.String(),
+				NewAdmin:        newAdmin.String(),
+			})
+			return keepers.ContractKeeper.AcceptContractAdmin(ctx, example.Contract, newAdmin)
+		},
+	}
+	for name, op := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := types.DefaultParams()
+			params.AllowImmutableOverride = true
+			keepers.WasmKeeper.SetParams(ctx, params)
+
+			// when
+			gotErr := op(ctx)
+
+			// then
+			assert.True(t, types.ErrImmutableContract.Is(gotErr), gotErr)
+			assert.Equal(t, info, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract))
+		})
+	}
+}
+
+func TestCancelContractAdminProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newAdmin := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		skipPropose bool
+		caller      sdk.AccAddress
+		expErr      *sdkerrors.Error
+	}{
+		"admin cancels": {
+			caller: example.CreatorAddr,
+		},
+		"pending admin can not cancel": {
+			caller: newAdmin,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"nothing proposed": {
+			skipPropose: true,
+			caller:      example.CreatorAddr,
+			expErr:      types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if !spec.skipPropose {
+				require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin, 0))
+			}
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := keepers.ContractKeeper.CancelContractAdminProposal(ctx.WithEventManager(em), example.Contract, spec.caller)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, keepers.WasmKeeper.GetPendingAdmin(ctx, example.Contract))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeCancelContractAdmin, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestAdminChangeDropsPendingAdmin(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"update admin": func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.UpdateContractAdmin(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t))
+		},
+		"clear admin": func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.ClearContractAdmin(ctx, example.Contract, example.CreatorAddr)
+		},
+	}
+	for name, change := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t), 0))
+
+			// when
+			require.NoError(t, change(ctx))
+
+			// then
+			assert.Nil(t, keepers.WasmKeeper.GetPendingAdmin(ctx, example.Contract))
+		})
+	}
+}
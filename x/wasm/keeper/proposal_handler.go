@@ -49,6 +49,22 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleUnpinCodesProposal(ctx, k, *c)
 		case *types.UpdateInstantiateConfigProposal:
 			return handleUpdateInstantiateConfigProposal(ctx, k, *c)
+		case *types.RegisterBlockHookProposal:
+			return handleRegisterBlockHookProposal(ctx, k, *c)
+		case *types.DeregisterBlockHookProposal:
+			return handleDeregisterBlockHookProposal(ctx, k, *c)
+		case *types.SetCodeStatusProposal:
+			return handleSetCodeStatusProposal(ctx, k, *c)
+		case *types.PruneCodesProposal:
+			return handlePruneCodesProposal(ctx, k, *c)
+		case *types.SetCodeStateLimitProposal:
+			return handleSetCodeStateLimitProposal(ctx, k, *c)
+		case *types.SetMigrationPolicyProposal:
+			return handleSetMigrationPolicyProposal(ctx, k, *c)
+		case *types.SetIBCRateLimitProposal:
+			return handleSetIBCRateLimitProposal(ctx, k, *c)
+		case *types.SetContractAnteHookProposal:
+			return handleSetContractAnteHookProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -240,3 +256,86 @@ func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k types.ContractOpsK
 	}
 	return nil
 }
+
+func handleRegisterBlockHookProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.RegisterBlockHookProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.RegisterBlockHook(ctx, contractAddr, p.Phase, p.GasLimit)
+}
+
+func handleDeregisterBlockHookProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.DeregisterBlockHookProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.DeregisterBlockHook(ctx, contractAddr, p.Phase)
+}
+
+func handleSetCodeStatusProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.SetCodeStatusProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	var emptyCaller sdk.AccAddress
+	return k.SetCodeStatus(ctx, p.CodeID, emptyCaller, p.Status, p.Reason, p.SupersededByCodeID)
+}
+
+func handlePruneCodesProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.PruneCodesProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	var emptyCaller sdk.AccAddress
+	for _, v := range p.CodeIDs {
+		if err := k.PruneCode(ctx, v, emptyCaller); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", v)
+		}
+	}
+	return nil
+}
+
+func handleSetCodeStateLimitProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.SetCodeStateLimitProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.SetCodeStateLimit(ctx, p.CodeID, p.MaxStateBytes)
+}
+
+func handleSetMigrationPolicyProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.SetMigrationPolicyProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	var emptyCaller sdk.AccAddress
+	return k.SetMigrationPolicy(ctx, p.CodeID, emptyCaller, p.MigrationPolicy)
+}
+
+func handleSetIBCRateLimitProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.SetIBCRateLimitProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.SetIBCRateLimit(ctx, contractAddr, p.ChannelID, p.Limit)
+}
+
+func handleSetContractAnteHookProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.SetContractAnteHookProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.SetContractAnteHook(ctx, contractAddr, p.Hook, p.Enabled)
+}
@@ -493,6 +493,98 @@ func TestAdminProposals(t *testing.T) {
 	}
 }
 
+func TestAdminProposalsOnImmutableContract(t *testing.T) {
+	var (
+		otherAddress sdk.AccAddress = bytes.Repeat([]byte{0x2}, types.ContractAddrLen)
+		contractAddr                = BuildContractAddressClassic(1, 1)
+	)
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcProposal   govtypes.Content
+		allowOverride bool
+		expAdmin      sdk.AccAddress
+		expErr        bool
+	}{
+		"update admin": {
+			srcProposal: &types.UpdateAdminProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+				NewAdmin:    otherAddress.String(),
+			},
+			expErr: true,
+		},
+		"clear admin": {
+			srcProposal: &types.ClearAdminProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+			},
+			expErr: true,
+		},
+		"migrate": {
+			srcProposal: &types.MigrateContractProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+				CodeID:      1,
+				Msg:         []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"update admin with override": {
+			srcProposal: &types.UpdateAdminProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+				NewAdmin:    otherAddress.String(),
+			},
+			allowOverride: true,
+			expAdmin:      otherAddress,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, "staking")
+			govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+			wasmKeeper.SetParams(ctx, types.Params{
+				CodeUploadAccess:             types.AllowNobody,
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+				AllowImmutableOverride:       spec.allowOverride,
+			})
+
+			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
+			require.NoError(t, wasmKeeper.importCode(ctx, 1, codeInfoFixture, wasmCode))
+
+			state := types.ContractInfoFixture(func(info *types.ContractInfo) {
+				info.Admin = ""
+				info.Immutable = true
+			})
+			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &state, []types.Model{}))
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, spec.srcProposal)
+
+			// then the proposal is rejected
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), types.ErrImmutableContract.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			// and execute proposal
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			cInfo := wasmKeeper.GetContractInfo(ctx, contractAddr)
+			require.NotNil(t, cInfo)
+			assert.Equal(t, spec.expAdmin.String(), cInfo.Admin)
+			assert.True(t, cInfo.Immutable)
+		})
+	}
+}
+
 func TestUpdateParamsProposal(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, "staking")
 	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
@@ -859,3 +951,373 @@ func TestUpdateInstantiateConfigProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockHookProposals(t *testing.T) {
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	parentCtx, keepers := CreateTestInput(t, false, "staking", WithWasmEngine(mock))
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	contractAddr := SeedNewContractInstance(t, parentCtx, keepers, mock).Contract
+	otherAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		existing *types.BlockHook
+		src      govtypes.Content
+		expHook  *types.BlockHook
+		expErr   bool
+	}{
+		"register": {
+			src: types.RegisterBlockHookProposalFixture(func(p *types.RegisterBlockHookProposal) {
+				p.Contract = contractAddr.String()
+			}),
+			expHook: &types.BlockHook{ContractAddress: contractAddr.String(), Phase: types.BlockHookPhaseEndBlock, GasLimit: 100_000},
+		},
+		"register unknown contract": {
+			src: types.RegisterBlockHookProposalFixture(func(p *types.RegisterBlockHookProposal) {
+				p.Contract = otherAddr.String()
+			}),
+			expErr: true,
+		},
+		"deregister": {
+			existing: &types.BlockHook{ContractAddress: contractAddr.String(), Phase: types.BlockHookPhaseEndBlock, GasLimit: 1},
+			src: types.DeregisterBlockHookProposalFixture(func(p *types.DeregisterBlockHookProposal) {
+				p.Contract = contractAddr.String()
+			}),
+		},
+		"deregister not registered": {
+			src: types.DeregisterBlockHookProposalFixture(func(p *types.DeregisterBlockHookProposal) {
+				p.Contract = contractAddr.String()
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.existing != nil {
+				wasmKeeper.storeBlockHook(ctx, contractAddr, *spec.existing)
+			}
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			// then
+			assert.Equal(t, spec.expHook, wasmKeeper.GetBlockHook(ctx, types.BlockHookPhaseEndBlock, contractAddr))
+		})
+	}
+}
+
+func TestSetCodeStatusProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	revokedCodeInfo := types.CodeInfoFixture(func(info *types.CodeInfo) {
+		info.Creator = creatorAddr.String()
+		info.Status = types.CodeStatusRevoked
+	})
+
+	specs := map[string]struct {
+		src       *types.SetCodeStatusProposal
+		expStatus types.CodeStatus
+		expErr    bool
+	}{
+		"deprecate": {
+			src:       types.SetCodeStatusProposalFixture(),
+			expStatus: types.CodeStatusDeprecated,
+		},
+		"reactivate": {
+			src: types.SetCodeStatusProposalFixture(func(p *types.SetCodeStatusProposal) {
+				p.Status = types.CodeStatusActive
+				p.Reason = ""
+			}),
+			expStatus: types.CodeStatusActive,
+		},
+		"unknown code": {
+			src: types.SetCodeStatusProposalFixture(func(p *types.SetCodeStatusProposal) {
+				p.CodeID = 99
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			wasmKeeper.storeCodeInfo(ctx, 1, revokedCodeInfo)
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			// then
+			gotInfo := wasmKeeper.GetCodeInfo(ctx, 1)
+			require.NotNil(t, gotInfo)
+			assert.Equal(t, spec.expStatus, gotInfo.Status)
+			assert.Equal(t, spec.src.Reason, gotInfo.StatusReason)
+		})
+	}
+}
+
+func TestSetMigrationPolicyProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	codeInfo := types.CodeInfoFixture()
+
+	specs := map[string]struct {
+		src       *types.SetMigrationPolicyProposal
+		expPolicy *types.MigrationPolicy
+		expErr    bool
+	}{
+		"set policy": {
+			src:       types.SetMigrationPolicyProposalFixture(),
+			expPolicy: &types.MigrationPolicy{CodeIDs: []uint64{2}},
+		},
+		"remove policy": {
+			src: types.SetMigrationPolicyProposalFixture(func(p *types.SetMigrationPolicyProposal) {
+				p.MigrationPolicy = types.MigrationPolicy{}
+			}),
+		},
+		"deny all": {
+			src: types.SetMigrationPolicyProposalFixture(func(p *types.SetMigrationPolicyProposal) {
+				p.MigrationPolicy = types.MigrationPolicy{DenyAll: true}
+			}),
+			expPolicy: &types.MigrationPolicy{DenyAll: true},
+		},
+		"unknown code": {
+			src: types.SetMigrationPolicyProposalFixture(func(p *types.SetMigrationPolicyProposal) {
+				p.CodeID = 99
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			wasmKeeper.storeCodeInfo(ctx, 1, codeInfo)
+			wasmKeeper.storeCodeInfo(ctx, 2, codeInfo)
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			// then
+			gotInfo := wasmKeeper.GetCodeInfo(ctx, 1)
+			require.NotNil(t, gotInfo)
+			assert.Equal(t, spec.expPolicy, gotInfo.MigrationPolicy)
+		})
+	}
+}
+
+func TestSetCodeStateLimitProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	codeInfo := types.CodeInfoFixture()
+
+	specs := map[string]struct {
+		src    *types.SetCodeStateLimitProposal
+		expMax uint64
+		expErr bool
+	}{
+		"set override": {
+			src:    types.SetCodeStateLimitProposalFixture(),
+			expMax: 1024,
+		},
+		"remove override": {
+			src: types.SetCodeStateLimitProposalFixture(func(p *types.SetCodeStateLimitProposal) {
+				p.MaxStateBytes = 0
+			}),
+		},
+		"unknown code": {
+			src: types.SetCodeStateLimitProposalFixture(func(p *types.SetCodeStateLimitProposal) {
+				p.CodeID = 99
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			wasmKeeper.storeCodeInfo(ctx, 1, codeInfo)
+			wasmKeeper.storeCodeStateLimit(ctx, 1, 1)
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			// then
+			assert.Equal(t, spec.expMax, wasmKeeper.GetMaxContractStateBytes(ctx, 1))
+		})
+	}
+}
+
+func TestSetIBCRateLimitProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+	contractInfo := types.ContractInfoFixture()
+
+	specs := map[string]struct {
+		src      *types.SetIBCRateLimitProposal
+		expLimit types.IBCRateLimit
+		expErr   bool
+	}{
+		"set override": {
+			src: types.SetIBCRateLimitProposalFixture(func(p *types.SetIBCRateLimitProposal) {
+				p.Contract = contractAddr.String()
+			}),
+			expLimit: types.IBCRateLimit{MaxPacketsPerBlock: 1},
+		},
+		"remove override": {
+			src: types.SetIBCRateLimitProposalFixture(func(p *types.SetIBCRateLimitProposal) {
+				p.Contract = contractAddr.String()
+				p.Limit = nil
+			}),
+		},
+		"unknown contract": {
+			src: types.SetIBCRateLimitProposalFixture(func(p *types.SetIBCRateLimitProposal) {
+				p.Contract = RandomBech32AccountAddress(t)
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			wasmKeeper.storeContractInfo(ctx, contractAddr, &contractInfo)
+			wasmKeeper.storeIBCRateLimitOverride(ctx, contractAddr, spec.src.ChannelID, &types.IBCRateLimit{MaxPacketsPerBlock: 2})
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			// then
+			assert.Equal(t, spec.expLimit, wasmKeeper.GetIBCRateLimit(ctx, contractAddr, spec.src.ChannelID))
+		})
+	}
+}
+
+func TestSetContractAnteHookProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+	contractInfo := types.ContractInfoFixture()
+
+	specs := map[string]struct {
+		src        *types.SetContractAnteHookProposal
+		expEnabled bool
+		expErr     bool
+	}{
+		"enable": {
+			src: types.SetContractAnteHookProposalFixture(func(p *types.SetContractAnteHookProposal) {
+				p.Contract = contractAddr.String()
+			}),
+			expEnabled: true,
+		},
+		"disable": {
+			src: types.SetContractAnteHookProposalFixture(func(p *types.SetContractAnteHookProposal) {
+				p.Contract = contractAddr.String()
+				p.Enabled = false
+			}),
+		},
+		"unknown contract": {
+			src: types.SetContractAnteHookProposalFixture(func(p *types.SetContractAnteHookProposal) {
+				p.Contract = RandomBech32AccountAddress(t)
+			}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			wasmKeeper.storeContractInfo(ctx, contractAddr, &contractInfo)
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			// then
+			assert.Equal(t, spec.expEnabled, wasmKeeper.HasContractAnteHook(ctx, contractAddr, types.AnteHookAuthentication))
+		})
+	}
+}
+
+func TestPruneCodesProposal(t *testing.T) {
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	parentCtx, keepers := CreateTestInput(t, false, "staking", WithWasmEngine(mock))
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	usedCodeID := SeedNewContractInstance(t, parentCtx, keepers, mock).CodeID
+	unusedCodeID := StoreRandomContract(t, parentCtx, keepers, mock).CodeID
+
+	specs := map[string]struct {
+		codeIDs []uint64
+		expErr  bool
+	}{
+		"unused code": {
+			codeIDs: []uint64{unusedCodeID},
+		},
+		"used code": {
+			codeIDs: []uint64{unusedCodeID, usedCodeID},
+			expErr:  true,
+		},
+		"unknown code": {
+			codeIDs: []uint64{99},
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			src := &types.PruneCodesProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				CodeIDs:     spec.codeIDs,
+			}
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			require.NoError(t, handler(ctx, storedProposal.GetContent()))
+			// then
+			for _, codeID := range spec.codeIDs {
+				assert.Nil(t, wasmKeeper.GetCodeInfo(ctx, codeID))
+			}
+			assert.NotNil(t, wasmKeeper.GetCodeInfo(ctx, usedCodeID))
+		})
+	}
+}
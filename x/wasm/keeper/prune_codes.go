@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// pruneCode removes the code info of a code that is not used by any contract, not pinned, not referenced by another
+// code as superseding code or in its migration policy and not the target of a queued migration. The wasm files are
+// not removed from the wasmvm cache as wasmvm can not remove code: the byte code stays on disk and is still served
+// by GetCode for its checksum.
+func (k Keeper) pruneCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authz AuthorizationPolicy) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if !authz.CanPruneCode(sdk.MustAccAddressFromBech32(codeInfo.Creator), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not prune code")
+	}
+	if k.IsPinnedCode(ctx, codeID) {
+		return sdkerrors.Wrap(types.ErrCodeInUse, "pinned")
+	}
+	var inUse bool
+	k.IterateContractsByCode(ctx, codeID, func(sdk.AccAddress) bool {
+		inUse = true
+		return true
+	})
+	if inUse {
+		return sdkerrors.Wrap(types.ErrCodeInUse, "used by contracts")
+	}
+	if k.hasPrefix(ctx, types.GetCodeReferenceSecondaryIndexPrefix(codeID)) {
+		return sdkerrors.Wrap(types.ErrCodeInUse, "referenced by other code")
+	}
+	if k.hasPrefix(ctx, types.GetTimelockedMigrationSecondaryIndexPrefix(codeID)) {
+		return sdkerrors.Wrap(types.ErrCodeInUse, "target of queued migration")
+	}
+
+	k.deleteCodeReferences(ctx, codeID, *codeInfo)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCodeKey(codeID))
+	store.Delete(types.GetCodeStateLimitKey(codeID))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePruneCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(codeInfo.CodeHash)),
+	))
+	return nil
+}
+
+// codeReferences returns the ids of the other codes that the code info references as superseding code or in the
+// migration policy
+func codeReferences(codeID uint64, info types.CodeInfo) []uint64 {
+	var ids []uint64
+	if info.SupersededByCodeID != 0 && info.SupersededByCodeID != codeID {
+		ids = append(ids, info.SupersededByCodeID)
+	}
+	if info.MigrationPolicy != nil {
+		for _, id := range info.MigrationPolicy.CodeIDs {
+			if id != codeID {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// addCodeReferences adds the codes referenced by the code info to the secondary index
+func (k Keeper) addCodeReferences(ctx sdk.Context, codeID uint64, info types.CodeInfo) {
+	store := ctx.KVStore(k.storeKey)
+	for _, id := range codeReferences(codeID, info) {
+		store.Set(types.GetCodeReferenceSecondaryIndexKey(id, codeID), []byte{})
+	}
+}
+
+// deleteCodeReferences removes the codes referenced by the code info from the secondary index
+func (k Keeper) deleteCodeReferences(ctx sdk.Context, codeID uint64, info types.CodeInfo) {
+	store := ctx.KVStore(k.storeKey)
+	for _, id := range codeReferences(codeID, info) {
+		store.Delete(types.GetCodeReferenceSecondaryIndexKey(id, codeID))
+	}
+}
+
+// hasPrefix returns true when the store contains any key with the prefix
+func (k Keeper) hasPrefix(ctx sdk.Context, keyPrefix []byte) bool {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	return iter.Valid()
+}
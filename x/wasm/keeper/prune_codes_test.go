@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	wasmvm "github.com/Finschia/wasmvm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestPruneCode(t *testing.T) {
+	mock := &wasmtesting.MockWasmer{
+		PinFn: func(wasmvm.Checksum) error { return nil },
+	}
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(mock))
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	const codeID = 1
+	myChecksum := []byte("myChecksum")
+
+	specs := map[string]struct {
+		setup  func(ctx sdk.Context)
+		authz  AuthorizationPolicy
+		caller sdk.AccAddress
+		expErr *sdkerrors.Error
+	}{
+		"creator prunes unused code": {
+			authz:  DefaultAuthorizationPolicy{},
+			caller: creatorAddr,
+		},
+		"gov prunes unused code": {
+			authz: GovAuthorizationPolicy{},
+		},
+		"checksum shared with other code": {
+			setup: func(ctx sdk.Context) {
+				k.storeCodeInfo(ctx, 2, types.NewCodeInfo(myChecksum, creatorAddr, types.AllowEverybody))
+			},
+			authz: GovAuthorizationPolicy{},
+		},
+		"different actor": {
+			authz:  DefaultAuthorizationPolicy{},
+			caller: RandomAccountAddress(t),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"used by contract": {
+			setup: func(ctx sdk.Context) {
+				k.addToContractCodeSecondaryIndex(ctx, RandomAccountAddress(t), types.ContractCodeHistoryEntry{CodeID: codeID, Updated: types.NewAbsoluteTxPosition(ctx)})
+			},
+			authz:  GovAuthorizationPolicy{},
+			expErr: types.ErrCodeInUse,
+		},
+		"pinned": {
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.pinCode(ctx, codeID))
+			},
+			authz:  GovAuthorizationPolicy{},
+			expErr: types.ErrCodeInUse,
+		},
+		"superseding other code": {
+			setup: func(ctx sdk.Context) {
+				otherInfo := types.NewCodeInfo([]byte("otherChecksum"), creatorAddr, types.AllowEverybody)
+				otherInfo.Status = types.CodeStatusDeprecated
+				otherInfo.SupersededByCodeID = codeID
+				k.storeCodeInfo(ctx, 2, otherInfo)
+			},
+			authz:  GovAuthorizationPolicy{},
+			expErr: types.ErrCodeInUse,
+		},
+		"superseding reference removed": {
+			setup: func(ctx sdk.Context) {
+				otherInfo := types.NewCodeInfo([]byte("otherChecksum"), creatorAddr, types.AllowEverybody)
+				otherInfo.Status = types.CodeStatusDeprecated
+				otherInfo.SupersededByCodeID = codeID
+				k.storeCodeInfo(ctx, 2, otherInfo)
+				require.NoError(t, k.setCodeStatus(ctx, 2, nil, types.CodeStatusActive, "", 0, GovAuthorizationPolicy{}))
+			},
+			authz: GovAuthorizationPolicy{},
+		},
+		"listed in migration policy of other code": {
+			setup: func(ctx sdk.Context) {
+				k.storeCodeInfo(ctx, 2, types.NewCodeInfo([]byte("otherChecksum"), creatorAddr, types.AllowEverybody))
+				require.NoError(t, k.setMigrationPolicy(ctx, 2, nil, types.MigrationPolicy{CodeIDs: []uint64{codeID}}, GovAuthorizationPolicy{}))
+			},
+			authz:  GovAuthorizationPolicy{},
+			expErr: types.ErrCodeInUse,
+		},
+		"listed in own migration policy": {
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.setMigrationPolicy(ctx, codeID, nil, types.MigrationPolicy{CodeIDs: []uint64{codeID}}, GovAuthorizationPolicy{}))
+			},
+			authz: GovAuthorizationPolicy{},
+		},
+		"target of queued migration": {
+			setup: func(ctx sdk.Context) {
+				k.storeTimelockedOperation(ctx, RandomAccountAddress(t), types.TimelockedOperation{
+					ID:              1,
+					ExecuteAtHeight: 10,
+					Type:            types.TimelockedOperationTypeMigrate,
+					Migration:       &types.TimelockedMigration{CodeID: codeID, Msg: []byte(`{}`)},
+				})
+			},
+			authz:  GovAuthorizationPolicy{},
+			expErr: types.ErrCodeInUse,
+		},
+		"queued migration cancelled": {
+			setup: func(ctx sdk.Context) {
+				contractAddr := RandomAccountAddress(t)
+				op := types.TimelockedOperation{
+					ID:              1,
+					ExecuteAtHeight: 10,
+					Type:            types.TimelockedOperationTypeMigrate,
+					Migration:       &types.TimelockedMigration{CodeID: codeID, Msg: []byte(`{}`)},
+				}
+				k.storeTimelockedOperation(ctx, contractAddr, op)
+				k.deleteTimelockedOperation(ctx, contractAddr, op)
+			},
+			authz: GovAuthorizationPolicy{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			k.storeCodeInfo(ctx, codeID, types.NewCodeInfo(myChecksum, creatorAddr, types.AllowEverybody))
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+			em = sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.pruneCode(ctx, codeID, spec.caller, spec.authz)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.NotNil(t, k.GetCodeInfo(ctx, codeID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetCodeInfo(ctx, codeID))
+			// and event emitted
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, "prune_code", em.Events()[0].Type)
+			exp := map[string]string{"code_id": "1", "code_checksum": hex.EncodeToString(myChecksum)}
+			assert.Equal(t, exp, attrsToStringMap(em.Events()[0].Attributes))
+		})
+	}
+}
+
+func TestPruneCodeUnknown(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	err := keepers.WasmKeeper.pruneCode(ctx, 99, nil, GovAuthorizationPolicy{})
+	assert.True(t, types.ErrNotFound.Is(err), err)
+}
+
+func TestPruneCodeReleasesReferences(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	k.storeCodeInfo(ctx, 1, types.NewCodeInfo([]byte("myChecksum"), creatorAddr, types.AllowEverybody))
+	otherInfo := types.NewCodeInfo([]byte("otherChecksum"), creatorAddr, types.AllowEverybody)
+	otherInfo.MigrationPolicy = &types.MigrationPolicy{CodeIDs: []uint64{1}}
+	k.storeCodeInfo(ctx, 2, otherInfo)
+	require.True(t, types.ErrCodeInUse.Is(k.pruneCode(ctx, 1, nil, GovAuthorizationPolicy{})))
+
+	// when
+	require.NoError(t, k.pruneCode(ctx, 2, nil, GovAuthorizationPolicy{}))
+
+	// then
+	require.NoError(t, k.pruneCode(ctx, 1, nil, GovAuthorizationPolicy{}))
+}
+
+func TestMigrate1to2PruneIndexes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	store := ctx.KVStore(k.storeKey)
+	for _, codeID := range []uint64{1, 2} {
+		info := types.NewCodeInfo([]byte("myChecksum"), creatorAddr, types.AllowEverybody)
+		store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(&info))
+	}
+	otherInfo := types.NewCodeInfo([]byte("otherChecksum"), creatorAddr, types.AllowEverybody)
+	otherInfo.SupersededByCodeID = 1
+	store.Set(types.GetCodeKey(3), k.cdc.MustMarshal(&otherInfo))
+	contractAddr := RandomAccountAddress(t)
+	op := types.TimelockedOperation{
+		ID:              1,
+		ContractAddress: contractAddr.String(),
+		ExecuteAtHeight: 10,
+		Type:            types.TimelockedOperationTypeMigrate,
+		Migration:       &types.TimelockedMigration{CodeID: 2, Msg: []byte(`{}`)},
+	}
+	store.Set(types.GetTimelockedOperationKey(contractAddr, op.ID), k.cdc.MustMarshal(&op))
+
+	// when
+	require.NoError(t, NewMigrator(*k).Migrate1to2(ctx))
+
+	// then
+	assert.True(t, types.ErrCodeInUse.Is(k.pruneCode(ctx, 1, nil, GovAuthorizationPolicy{})))
+	assert.True(t, types.ErrCodeInUse.Is(k.pruneCode(ctx, 2, nil, GovAuthorizationPolicy{})))
+	assert.NotNil(t, k.GetTimelockedOperation(ctx, contractAddr, op.ID))
+}
+
+func TestPruneCodeKeepsGenesisConsistent(t *testing.T) {
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(mock))
+	mock.GetCodeFn = func(wasmvm.Checksum) (wasmvm.WasmCode, error) { return []byte("myCode"), nil }
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	unusedCodeID, _, err := keepers.ContractKeeper.Create(ctx, example.CreatorAddr, []byte("otherCode"), nil)
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, keepers.ContractKeeper.PruneCode(ctx, unusedCodeID, example.CreatorAddr))
+
+	// then
+	genState := ExportGenesis(ctx, k)
+	require.Len(t, genState.Codes, 1)
+	assert.Equal(t, example.CodeID, genState.Codes[0].CodeID)
+	assert.Equal(t, unusedCodeID+1, genState.Sequences[0].Value)
+}
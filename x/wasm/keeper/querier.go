@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"runtime/debug"
 
 	"google.golang.org/grpc/codes"
@@ -13,6 +16,7 @@ import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
@@ -229,6 +233,7 @@ func (q grpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 	r := make([]types.CodeInfoResponse, 0)
+	verifiers := q.keeper.GetParams(ctx).CodeVerifiers
 	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.CodeKeyPrefix)
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		if accumulate {
@@ -236,12 +241,7 @@ func (q grpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 			if err := q.cdc.Unmarshal(value, &c); err != nil {
 				return false, err
 			}
-			r = append(r, types.CodeInfoResponse{
-				CodeID:                binary.BigEndian.Uint64(key),
-				Creator:               c.Creator,
-				DataHash:              c.CodeHash,
-				InstantiatePermission: c.InstantiateConfig,
-			})
+			r = append(r, newCodeInfoResponse(binary.BigEndian.Uint64(key), c, verifiers))
 		}
 		return true, nil
 	})
@@ -259,9 +259,38 @@ func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKe
 	return &types.QueryContractInfoResponse{
 		Address:      addr.String(),
 		ContractInfo: *info,
+		StateSize:    keeper.GetContractStateSize(ctx, addr),
 	}, nil
 }
 
+// newCodeInfoResponse returns the query response for the code. The verifications of verifiers that are not
+// allowlisted anymore are returned as stale.
+func newCodeInfoResponse(codeID uint64, info types.CodeInfo, verifiers []string) types.CodeInfoResponse {
+	res := types.CodeInfoResponse{
+		CodeID:                codeID,
+		Creator:               info.Creator,
+		DataHash:              info.CodeHash,
+		InstantiatePermission: info.InstantiateConfig,
+		Status:                info.Status,
+		StatusReason:          info.StatusReason,
+		SupersededByCodeID:    info.SupersededByCodeID,
+		MigrationPolicy:       info.MigrationPolicy,
+		BuildMetadata:         info.BuildMetadata,
+	}
+	allowed := make(map[string]struct{}, len(verifiers))
+	for _, v := range verifiers {
+		allowed[v] = struct{}{}
+	}
+	for _, v := range info.Verifications {
+		if _, ok := allowed[v.Verifier]; ok {
+			res.Verifications = append(res.Verifications, v)
+		} else {
+			res.StaleVerifications = append(res.StaleVerifications, v)
+		}
+	}
+	return res
+}
+
 func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.QueryCodeResponse, error) {
 	if codeID == 0 {
 		return nil, nil
@@ -271,12 +300,7 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 		// nil, nil leads to 404 in rest handler
 		return nil, nil
 	}
-	info := types.CodeInfoResponse{
-		CodeID:                codeID,
-		Creator:               res.Creator,
-		DataHash:              res.CodeHash,
-		InstantiatePermission: res.InstantiateConfig,
-	}
+	info := newCodeInfoResponse(codeID, *res, keeper.GetParams(ctx).CodeVerifiers)
 
 	code, err := keeper.GetByteCode(ctx, codeID)
 	if err != nil {
@@ -315,3 +339,176 @@ func (q grpcQuerier) Params(c context.Context, req *types.QueryParamsRequest) (*
 	params := q.keeper.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// IBCPacketUsage returns the IBC rate limit and the consumed packet quota of a contract on a channel.
+func (q grpcQuerier) IBCPacketUsage(c context.Context, req *types.QueryIBCPacketUsageRequest) (*types.QueryIBCPacketUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryIBCPacketUsageResponse{
+		Limit: q.keeper.GetIBCRateLimit(ctx, contractAddr, req.ChannelId),
+		Usage: q.keeper.GetIBCPacketUsage(ctx, contractAddr, req.ChannelId),
+	}, nil
+}
+
+// BlockHooks returns the contracts that are called with sudo in every block.
+func (q grpcQuerier) BlockHooks(c context.Context, req *types.QueryBlockHooksRequest) (*types.QueryBlockHooksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.BlockHook, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.BlockHookPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var hook types.BlockHook
+			if err := q.cdc.Unmarshal(value, &hook); err != nil {
+				return false, err
+			}
+			r = append(r, hook)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBlockHooksResponse{
+		BlockHooks: r,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q grpcQuerier) ContractStateSize(c context.Context, req *types.QueryContractStateSizeRequest) (*types.QueryContractStateSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryContractStateSizeResponse{
+		StateSize:     q.keeper.GetContractStateSize(ctx, contractAddr),
+		MaxStateBytes: q.keeper.GetMaxContractStateBytes(ctx, q.keeper.GetContractInfo(ctx, contractAddr).CodeID),
+	}, nil
+}
+
+func (q grpcQuerier) StorageDeposit(c context.Context, req *types.QueryStorageDepositRequest) (*types.QueryStorageDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryStorageDepositResponse{
+		Deposit:      q.keeper.GetStorageDeposit(ctx, contractAddr),
+		StateSize:    q.keeper.GetContractStateSize(ctx, contractAddr),
+		PricePerByte: q.keeper.GetParams(ctx).StorageDepositPerByte,
+	}, nil
+}
+
+func (q grpcQuerier) PendingAdmin(c context.Context, req *types.QueryPendingAdminRequest) (*types.QueryPendingAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryPendingAdminResponse{
+		PendingAdmin: q.keeper.GetPendingAdmin(ctx, contractAddr),
+	}, nil
+}
+
+func (q grpcQuerier) ContractTimelock(c context.Context, req *types.QueryContractTimelockRequest) (*types.QueryContractTimelockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	r := make([]types.TimelockedOperation, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetTimelockedOperationPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var op types.TimelockedOperation
+			if err := q.cdc.Unmarshal(value, &op); err != nil {
+				return false, err
+			}
+			r = append(r, op)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractTimelockResponse{
+		DelayBlocks: q.keeper.GetContractTimelock(ctx, contractAddr),
+		Operations:  r,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (q grpcQuerier) BuildAddress(c context.Context, req *types.QueryBuildAddressRequest) (*types.QueryBuildAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	codeHash, err := hex.DecodeString(req.CodeHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid code hash: %s", err))
+	}
+	if len(codeHash) != sha256.Size {
+		return nil, status.Error(codes.InvalidArgument, "invalid code hash length")
+	}
+	creator, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	salt, err := hex.DecodeString(req.Salt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid salt: %s", err))
+	}
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid salt: %s", err))
+	}
+	initArgs := types.RawContractMessage(req.InitArgs)
+	if len(initArgs) != 0 {
+		if err := initArgs.ValidateBasic(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid init args: %s", err))
+		}
+	}
+	addr, err := q.keeper.BuildPredictableAddress(req.AddressGenerator, codeHash, creator, salt, initArgs)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBuildAddressResponse{Address: addr.String()}, nil
+}
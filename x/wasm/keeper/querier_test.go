@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cosmos/btcutil/bech32"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -297,6 +300,248 @@ func TestQueryRawContractState(t *testing.T) {
 	}
 }
 
+func TestQueryContractStateSize(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	expSize := keeper.computeContractStateSize(ctx, exampleContract.Contract)
+	contractModel := []types.Model{
+		{Key: []byte("foo"), Value: []byte(`"bar"`)},
+		{Key: []byte{0x0, 0x1}, Value: []byte(`{"count":8}`)},
+	}
+	require.NoError(t, keeper.importContractState(ctx, exampleContract.Contract, contractModel))
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery *types.QueryContractStateSizeRequest
+		expSize  types.ContractStateSize
+		expErr   error
+	}{
+		"query size": {
+			srcQuery: &types.QueryContractStateSizeRequest{Address: contractAddr},
+			expSize:  types.ContractStateSize{KeyCount: expSize.KeyCount + 2, TotalBytes: expSize.TotalBytes + 21},
+		},
+		"query with unknown address": {
+			srcQuery: &types.QueryContractStateSizeRequest{Address: RandomBech32AccountAddress(t)},
+			expErr:   types.ErrNotFound,
+		},
+		"query with invalid address": {
+			srcQuery: &types.QueryContractStateSizeRequest{Address: "abcde"},
+			expErr:   bech32.ErrInvalidLength(5),
+		},
+		"with empty request": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractStateSize(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expSize, got.StateSize)
+		})
+	}
+}
+
+func TestQueryStorageDeposit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	params := types.DefaultParams()
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	keeper.SetParams(ctx, params)
+	keeper.storeStorageDeposit(ctx, exampleContract.Contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 10)))
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery *types.QueryStorageDepositRequest
+		expRsp   *types.QueryStorageDepositResponse
+		expErr   error
+	}{
+		"query deposit": {
+			srcQuery: &types.QueryStorageDepositRequest{Address: contractAddr},
+			expRsp: &types.QueryStorageDepositResponse{
+				Deposit:      sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+				StateSize:    keeper.GetContractStateSize(ctx, exampleContract.Contract),
+				PricePerByte: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			},
+		},
+		"query with unknown address": {
+			srcQuery: &types.QueryStorageDepositRequest{Address: RandomBech32AccountAddress(t)},
+			expErr:   types.ErrNotFound,
+		},
+		"query with invalid address": {
+			srcQuery: &types.QueryStorageDepositRequest{Address: "abcde"},
+			expErr:   bech32.ErrInvalidLength(5),
+		},
+		"with empty request": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.StorageDeposit(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expRsp, got)
+		})
+	}
+}
+
+func TestQueryPendingAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	expiresAt := uint64(ctx.BlockHeight()) + 100
+	require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, exampleContract.Contract, exampleContract.CreatorAddr, newAdmin, expiresAt))
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery *types.QueryPendingAdminRequest
+		expRsp   *types.QueryPendingAdminResponse
+		expErr   error
+	}{
+		"query pending admin": {
+			srcQuery: &types.QueryPendingAdminRequest{Address: exampleContract.Contract.String()},
+			expRsp: &types.QueryPendingAdminResponse{
+				PendingAdmin: &types.PendingAdmin{
+					ContractAddress: exampleContract.Contract.String(),
+					NewAdmin:        newAdmin.String(),
+					ExpiresAtHeight: expiresAt,
+				},
+			},
+		},
+		"query without pending admin": {
+			srcQuery: &types.QueryPendingAdminRequest{Address: otherContract.Contract.String()},
+			expRsp:   &types.QueryPendingAdminResponse{},
+		},
+		"query with unknown address": {
+			srcQuery: &types.QueryPendingAdminRequest{Address: RandomBech32AccountAddress(t)},
+			expErr:   types.ErrNotFound,
+		},
+		"query with invalid address": {
+			srcQuery: &types.QueryPendingAdminRequest{Address: "abcde"},
+			expErr:   bech32.ErrInvalidLength(5),
+		},
+		"with empty request": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.PendingAdmin(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expRsp, got)
+		})
+	}
+}
+
+func TestQueryContractTimelock(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keepers.ContractKeeper.SetContractTimelock(ctx, exampleContract.Contract, exampleContract.CreatorAddr, 10))
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, exampleContract.Contract, exampleContract.CreatorAddr))
+	newAdmin := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, exampleContract.Contract, exampleContract.CreatorAddr, newAdmin))
+	firstOp := types.TimelockedOperation{
+		ID:              1,
+		ContractAddress: exampleContract.Contract.String(),
+		Sender:          exampleContract.CreatorAddr.String(),
+		ExecuteAtHeight: uint64(ctx.BlockHeight()) + 10,
+		Type:            types.TimelockedOperationTypeClearAdmin,
+	}
+	secondOp := types.TimelockedOperation{
+		ID:              2,
+		ContractAddress: exampleContract.Contract.String(),
+		Sender:          exampleContract.CreatorAddr.String(),
+		ExecuteAtHeight: uint64(ctx.BlockHeight()) + 10,
+		Type:            types.TimelockedOperationTypeUpdateAdmin,
+		NewAdmin:        newAdmin.String(),
+	}
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery *types.QueryContractTimelockRequest
+		expRsp   *types.QueryContractTimelockResponse
+		expErr   error
+	}{
+		"query timelock": {
+			srcQuery: &types.QueryContractTimelockRequest{Address: exampleContract.Contract.String()},
+			expRsp: &types.QueryContractTimelockResponse{
+				DelayBlocks: 10,
+				Operations:  []types.TimelockedOperation{firstOp, secondOp},
+				Pagination:  &query.PageResponse{Total: 2},
+			},
+		},
+		"query with pagination": {
+			srcQuery: &types.QueryContractTimelockRequest{
+				Address:    exampleContract.Contract.String(),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expRsp: &types.QueryContractTimelockResponse{
+				DelayBlocks: 10,
+				Operations:  []types.TimelockedOperation{firstOp},
+				Pagination: &query.PageResponse{
+					NextKey: sdk.Uint64ToBigEndian(2),
+				},
+			},
+		},
+		"query without timelock": {
+			srcQuery: &types.QueryContractTimelockRequest{Address: otherContract.Contract.String()},
+			expRsp: &types.QueryContractTimelockResponse{
+				Operations: []types.TimelockedOperation{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"query with unknown address": {
+			srcQuery: &types.QueryContractTimelockRequest{Address: RandomBech32AccountAddress(t)},
+			expErr:   types.ErrNotFound,
+		},
+		"query with invalid address": {
+			srcQuery: &types.QueryContractTimelockRequest{Address: "abcde"},
+			expErr:   bech32.ErrInvalidLength(5),
+		},
+		"with empty request": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractTimelock(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expRsp, got)
+		})
+	}
+}
+
 func TestQueryContractsByCode(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -872,6 +1117,10 @@ func TestQueryContractInfo(t *testing.T) {
 	withIBCPort := func(info *types.ContractInfo) {
 		info.IBCPortID = "fooPort"
 	}
+	immutable := func(info *types.ContractInfo) {
+		info.Admin = ""
+		info.Immutable = true
+	}
 	specs := map[string]struct {
 		src    *types.QueryContractInfoRequest
 		stored types.ContractInfo
@@ -902,6 +1151,14 @@ func TestQueryContractInfo(t *testing.T) {
 				ContractInfo: types.ContractInfoFixture(withIBCPort),
 			},
 		},
+		"immutable": {
+			src:    &types.QueryContractInfoRequest{Address: contractAddr.String()},
+			stored: types.ContractInfoFixture(immutable),
+			expRsp: &types.QueryContractInfoResponse{
+				Address:      contractAddr.String(),
+				ContractInfo: types.ContractInfoFixture(immutable),
+			},
+		},
 		"not found": {
 			src:    &types.QueryContractInfoRequest{Address: RandomBech32AccountAddress(t)},
 			stored: types.ContractInfoFixture(),
@@ -1061,9 +1318,21 @@ func TestQueryCodeInfo(t *testing.T) {
 
 	anyAddress, err := sdk.AccAddressFromBech32("link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5")
 	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.CodeVerifiers = []string{anyAddress.String()}
+	keeper.SetParams(ctx, params)
+	staleVerifier := RandomBech32AccountAddress(t)
 	specs := map[string]struct {
 		codeId       uint64
 		accessConfig types.AccessConfig
+		status       types.CodeStatus
+		reason       string
+		supersededBy uint64
+		policy       *types.MigrationPolicy
+		metadata     *types.CodeBuildMetadata
+		verifs       []types.CodeVerification
+		expVerifs    []types.CodeVerification
+		expStale     []types.CodeVerification
 	}{
 		"everybody": {
 			codeId:       1,
@@ -1077,11 +1346,44 @@ func TestQueryCodeInfo(t *testing.T) {
 			codeId:       20,
 			accessConfig: types.AccessTypeOnlyAddress.With(anyAddress),
 		},
+		"deprecated": {
+			codeId:       30,
+			accessConfig: types.AllowEverybody,
+			status:       types.CodeStatusDeprecated,
+			reason:       "security issue",
+			supersededBy: 1,
+		},
+		"with migration policy": {
+			codeId:       40,
+			accessConfig: types.AllowEverybody,
+			policy:       &types.MigrationPolicy{CodeIDs: []uint64{1}},
+		},
+		"with verified build metadata": {
+			codeId:       50,
+			accessConfig: types.AllowEverybody,
+			metadata:     &types.CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"},
+			verifs:       []types.CodeVerification{{Verifier: anyAddress.String(), Height: 1}},
+			expVerifs:    []types.CodeVerification{{Verifier: anyAddress.String(), Height: 1}},
+		},
+		"with verification of removed verifier": {
+			codeId:       60,
+			accessConfig: types.AllowEverybody,
+			metadata:     &types.CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"},
+			verifs:       []types.CodeVerification{{Verifier: staleVerifier, Height: 1}, {Verifier: anyAddress.String(), Height: 2}},
+			expVerifs:    []types.CodeVerification{{Verifier: anyAddress.String(), Height: 2}},
+			expStale:     []types.CodeVerification{{Verifier: staleVerifier, Height: 1}},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			codeInfo := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
 			codeInfo.InstantiateConfig = spec.accessConfig
+			codeInfo.Status = spec.status
+			codeInfo.StatusReason = spec.reason
+			codeInfo.SupersededByCodeID = spec.supersededBy
+			codeInfo.MigrationPolicy = spec.policy
+			codeInfo.BuildMetadata = spec.metadata
+			codeInfo.Verifications = spec.verifs
 			require.NoError(t, keeper.importCode(ctx, spec.codeId,
 				codeInfo,
 				wasmCode),
@@ -1098,6 +1400,13 @@ func TestQueryCodeInfo(t *testing.T) {
 					Creator:               codeInfo.Creator,
 					DataHash:              codeInfo.CodeHash,
 					InstantiatePermission: spec.accessConfig,
+					Status:                spec.status,
+					StatusReason:          spec.reason,
+					SupersededByCodeID:    spec.supersededBy,
+					MigrationPolicy:       spec.policy,
+					BuildMetadata:         spec.metadata,
+					Verifications:         spec.expVerifs,
+					StaleVerifications:    spec.expStale,
 				},
 				Data: wasmCode,
 			}
@@ -1180,3 +1489,216 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryIBCPacketUsage(t *testing.T) {
+	contractAddr := RandomAccountAddress(t)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	ctx = ctx.WithBlockHeight(10)
+	myLimit := types.IBCRateLimit{MaxPacketsPerBlock: 2, MaxBytesPerWindow: 100, WindowBlocks: 5}
+	params := types.DefaultParams()
+	params.IBCRateLimit = myLimit
+	k.SetParams(ctx, params)
+	contractInfo := types.ContractInfoFixture()
+	k.storeContractInfo(ctx, contractAddr, &contractInfo)
+	require.NoError(t, k.ConsumeIBCPacketQuota(ctx, contractAddr, "channel-0", 6))
+	myOverride := types.IBCRateLimit{MaxPacketsPerBlock: 1}
+	k.storeIBCRateLimitOverride(ctx, contractAddr, "channel-2", &myOverride)
+
+	specs := map[string]struct {
+		src    *types.QueryIBCPacketUsageRequest
+		expRsp *types.QueryIBCPacketUsageResponse
+		expErr error
+	}{
+		"found": {
+			src: &types.QueryIBCPacketUsageRequest{Address: contractAddr.String(), ChannelId: "channel-0"},
+			expRsp: &types.QueryIBCPacketUsageResponse{
+				Limit: myLimit,
+				Usage: types.IBCPacketUsage{BlockHeight: 10, PacketsInBlock: 1, WindowStartHeight: 10, BytesInWindow: 6, PruneHeight: 15},
+			},
+		},
+		"unused channel": {
+			src: &types.QueryIBCPacketUsageRequest{Address: contractAddr.String(), ChannelId: "channel-1"},
+			expRsp: &types.QueryIBCPacketUsageResponse{
+				Limit: myLimit,
+				Usage: types.IBCPacketUsage{BlockHeight: 10, WindowStartHeight: 10},
+			},
+		},
+		"channel override": {
+			src: &types.QueryIBCPacketUsageRequest{Address: contractAddr.String(), ChannelId: "channel-2"},
+			expRsp: &types.QueryIBCPacketUsageResponse{
+				Limit: myOverride,
+				Usage: types.IBCPacketUsage{BlockHeight: 10, WindowStartHeight: 10},
+			},
+		},
+		"contract not found": {
+			src:    &types.QueryIBCPacketUsageRequest{Address: RandomBech32AccountAddress(t), ChannelId: "channel-0"},
+			expErr: types.ErrNotFound,
+		},
+		"query with invalid address": {
+			src:    &types.QueryIBCPacketUsageRequest{Address: "abcde", ChannelId: "channel-0"},
+			expErr: bech32.ErrInvalidLength(5),
+		},
+		"query with invalid channel": {
+			src:    &types.QueryIBCPacketUsageRequest{Address: contractAddr.String(), ChannelId: "x"},
+			expErr: status.Error(codes.InvalidArgument, host.ChannelIdentifierValidator("x").Error()),
+		},
+		"with empty request": {
+			src:    nil,
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.IBCPacketUsage(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(gotErr, spec.expErr), "but got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryBlockHooks(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+	myAddr := RandomAccountAddress(t)
+	beginHook := types.BlockHook{ContractAddress: myAddr.String(), Phase: types.BlockHookPhaseBeginBlock, GasLimit: 1}
+	endHook := types.BlockHook{ContractAddress: myAddr.String(), Phase: types.BlockHookPhaseEndBlock, GasLimit: 2, ConsecutiveFailures: 1}
+	keeper.storeBlockHook(ctx, myAddr, endHook)
+	keeper.storeBlockHook(ctx, myAddr, beginHook)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery           *types.QueryBlockHooksRequest
+		expHooks           []types.BlockHook
+		expPaginationTotal uint64
+		expErr             error
+	}{
+		"req nil": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+		"query all": {
+			srcQuery:           &types.QueryBlockHooksRequest{},
+			expHooks:           []types.BlockHook{beginHook, endHook},
+			expPaginationTotal: 2,
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryBlockHooksRequest{
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expHooks:           []types.BlockHook{endHook},
+			expPaginationTotal: 2,
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryBlockHooksRequest{
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expHooks: []types.BlockHook{beginHook},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.BlockHooks(sdk.WrapSDKContext(ctx), spec.srcQuery)
+
+			if spec.expErr != nil {
+				assert.Nil(t, got)
+				assert.EqualError(t, spec.expErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, spec.expPaginationTotal, got.Pagination.Total)
+			assert.Equal(t, spec.expHooks, got.BlockHooks)
+		})
+	}
+}
+
+func TestQueryBuildAddress(t *testing.T) {
+	myBuilder := func(checksum []byte, creator sdk.AccAddress, salt, initMsg types.RawContractMessage) sdk.AccAddress {
+		return BuildContractAddressPredictable(checksum, creator, append([]byte("my"), salt...), initMsg)
+	}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithAddressGenerator("my-generator", myBuilder))
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	checksum := bytes.Repeat([]byte{1}, 32)
+	creator := RandomAccountAddress(t)
+	salt := []byte("my salt")
+	initArgs := []byte(`{"foo":"bar"}`)
+
+	specs := map[string]struct {
+		src     *types.QueryBuildAddressRequest
+		expAddr sdk.AccAddress
+		expCode codes.Code
+		expErr  error
+	}{
+		"default generator": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt)},
+			expAddr: BuildContractAddressPredictable(checksum, creator, salt, nil),
+		},
+		"default generator with init args": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt), InitArgs: initArgs},
+			expAddr: BuildContractAddressPredictable(checksum, creator, salt, initArgs),
+		},
+		"explicit default generator": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt), AddressGenerator: types.DefaultAddressGenerator},
+			expAddr: BuildContractAddressPredictable(checksum, creator, salt, nil),
+		},
+		"named generator": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt), AddressGenerator: "my-generator"},
+			expAddr: myBuilder(checksum, creator, salt, nil),
+		},
+		"unknown generator": {
+			src:    &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt), AddressGenerator: "unknown"},
+			expErr: types.ErrNotFound,
+		},
+		"invalid code hash": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: "xyz", CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt)},
+			expCode: codes.InvalidArgument,
+		},
+		"invalid code hash length": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum[1:]), CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt)},
+			expCode: codes.InvalidArgument,
+		},
+		"invalid creator": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: "abcde", Salt: hex.EncodeToString(salt)},
+			expCode: codes.InvalidArgument,
+		},
+		"empty salt": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: creator.String()},
+			expCode: codes.InvalidArgument,
+		},
+		"invalid init args": {
+			src:     &types.QueryBuildAddressRequest{CodeHash: hex.EncodeToString(checksum), CreatorAddress: creator.String(), Salt: hex.EncodeToString(salt), InitArgs: []byte("not json")},
+			expCode: codes.InvalidArgument,
+		},
+		"with empty request": {
+			src:     nil,
+			expCode: codes.InvalidArgument,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.BuildAddress(sdk.WrapSDKContext(ctx), spec.src)
+			switch {
+			case spec.expErr != nil:
+				require.True(t, errors.Is(gotErr, spec.expErr), "but got %+v", gotErr)
+				return
+			case spec.expCode != codes.OK:
+				assert.Equal(t, spec.expCode, status.Code(gotErr), "but got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expAddr.String(), gotRsp.Address)
+		})
+	}
+}
@@ -38,6 +38,9 @@ func (k Keeper) OnOpenChannel(
 	if execErr != nil {
 		return "", sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return "", err
+	}
 	if res != nil {
 		return res.Version, nil
 	}
@@ -72,6 +75,9 @@ func (k Keeper) OnConnectChannel(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -104,6 +110,9 @@ func (k Keeper) OnCloseChannel(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -135,6 +144,9 @@ func (k Keeper) OnRecvPacket(
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return nil, err
+	}
 	if res.Err != "" { // handle error case as before https://github.com/CosmWasm/wasmvm/commit/c300106fe5c9426a495f8e10821e00a9330c56c6
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, res.Err)
 	}
@@ -170,6 +182,9 @@ func (k Keeper) OnAckPacket(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return err
+	}
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
 
@@ -198,6 +213,9 @@ func (k Keeper) OnTimeoutPacket(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.checkStateChange(); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"io"
+	"sync"
 
 	protoio "github.com/gogo/protobuf/io"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -18,8 +21,13 @@ import (
 
 var _ snapshot.ExtensionSnapshotter = &WasmSnapshotter{}
 
-// SnapshotFormat format 1 is just gzipped wasm byte code for each item payload. No protobuf envelope, no metadata.
-const SnapshotFormat = 1
+const (
+	// SnapshotFormatV1 format 1 is just gzipped wasm byte code for each item payload. No protobuf envelope, no metadata.
+	SnapshotFormatV1 = 1
+	// SnapshotFormat format 2 wraps each item payload in a types.SnapshotPayload envelope. There is one
+	// types.SnapshotCode item per unique checksum followed by a trailing types.SnapshotManifest.
+	SnapshotFormat = 2
+)
 
 type WasmSnapshotter struct {
 	wasm *Keeper
@@ -43,7 +51,7 @@ func (ws *WasmSnapshotter) SnapshotFormat() uint32 {
 
 func (ws *WasmSnapshotter) SupportedFormats() []uint32 {
 	// If we support older formats, add them here and handle them in Restore
-	return []uint32{SnapshotFormat}
+	return []uint32{SnapshotFormat, SnapshotFormatV1}
 }
 
 func (ws *WasmSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
@@ -53,66 +61,72 @@ func (ws *WasmSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) e
 	}
 
 	ctx := sdk.NewContext(cacheMS, tmproto.Header{}, false, log.NewNopLogger())
-	seenBefore := make(map[string]bool)
-	var rerr error
 
+	// Many code ids may point to the same code hash... only sync it once
+	var items []*types.SnapshotCode
+	byChecksum := make(map[string]*types.SnapshotCode)
+	var codeCount uint64
 	ws.wasm.IterateCodeInfos(ctx, func(id uint64, info types.CodeInfo) bool {
-		// Many code ids may point to the same code hash... only sync it once
+		codeCount++
 		hexHash := hex.EncodeToString(info.CodeHash)
-		// if seenBefore, just skip this one and move to the next
-		if seenBefore[hexHash] {
-			return false
+		item, seenBefore := byChecksum[hexHash]
+		if !seenBefore {
+			item = &types.SnapshotCode{Checksum: info.CodeHash}
+			byChecksum[hexHash] = item
+			items = append(items, item)
 		}
-		seenBefore[hexHash] = true
+		item.CodeIDs = append(item.CodeIDs, id)
+		item.Pinned = item.Pinned || ws.wasm.IsPinnedCode(ctx, id)
+		return false
+	})
 
+	manifest := types.SnapshotManifest{CodeCount: codeCount}
+	for _, item := range items {
 		// load code and abort on error
-		wasmBytes, err := ws.wasm.GetByteCode(ctx, id)
+		wasmBytes, err := ws.wasm.GetByteCode(ctx, item.CodeIDs[0])
 		if err != nil {
-			rerr = err
-			return true
+			return err
 		}
-
-		compressedWasm, err := ioutils.GzipIt(wasmBytes)
+		item.WASMByteCode, err = ioutils.GzipIt(wasmBytes)
 		if err != nil {
-			rerr = err
-			return true
+			return err
 		}
-
-		err = snapshot.WriteExtensionItem(protoWriter, compressedWasm)
-		if err != nil {
-			rerr = err
-			return true
+		if err := writeSnapshotPayload(protoWriter, &types.SnapshotPayload{Sum: &types.SnapshotPayload_Code{Code: item}}); err != nil {
+			return err
 		}
+		manifest.Checksums = append(manifest.Checksums, item.Checksum)
+	}
+	return writeSnapshotPayload(protoWriter, &types.SnapshotPayload{Sum: &types.SnapshotPayload_Manifest{Manifest: &manifest}})
+}
 
-		return false
-	})
-
-	return rerr
+func writeSnapshotPayload(protoWriter protoio.Writer, payload *types.SnapshotPayload) error {
+	bz, err := payload.Marshal()
+	if err != nil {
+		return err
+	}
+	return snapshot.WriteExtensionItem(protoWriter, bz)
 }
 
 func (ws *WasmSnapshotter) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshot.SnapshotItem, error) {
-	if format == SnapshotFormat {
+	switch format {
+	case SnapshotFormat:
+		r := &restorerV2{seen: make(map[string]struct{})}
+		return ws.processAllItems(height, protoReader, r.restore, r.finalize)
+	case SnapshotFormatV1:
 		return ws.processAllItems(height, protoReader, restoreV1, finalizeV1)
+	default:
+		return snapshot.SnapshotItem{}, snapshot.ErrUnknownFormat
 	}
-	return snapshot.SnapshotItem{}, snapshot.ErrUnknownFormat
 }
 
-func restoreV1(ctx sdk.Context, k *Keeper, compressedCode []byte) error {
+func restoreV1(ctx sdk.Context, k *Keeper, compiler *wasmCompiler, compressedCode []byte) error {
 	if !ioutils.IsGzip(compressedCode) {
 		return types.ErrInvalid.Wrap("not a gzip")
 	}
-	wasmCode, err := ioutils.Uncompress(compressedCode, uint64(types.MaxWasmSize))
-	if err != nil {
-		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
-	}
-
 	// FIXME: check which codeIDs the checksum matches??
-	_, err = k.wasmVM.Create(wasmCode)
-	if err != nil {
-		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
-	}
+	compiler.compile(compressedCode, nil)
 	return nil
 }
 
@@ -121,13 +135,104 @@ func finalizeV1(ctx sdk.Context, k *Keeper) error {
 	return k.InitializePinnedCodes(ctx)
 }
 
+// restorerV2 keeps track of the restored code items to verify them against the trailing manifest
+type restorerV2 struct {
+	checksums [][]byte
+	seen      map[string]struct{}
+	codeCount uint64
+	manifest  *types.SnapshotManifest
+}
+
+func (r *restorerV2) restore(ctx sdk.Context, k *Keeper, compiler *wasmCompiler, bz []byte) error {
+	if r.manifest != nil {
+		return types.ErrInvalid.Wrap("item after manifest")
+	}
+	var payload types.SnapshotPayload
+	if err := payload.Unmarshal(bz); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	switch {
+	case payload.GetCode() != nil:
+		return r.restoreCode(ctx, k, compiler, *payload.GetCode())
+	case payload.GetManifest() != nil:
+		r.manifest = payload.GetManifest()
+		return nil
+	default:
+		return types.ErrInvalid.Wrap("empty payload")
+	}
+}
+
+func (r *restorerV2) restoreCode(ctx sdk.Context, k *Keeper, compiler *wasmCompiler, item types.SnapshotCode) error {
+	if len(item.Checksum) != sha256.Size {
+		return sdkerrors.Wrapf(types.ErrInvalid, "checksum length %d", len(item.Checksum))
+	}
+	if _, exists := r.seen[string(item.Checksum)]; exists {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "checksum %X", item.Checksum)
+	}
+	if len(item.CodeIDs) == 0 {
+		return sdkerrors.Wrapf(types.ErrEmpty, "code ids for checksum %X", item.Checksum)
+	}
+	var pinned bool
+	for _, id := range item.CodeIDs {
+		info := k.GetCodeInfo(ctx, id)
+		if info == nil {
+			return sdkerrors.Wrapf(types.ErrNotFound, "code id %d", id)
+		}
+		if !bytes.Equal(info.CodeHash, item.Checksum) {
+			return sdkerrors.Wrapf(types.ErrInvalid, "checksum %X does not match code id %d", item.Checksum, id)
+		}
+		pinned = pinned || k.IsPinnedCode(ctx, id)
+	}
+	if pinned != item.Pinned {
+		return sdkerrors.Wrapf(types.ErrInvalid, "pinned flag does not match state for checksum %X", item.Checksum)
+	}
+	if !ioutils.IsGzip(item.WASMByteCode) {
+		return types.ErrInvalid.Wrap("not a gzip")
+	}
+	compiler.compile(item.WASMByteCode, item.Checksum)
+	r.seen[string(item.Checksum)] = struct{}{}
+	r.checksums = append(r.checksums, item.Checksum)
+	r.codeCount += uint64(len(item.CodeIDs))
+	return nil
+}
+
+func (r *restorerV2) finalize(ctx sdk.Context, k *Keeper) error {
+	if r.manifest == nil {
+		return types.ErrInvalid.Wrap("missing manifest")
+	}
+	if r.manifest.CodeCount != r.codeCount {
+		return sdkerrors.Wrapf(types.ErrInvalid, "manifest code count %d does not match restored %d", r.manifest.CodeCount, r.codeCount)
+	}
+	if len(r.manifest.Checksums) != len(r.checksums) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "manifest checksum count %d does not match restored %d", len(r.manifest.Checksums), len(r.checksums))
+	}
+	for i, checksum := range r.manifest.Checksums {
+		if !bytes.Equal(checksum, r.checksums[i]) {
+			return sdkerrors.Wrapf(types.ErrInvalid, "manifest checksum %X does not match restored %X at position %d", checksum, r.checksums[i], i)
+		}
+	}
+	var rerr error
+	k.IterateCodeInfos(ctx, func(id uint64, info types.CodeInfo) bool {
+		if _, ok := r.seen[string(info.CodeHash)]; !ok {
+			rerr = sdkerrors.Wrapf(types.ErrNotFound, "wasm byte code for code id %d", id)
+			return true
+		}
+		return false
+	})
+	if rerr != nil {
+		return rerr
+	}
+	return k.InitializePinnedCodes(ctx)
+}
+
 func (ws *WasmSnapshotter) processAllItems(
 	height uint64,
 	protoReader protoio.Reader,
-	cb func(sdk.Context, *Keeper, []byte) error,
+	cb func(sdk.Context, *Keeper, *wasmCompiler, []byte) error,
 	finalize func(sdk.Context, *Keeper) error,
 ) (snapshot.SnapshotItem, error) {
 	ctx := sdk.NewContext(ws.cms, tmproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+	compiler := newWasmCompiler(ws.wasm.wasmVM, ws.wasm.snapshotRestoreConcurrency)
 
 	// keep the last item here... if we break, it will either be empty (if we hit io.EOF)
 	// or contain the last item (if we hit payload == nil)
@@ -138,6 +243,7 @@ func (ws *WasmSnapshotter) processAllItems(
 		if err == io.EOF {
 			break
 		} else if err != nil {
+			_ = compiler.wait() // drain the workers, the read error takes precedence
 			return snapshot.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
 		}
 
@@ -148,10 +254,84 @@ func (ws *WasmSnapshotter) processAllItems(
 			break
 		}
 
-		if err := cb(ctx, ws.wasm, payload.Payload); err != nil {
+		if err := cb(ctx, ws.wasm, compiler, payload.Payload); err != nil {
+			_ = compiler.wait() // drain the workers, the item error takes precedence
 			return snapshot.SnapshotItem{}, sdkerrors.Wrap(err, "processing snapshot item")
 		}
 	}
+	if err := compiler.wait(); err != nil {
+		return snapshot.SnapshotItem{}, sdkerrors.Wrap(err, "processing snapshot item")
+	}
 
 	return item, finalize(ctx, ws.wasm)
 }
+
+// wasmCompiler decompresses and compiles wasm byte code in a bounded pool of workers.
+// Only the wasmvm cache is written by the workers, all store access stays with the caller.
+type wasmCompiler struct {
+	wasmVM types.WasmerEngine
+	sem    chan struct{}
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	next     int
+	errPos   int
+	firstErr error
+}
+
+func newWasmCompiler(wasmVM types.WasmerEngine, concurrency uint32) *wasmCompiler {
+	if concurrency == 0 {
+		concurrency = 1
+	}
+	return &wasmCompiler{
+		wasmVM: wasmVM,
+		sem:    make(chan struct{}, concurrency),
+	}
+}
+
+// compile schedules the gzipped wasm code for compilation. It blocks while all workers are busy.
+// When a checksum is given, the uncompressed code must match it.
+func (c *wasmCompiler) compile(compressedCode, checksum []byte) {
+	pos := c.next
+	c.next++
+	c.sem <- struct{}{}
+	c.wg.Add(1)
+	go func() {
+		defer func() {
+			<-c.sem
+			c.wg.Done()
+		}()
+		if err := c.doCompile(compressedCode, checksum); err != nil {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			// keep the error of the first item in snapshot order to be deterministic
+			if c.firstErr == nil || pos < c.errPos {
+				c.firstErr, c.errPos = err, pos
+			}
+		}
+	}()
+}
+
+func (c *wasmCompiler) doCompile(compressedCode, checksum []byte) error {
+	wasmCode, err := ioutils.Uncompress(compressedCode, uint64(types.MaxWasmSize))
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	if checksum != nil {
+		if hash := sha256.Sum256(wasmCode); !bytes.Equal(hash[:], checksum) {
+			return sdkerrors.Wrapf(types.ErrInvalid, "corrupt wasm byte code for checksum %X", checksum)
+		}
+	}
+	if _, err := c.wasmVM.Create(wasmCode); err != nil {
+		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	return nil
+}
+
+// wait blocks until all scheduled codes are compiled and returns the error of the first failed item
+func (c *wasmCompiler) wait() error {
+	c.wg.Wait()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.firstErr
+}
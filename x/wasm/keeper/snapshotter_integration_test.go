@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"crypto/sha256"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -59,6 +60,8 @@ func TestSnapshotter(t *testing.T) {
 				require.Equal(t, uint64(i+1), codeID)
 				srcCodeIDToChecksum[codeID] = checksum
 			}
+			// pin the first code
+			require.NoError(t, contractKeeper.PinCode(ctx, 1))
 			// create snapshot
 			srcWasmApp.Commit()
 			snapshotHeight := uint64(srcWasmApp.LastBlockHeight())
@@ -94,6 +97,7 @@ func TestSnapshotter(t *testing.T) {
 				hash := sha256.Sum256(bz)
 				destCodeIDToChecksum[id] = hash[:]
 				assert.Equal(t, hash[:], info.CodeHash)
+				assert.Equal(t, id == 1, wasmKeeper.IsPinnedCode(ctx, id))
 				return false
 			})
 			assert.Equal(t, srcCodeIDToChecksum, destCodeIDToChecksum)
@@ -101,7 +105,53 @@ func TestSnapshotter(t *testing.T) {
 	}
 }
 
-func newWasmExampleApp(t *testing.T) (*app.WasmApp, sdk.AccAddress) {
+func BenchmarkSnapshotRestore(b *testing.B) {
+	wasmFiles := []string{
+		"./testdata/hackatom.wasm", "./testdata/burner.wasm", "./testdata/reflect.wasm",
+		"./testdata/ibc_reflect.wasm", "./testdata/ibc_reflect_send.wasm", "./testdata/staking.wasm",
+	}
+	// setup source app
+	srcWasmApp, creator := newWasmExampleApp(b)
+	ctx := srcWasmApp.NewUncachedContext(false, tmproto.Header{
+		ChainID: "foo",
+		Height:  srcWasmApp.LastBlockHeight() + 1,
+		Time:    time.Now(),
+	})
+	wasmKeeper := app.NewTestSupport(b, srcWasmApp).WasmKeeper()
+	contractKeeper := keeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	for _, v := range wasmFiles {
+		wasmCode, err := os.ReadFile(v)
+		require.NoError(b, err)
+		_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+		require.NoError(b, err)
+	}
+	srcWasmApp.Commit()
+	snapshot, err := srcWasmApp.SnapshotManager().Create(uint64(srcWasmApp.LastBlockHeight()))
+	require.NoError(b, err)
+
+	for _, concurrency := range []uint32{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("concurrency %d", concurrency), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				destWasmApp := app.SetupWithEmptyStore(b, keeper.WithSnapshotRestoreConcurrency(concurrency))
+				b.StartTimer()
+
+				require.NoError(b, destWasmApp.SnapshotManager().Restore(*snapshot))
+				for j := uint32(0); j < snapshot.Chunks; j++ {
+					chunkBz, err := srcWasmApp.SnapshotManager().LoadChunk(snapshot.Height, snapshot.Format, j)
+					require.NoError(b, err)
+					end, err := destWasmApp.SnapshotManager().RestoreChunk(chunkBz)
+					require.NoError(b, err)
+					if end {
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func newWasmExampleApp(t testing.TB) (*app.WasmApp, sdk.AccAddress) {
 	senderPrivKey := ed25519.GenPrivKey()
 	pubKey, err := cryptocodec.ToOcPubKeyInterface(senderPrivKey.PubKey())
 	require.NoError(t, err)
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	snapshot "github.com/Finschia/finschia-sdk/snapshots/types"
+	wasmvm "github.com/Finschia/wasmvm"
+
+	"github.com/Finschia/wasmd/x/wasm/ioutils"
+	"github.com/Finschia/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestSnapshotterRestore(t *testing.T) {
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	gzippedCode, err := ioutils.GzipIt(wasmCode)
+	require.NoError(t, err)
+	corruptCode, err := ioutils.GzipIt(append([]byte{}, wasmCode[:len(wasmCode)-1]...))
+	require.NoError(t, err)
+
+	codeItem := func(checksum []byte, mutators ...func(*types.SnapshotCode)) *types.SnapshotPayload {
+		item := types.SnapshotCode{Checksum: checksum, CodeIDs: []uint64{1}, WASMByteCode: gzippedCode}
+		for _, m := range mutators {
+			m(&item)
+		}
+		return &types.SnapshotPayload{Sum: &types.SnapshotPayload_Code{Code: &item}}
+	}
+	manifestItem := func(codeCount uint64, checksums ...[]byte) *types.SnapshotPayload {
+		return &types.SnapshotPayload{Sum: &types.SnapshotPayload_Manifest{Manifest: &types.SnapshotManifest{CodeCount: codeCount, Checksums: checksums}}}
+	}
+
+	specs := map[string]struct {
+		format  uint32
+		pinned  bool
+		items   func(checksum []byte) [][]byte
+		expErr  bool
+		expPins bool
+	}{
+		"format 2": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum)), mustMarshalPayload(t, manifestItem(1, checksum))}
+			},
+		},
+		"format 2 pinned": {
+			format: SnapshotFormat,
+			pinned: true,
+			items: func(checksum []byte) [][]byte {
+				pinned := func(c *types.SnapshotCode) { c.Pinned = true }
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum, pinned)), mustMarshalPayload(t, manifestItem(1, checksum))}
+			},
+			expPins: true,
+		},
+		"format 1": {
+			format: SnapshotFormatV1,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{gzippedCode}
+			},
+		},
+		"format 2 missing manifest": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum))}
+			},
+			expErr: true,
+		},
+		"format 2 manifest code count mismatch": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum)), mustMarshalPayload(t, manifestItem(2, checksum))}
+			},
+			expErr: true,
+		},
+		"format 2 manifest checksum mismatch": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum)), mustMarshalPayload(t, manifestItem(1, bytes.Repeat([]byte{1}, 32)))}
+			},
+			expErr: true,
+		},
+		"format 2 item after manifest": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{mustMarshalPayload(t, manifestItem(0)), mustMarshalPayload(t, codeItem(checksum))}
+			},
+			expErr: true,
+		},
+		"format 2 missing code": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{mustMarshalPayload(t, manifestItem(0))}
+			},
+			expErr: true,
+		},
+		"format 2 corrupt code": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				corrupt := func(c *types.SnapshotCode) { c.WASMByteCode = corruptCode }
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum, corrupt)), mustMarshalPayload(t, manifestItem(1, checksum))}
+			},
+			expErr: true,
+		},
+		"format 2 unknown code id": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				unknown := func(c *types.SnapshotCode) { c.CodeIDs = []uint64{2} }
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum, unknown)), mustMarshalPayload(t, manifestItem(1, checksum))}
+			},
+			expErr: true,
+		},
+		"format 2 pinned flag mismatch": {
+			format: SnapshotFormat,
+			pinned: true,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{mustMarshalPayload(t, codeItem(checksum)), mustMarshalPayload(t, manifestItem(1, checksum))}
+			},
+			expErr: true,
+		},
+		"format 2 invalid payload": {
+			format: SnapshotFormat,
+			items: func(checksum []byte) [][]byte {
+				return [][]byte{gzippedCode}
+			},
+			expErr: true,
+		},
+		"unknown format": {
+			format: 3,
+			items: func(checksum []byte) [][]byte {
+				return nil
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			// store the code info only as the byte code is restored from the snapshot
+			checksum, err := k.wasmVM.Create(wasmCode)
+			require.NoError(t, err)
+			codeInfo := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
+			k.storeCodeInfo(ctx, 1, codeInfo)
+			if spec.pinned {
+				ctx.KVStore(k.storeKey).Set(types.GetPinnedCodeIndexPrefix(1), []byte{1})
+			}
+
+			var buf bytes.Buffer
+			w := protoio.NewDelimitedWriter(&buf)
+			for _, item := range spec.items(checksum) {
+				require.NoError(t, snapshot.WriteExtensionItem(w, item))
+			}
+			require.NoError(t, w.Close())
+
+			// when
+			_, gotErr := NewWasmSnapshotter(ctx.MultiStore(), k).
+				Restore(1, spec.format, protoio.NewDelimitedReader(&buf, 1e8))
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expPins, k.IsPinnedCode(ctx, 1))
+		})
+	}
+}
+
+func mustMarshalPayload(t *testing.T, payload *types.SnapshotPayload) []byte {
+	t.Helper()
+	bz, err := payload.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestWasmCompiler(t *testing.T) {
+	specs := map[string]struct {
+		concurrency uint32
+		failing     map[string]bool
+		expErr      string
+	}{
+		"sequential": {
+			concurrency: 1,
+		},
+		"parallel": {
+			concurrency: 4,
+		},
+		"zero defaults to sequential": {
+			concurrency: 0,
+		},
+		"first error in schedule order": {
+			concurrency: 4,
+			failing:     map[string]bool{"code-3": true, "code-7": true},
+			expErr:      "code-3",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu                  sync.Mutex
+				running, maxRunning int
+				compiled            []string
+				expMaxRunning       = int(spec.concurrency)
+			)
+			if expMaxRunning == 0 {
+				expMaxRunning = 1
+			}
+			mock := &wasmtesting.MockWasmer{CreateFn: func(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
+				mu.Lock()
+				running++
+				if running > maxRunning {
+					maxRunning = running
+				}
+				mu.Unlock()
+				time.Sleep(time.Millisecond)
+				mu.Lock()
+				defer mu.Unlock()
+				running--
+				if spec.failing[string(code)] {
+					return nil, errors.New(string(code))
+				}
+				compiled = append(compiled, string(code))
+				return wasmvm.Checksum(code), nil
+			}}
+			compiler := newWasmCompiler(mock, spec.concurrency)
+			for i := 0; i < 10; i++ {
+				compressed, err := ioutils.GzipIt([]byte(fmt.Sprintf("code-%d", i)))
+				require.NoError(t, err)
+				compiler.compile(compressed, nil)
+			}
+
+			// when
+			gotErr := compiler.wait()
+
+			// then
+			assert.LessOrEqual(t, maxRunning, expMaxRunning)
+			if spec.expErr != "" {
+				require.Error(t, gotErr)
+				assert.Contains(t, gotErr.Error(), spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, compiled, 10)
+		})
+	}
+}
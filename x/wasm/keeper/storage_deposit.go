@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// getStorageDepositPerByte returns the deposit price per byte
+func (k Keeper) getStorageDepositPerByte(ctx sdk.Context) sdk.Coins {
+	var price sdk.Coins
+	k.paramSpace.Get(ctx, types.ParamStoreKeyStorageDeposit, &price)
+	return price
+}
+
+// GetStorageDeposit returns the deposit that is held by the module for the state of the contract
+func (k Keeper) GetStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageDepositKey(contractAddr))
+	if bz == nil {
+		return nil
+	}
+	var deposit types.StorageDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit.Amount
+}
+
+// totalStorageDeposits returns the sum of the deposits that are held by the module for the state of all contracts
+func (k Keeper) totalStorageDeposits(ctx sdk.Context) sdk.Coins {
+	var total sdk.Coins
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageDepositPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.StorageDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		total = total.Add(deposit.Amount...)
+	}
+	return total
+}
+
+// checkStorageDepositsFunded returns an error when the deposits of all contracts exceed the balance of the module
+func (k Keeper) checkStorageDepositsFunded(ctx sdk.Context) error {
+	total := k.totalStorageDeposits(ctx)
+	if total.Empty() {
+		return nil
+	}
+	if balance := k.storageDeposits.StorageDepositBalance(ctx); !balance.IsAllGTE(total) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "storage deposits %s exceed the module balance %s", total, balance)
+	}
+	return nil
+}
+
+func (k Keeper) storeStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.Empty() {
+		store.Delete(types.GetStorageDepositKey(contractAddr))
+		return
+	}
+	store.Set(types.GetStorageDepositKey(contractAddr), k.cdc.MustMarshal(&types.StorageDeposit{Amount: amount}))
+}
+
+// adjustStorageDeposit charges the contract the deposit for the state growth from oldBytes to newBytes at the
+// current price. When the state shrinks, the share of the held deposit for the freed bytes is refunded and the
+// full deposit when the state is empty.
+func (k Keeper) adjustStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, oldBytes, newBytes uint64) error {
+	switch {
+	case newBytes > oldBytes:
+		price := k.getStorageDepositPerByte(ctx)
+		if price.Empty() {
+			return nil
+		}
+		amount := mulCoins(price, sdk.NewIntFromUint64(newBytes-oldBytes))
+		if err := k.storageDeposits.ChargeStorageDeposit(ctx, contractAddr, amount); err != nil {
+			return sdkerrors.Wrap(types.ErrInsufficientStorageDeposit, err.Error())
+		}
+		k.storeStorageDeposit(ctx, contractAddr, k.GetStorageDeposit(ctx, contractAddr).Add(amount...))
+	case newBytes < oldBytes:
+		deposit := k.GetStorageDeposit(ctx, contractAddr)
+		if deposit.Empty() {
+			return nil
+		}
+		refund := deposit
+		if newBytes != 0 {
+			refund = shareOfCoins(deposit, sdk.NewIntFromUint64(oldBytes-newBytes), sdk.NewIntFromUint64(oldBytes))
+			if refund.Empty() {
+				return nil
+			}
+		}
+		if err := k.storageDeposits.RefundStorageDeposit(ctx, contractAddr, refund); err != nil {
+			return sdkerrors.Wrap(err, "refund storage deposit")
+		}
+		k.storeStorageDeposit(ctx, contractAddr, deposit.Sub(refund))
+	}
+	return nil
+}
+
+func mulCoins(coins sdk.Coins, n sdk.Int) sdk.Coins {
+	result := make(sdk.Coins, 0, len(coins))
+	for _, c := range coins {
+		result = append(result, sdk.NewCoin(c.Denom, c.Amount.Mul(n)))
+	}
+	return result
+}
+
+// shareOfCoins returns coins * numerator / denominator rounded down for every denom
+func shareOfCoins(coins sdk.Coins, numerator, denominator sdk.Int) sdk.Coins {
+	var result sdk.Coins
+	for _, c := range coins {
+		if amount := c.Amount.Mul(numerator).Quo(denominator); amount.IsPositive() {
+			result = append(result, sdk.NewCoin(c.Denom, amount))
+		}
+	}
+	return result
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestStorageDeposit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	k.SetParams(parentCtx, params)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	specs := map[string]struct {
+		funds      sdk.Coins
+		setup      func(store *contractStateStore)
+		expDeposit sdk.Coins
+		expBalance sdk.Coins
+	}{
+		"charged on new key": {
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+			},
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 12)),
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("denom", 88)),
+		},
+		"charged on growing value": {
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("foo"), []byte("barbar"))
+			},
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 18)),
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("denom", 82)),
+		},
+		"share refunded on shrinking value": {
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("barbar"))
+				store.Set([]byte("foo"), []byte("b"))
+			},
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 8)),
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("denom", 92)),
+		},
+		"all refunded on empty state": {
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("a"), []byte("b"))
+				store.Delete([]byte("foo"))
+				store.Delete([]byte("a"))
+			},
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		},
+		"not charged on unchanged size": {
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 12)),
+			setup: func(store *contractStateStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("foo"), []byte("baz"))
+			},
+			expDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 12)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			contractAddr := RandomAccountAddress(t)
+			keepers.Faucet.Fund(ctx, contractAddr, spec.funds...)
+			moduleBalance := keepers.BankKeeper.GetAllBalances(ctx, moduleAddr)
+
+			store := k.newContractStateStore(ctx, contractAddr, 1)
+			spec.setup(store)
+
+			// when
+			require.NoError(t, store.checkStateChange())
+
+			// then
+			assert.Equal(t, spec.expDeposit.String(), k.GetStorageDeposit(ctx, contractAddr).String())
+			assert.Equal(t, spec.expBalance.String(), keepers.BankKeeper.GetAllBalances(ctx, contractAddr).String())
+			assert.Equal(t, moduleBalance.Add(spec.expDeposit...).String(), keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).String())
+			msg, broken := StorageDepositsInvariant(k)(ctx)
+			assert.False(t, broken, msg)
+		})
+	}
+}
+
+func TestStorageDepositOnInstantiate(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	k.SetParams(parentCtx, params)
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	keepers.Faucet.Fund(parentCtx, example.CreatorAddr, sdk.NewInt64Coin("denom", 100000))
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	specs := map[string]struct {
+		funds  sdk.Coins
+		expErr bool
+	}{
+		"deposit paid from contract balance": {
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 10000)),
+		},
+		"insufficient contract balance": {
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "test", spec.funds)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.True(t, types.ErrInsufficientStorageDeposit.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+			size := k.GetContractStateSize(ctx, contractAddr)
+			require.NotZero(t, size.TotalBytes)
+			expDeposit := sdk.NewCoins(sdk.NewCoin("denom", sdk.NewIntFromUint64(size.TotalBytes)))
+			assert.Equal(t, expDeposit, k.GetStorageDeposit(ctx, contractAddr))
+			assert.Equal(t, spec.funds.Sub(expDeposit), keepers.BankKeeper.GetAllBalances(ctx, contractAddr))
+		})
+	}
+}
+
+func TestStorageDepositInsufficientFunds(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	k.SetParams(ctx, params)
+	contractAddr := RandomAccountAddress(t)
+	keepers.Faucet.Fund(ctx, contractAddr, sdk.NewInt64Coin("denom", 11))
+	store := k.newContractStateStore(ctx, contractAddr, 1)
+	store.Set([]byte("foo"), []byte("bar"))
+
+	// when
+	gotErr := store.checkStateChange()
+
+	// then
+	require.Error(t, gotErr)
+	assert.True(t, types.ErrInsufficientStorageDeposit.Is(gotErr), gotErr)
+	assert.Empty(t, k.GetStorageDeposit(ctx, contractAddr))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 11)), keepers.BankKeeper.GetAllBalances(ctx, contractAddr))
+}
+
+func TestStorageDepositDisabled(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+
+	store := k.newContractStateStore(ctx, contractAddr, 1)
+	store.Set([]byte("foo"), []byte("bar"))
+
+	require.NoError(t, store.checkStateChange())
+	assert.Empty(t, k.GetStorageDeposit(ctx, contractAddr))
+}
+
+func TestImportContractStateExemptFromDeposit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	k.SetParams(ctx, params)
+	contractAddr := RandomAccountAddress(t)
+
+	require.NoError(t, k.importContractState(ctx, contractAddr, []types.Model{{Key: []byte("foo"), Value: []byte("bar")}}))
+
+	assert.Empty(t, k.GetStorageDeposit(ctx, contractAddr))
+	assert.Equal(t, types.ContractStateSize{KeyCount: 1, TotalBytes: 6}, k.GetContractStateSize(ctx, contractAddr))
+}
@@ -0,0 +1,35 @@
+package testdata
+
+import (
+	_ "embed"
+
+	typwasmvmtypes "github.com/Finschia/wasmvm/types"
+)
+
+//go:embed hackatom.wasm
+var hackatomContract []byte
+
+func HackatomContractWasm() []byte {
+	return hackatomContract
+}
+
+// HackatomInitMsg is used to instantiate the hackatom contract
+type HackatomInitMsg struct {
+	Verifier    string `json:"verifier"`
+	Beneficiary string `json:"beneficiary"`
+}
+
+// HackatomMigrateMsg is used to migrate the hackatom contract
+type HackatomMigrateMsg struct {
+	Verifier string `json:"verifier"`
+}
+
+// HackatomSudoMsg is used to encode sudo messages
+type HackatomSudoMsg struct {
+	StealFunds *StealFundsPayload `json:"steal_funds,omitempty"`
+}
+
+type StealFundsPayload struct {
+	Recipient string               `json:"recipient"`
+	Amount    typwasmvmtypes.Coins `json:"amount"`
+}
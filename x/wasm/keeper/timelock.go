@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// GetContractTimelock returns the number of blocks that admin operations on the contract are queued before they are
+// executed. Zero when the contract is not timelocked.
+func (k Keeper) GetContractTimelock(ctx sdk.Context, contractAddr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractTimelockKey(contractAddr))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// IterateContractTimelocks iterates through all contract timelocks ordered by contract address.
+// The callback method can return true to abort early.
+func (k Keeper) IterateContractTimelocks(ctx sdk.Context, cb func(types.ContractTimelock) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractTimelockPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		timelock := types.ContractTimelock{
+			ContractAddress: sdk.AccAddress(iter.Key()).String(),
+			DelayBlocks:     binary.BigEndian.Uint64(iter.Value()),
+		}
+		// cb returns true to stop early
+		if cb(timelock) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeContractTimelock(ctx sdk.Context, contractAddr sdk.AccAddress, delayBlocks uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if delayBlocks == 0 {
+		store.Delete(types.GetContractTimelockKey(contractAddr))
+		return
+	}
+	store.Set(types.GetContractTimelockKey(contractAddr), sdk.Uint64ToBigEndian(delayBlocks))
+}
+
+// setContractTimelock sets the number of blocks that migrations and admin changes of the contract are queued.
+// Zero removes the timelock. Operations that were queued before are not affected.
+func (k Keeper) setContractTimelock(ctx sdk.Context, contractAddr, caller sdk.AccAddress, delayBlocks uint64, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := types.ValidateTimelockDelay(delayBlocks); err != nil {
+		return err
+	}
+	k.storeContractTimelock(ctx, contractAddr, delayBlocks)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateTimelock,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelayBlocks, strconv.FormatUint(delayBlocks, 10)),
+	))
+	return nil
+}
+
+// GetTimelockedOperation returns the queued operation of the contract or nil when not found
+func (k Keeper) GetTimelockedOperation(ctx sdk.Context, contractAddr sdk.AccAddress, operationID uint64) *types.TimelockedOperation {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTimelockedOperationKey(contractAddr, operationID))
+	if bz == nil {
+		return nil
+	}
+	var op types.TimelockedOperation
+	k.cdc.MustUnmarshal(bz, &op)
+	return &op
+}
+
+// IterateTimelockedOperations iterates through all queued operations ordered by contract address and id.
+// The callback method can return true to abort early.
+func (k Keeper) IterateTimelockedOperations(ctx sdk.Context, cb func(types.TimelockedOperation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimelockedOperationPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var op types.TimelockedOperation
+		k.cdc.MustUnmarshal(iter.Value(), &op)
+		// cb returns true to stop early
+		if cb(op) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeTimelockedOperation(ctx sdk.Context, contractAddr sdk.AccAddress, op types.TimelockedOperation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTimelockedOperationKey(contractAddr, op.ID), k.cdc.MustMarshal(&op))
+	store.Set(types.GetTimelockedOperationQueueKey(op.ExecuteAtHeight, op.ID), contractAddr)
+	if op.Migration != nil {
+		store.Set(types.GetTimelockedMigrationSecondaryIndexKey(op.Migration.CodeID, op.ID), []byte{})
+	}
+}
+
+func (k Keeper) deleteTimelockedOperation(ctx sdk.Context, contractAddr sdk.AccAddress, op types.TimelockedOperation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTimelockedOperationKey(contractAddr, op.ID))
+	store.Delete(types.GetTimelockedOperationQueueKey(op.ExecuteAtHeight, op.ID))
+	if op.Migration != nil {
+		store.Delete(types.GetTimelockedMigrationSecondaryIndexKey(op.Migration.CodeID, op.ID))
+	}
+}
+
+// queueTimelockedOperation queues the operation when the contract is timelocked and the authorization policy does
+// not bypass the timelock. A higher timelock is never queued. It returns false when the operation has to be
+// executed immediately.
+func (k Keeper) queueTimelockedOperation(ctx sdk.Context, contractAddr, caller sdk.AccAddress, op types.TimelockedOperation, authZ AuthorizationPolicy) (bool, error) {
+	if authZ.CanBypassTimelock(caller) {
+		return false, nil
+	}
+	delayBlocks := k.GetContractTimelock(ctx, contractAddr)
+	if delayBlocks == 0 || op.Type == types.TimelockedOperationTypeSetTimelock && op.DelayBlocks >= delayBlocks {
+		return false, nil
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return false, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return false, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if op.Migration != nil && !k.containsCodeInfo(ctx, op.Migration.CodeID) {
+		return false, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
+	}
+	op.ContractAddress = contractAddr.String()
+	op.Sender = caller.String()
+	op.ExecuteAtHeight = uint64(ctx.BlockHeight()) + delayBlocks
+	op.ID = k.autoIncrementID(ctx, types.KeyLastTimelockedOperationID)
+	if err := op.ValidateBasic(); err != nil {
+		return false, err
+	}
+	k.storeTimelockedOperation(ctx, contractAddr, op)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQueueOperation,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, op.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(op.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyOperationType, op.Type.String()),
+		sdk.NewAttribute(types.AttributeKeyExecuteAtHeight, strconv.FormatUint(op.ExecuteAtHeight, 10)),
+	))
+	return true, nil
+}
+
+// cancelTimelockedOperation removes a queued operation of the contract
+func (k Keeper) cancelTimelockedOperation(ctx sdk.Context, contractAddr, caller sdk.AccAddress, operationID uint64, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	op := k.GetTimelockedOperation(ctx, contractAddr, operationID)
+	if op == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "timelocked operation")
+	}
+	k.deleteTimelockedOperation(ctx, contractAddr, *op)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelOperation,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(operationID, 10)),
+	))
+	return nil
+}
+
+// ExecuteTimelockedOperations executes the queued operations that are due at the current block height in the order
+// they were queued. Every operation runs in an isolated context that is limited by the timelocked operation gas limit
+// so that a failing operation does not halt the chain. Operations are removed from the queue whether they succeed or
+// not.
+func (k Keeper) ExecuteTimelockedOperations(ctx sdk.Context) {
+	var ops []types.TimelockedOperation
+	func() {
+		store := ctx.KVStore(k.storeKey)
+		end := types.GetTimelockedOperationQueueKey(uint64(ctx.BlockHeight())+1, 0)
+		iter := store.Iterator(types.TimelockedOperationQueuePrefix, end)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			operationID := sdk.BigEndianToUint64(iter.Key()[len(types.TimelockedOperationQueuePrefix)+8:])
+			op := k.GetTimelockedOperation(ctx, iter.Value(), operationID)
+			if op == nil {
+				panic("timelocked operation not found")
+			}
+			ops = append(ops, *op)
+		}
+	}()
+	for _, op := range ops {
+		contractAddr := sdk.MustAccAddressFromBech32(op.ContractAddress)
+		k.deleteTimelockedOperation(ctx, contractAddr, op)
+		if err := k.executeTimelockedOperation(ctx, contractAddr, op); err != nil {
+			k.Logger(ctx).Info("timelocked operation failed", "contract", op.ContractAddress, "operation", op.ID, "error", err.Error())
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeOperationFailed,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, op.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(op.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+		}
+	}
+}
+
+// executeTimelockedOperation runs the operation on behalf of its sender in an isolated context that is limited by
+// the gas limit. The sender must still be authorized to modify the contract. State changes and events are persisted
+// only when the operation succeeds.
+func (k Keeper) executeTimelockedOperation(ctx sdk.Context, contractAddr sdk.AccAddress, op types.TimelockedOperation) (err error) {
+	gasLimit := k.timelockedOperationGasLimit
+	opCtx, commit := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			rType, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gasWanted: %d", rType.Descriptor, gasLimit)
+		}
+	}()
+	sender := sdk.MustAccAddressFromBech32(op.Sender)
+	authZ := DefaultAuthorizationPolicy{}
+	switch op.Type {
+	case types.TimelockedOperationTypeMigrate:
+		_, err = k.migrate(opCtx, contractAddr, sender, op.Migration.CodeID, op.Migration.Msg, authZ)
+	case types.TimelockedOperationTypeUpdateAdmin:
+		err = k.setContractAdmin(opCtx, contractAddr, sender, sdk.MustAccAddressFromBech32(op.NewAdmin), authZ)
+	case types.TimelockedOperationTypeClearAdmin:
+		err = k.setContractAdmin(opCtx, contractAddr, sender, nil, authZ)
+	case types.TimelockedOperationTypeSetTimelock:
+		err = k.setContractTimelock(opCtx, contractAddr, sender, op.DelayBlocks, authZ)
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalid, "operation type: %s", op.Type)
+	}
+	if err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(opCtx.EventManager().Events())
+	return nil
+}
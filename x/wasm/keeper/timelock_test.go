@@ -0,0 +1,336 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestSetContractTimelock(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	specs := map[string]struct {
+		current   uint64
+		delay     uint64
+		caller    sdk.AccAddress
+		contract  sdk.AccAddress
+		expDelay  uint64
+		expQueued bool
+		expErr    *sdkerrors.Error
+	}{
+		"admin sets timelock": {
+			delay:    10,
+			caller:   example.CreatorAddr,
+			contract: example.Contract,
+			expDelay: 10,
+		},
+		"admin raises timelock": {
+			current:  10,
+			delay:    20,
+			caller:   example.CreatorAddr,
+			contract: example.Contract,
+			expDelay: 20,
+		},
+		"admin lowers timelock": {
+			current:   10,
+			delay:     5,
+			caller:    example.CreatorAddr,
+			contract:  example.Contract,
+			expDelay:  10,
+			expQueued: true,
+		},
+		"admin removes timelock": {
+			current:   10,
+			caller:    example.CreatorAddr,
+			contract:  example.Contract,
+			expDelay:  10,
+			expQueued: true,
+		},
+		"non admin": {
+			delay:    10,
+			caller:   RandomAccountAddress(t),
+			contract: example.Contract,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"non admin can not lower": {
+			current:  10,
+			delay:    5,
+			caller:   RandomAccountAddress(t),
+			contract: example.Contract,
+			expDelay: 10,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			delay:    10,
+			caller:   example.CreatorAddr,
+			contract: RandomAccountAddress(t),
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"delay exceeds max": {
+			delay:    1 << 63,
+			caller:   example.CreatorAddr,
+			contract: example.Contract,
+			expErr:   types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			keepers.WasmKeeper.storeContractTimelock(ctx, example.Contract, spec.current)
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := keepers.ContractKeeper.SetContractTimelock(ctx.WithEventManager(em), spec.contract, spec.caller, spec.delay)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Equal(t, spec.expDelay, keepers.WasmKeeper.GetContractTimelock(ctx, spec.contract))
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expDelay, keepers.WasmKeeper.GetContractTimelock(ctx, spec.contract))
+			require.Len(t, em.Events(), 1)
+			if spec.expQueued {
+				assert.Equal(t, types.EventTypeQueueOperation, em.Events()[0].Type)
+				return
+			}
+			assert.Equal(t, types.EventTypeUpdateTimelock, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestTimelockedContractOperations(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	burner := StoreBurnerExampleContract(t, parentCtx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	keepers.WasmKeeper.storeContractTimelock(parentCtx, example.Contract, 10)
+
+	specs := map[string]struct {
+		exec         func(ctx sdk.Context) error
+		expOperation types.TimelockedOperation
+		assertExec   func(t *testing.T, ctx sdk.Context)
+	}{
+		"migrate": {
+			exec: func(ctx sdk.Context) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, burner.CodeID, BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t))
+				return err
+			},
+			expOperation: types.TimelockedOperation{
+				Type: types.TimelockedOperationTypeMigrate,
+				Migration: &types.TimelockedMigration{
+					CodeID: burner.CodeID,
+					Msg:    BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t),
+				},
+			},
+			assertExec: func(t *testing.T, ctx sdk.Context) {
+				assert.Equal(t, burner.CodeID, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).CodeID)
+			},
+		},
+		"update admin": {
+			exec: func(ctx sdk.Context) error {
+				return keepers.ContractKeeper.UpdateContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin)
+			},
+			expOperation: types.TimelockedOperation{
+				Type:     types.TimelockedOperationTypeUpdateAdmin,
+				NewAdmin: newAdmin.String(),
+			},
+			assertExec: func(t *testing.T, ctx sdk.Context) {
+				assert.Equal(t, newAdmin.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+			},
+		},
+		"clear admin": {
+			exec: func(ctx sdk.Context) error {
+				return keepers.ContractKeeper.ClearContractAdmin(ctx, example.Contract, example.CreatorAddr)
+			},
+			expOperation: types.TimelockedOperation{
+				Type: types.TimelockedOperationTypeClearAdmin,
+			},
+			assertExec: func(t *testing.T, ctx sdk.Context) {
+				assert.Empty(t, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+			},
+		},
+		"lower timelock": {
+			exec: func(ctx sdk.Context) error {
+				return keepers.ContractKeeper.SetContractTimelock(ctx, example.Contract, example.CreatorAddr, 0)
+			},
+			expOperation: types.TimelockedOperation{
+				Type: types.TimelockedOperationTypeSetTimelock,
+			},
+			assertExec: func(t *testing.T, ctx sdk.Context) {
+				assert.Equal(t, uint64(0), keepers.WasmKeeper.GetContractTimelock(ctx, example.Contract))
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			infoBefore := keepers.WasmKeeper.GetContractInfo(ctx, example.Contract)
+
+			// when
+			require.NoError(t, spec.exec(ctx))
+
+			// then the operation is queued and the contract is not modified
+			assert.Equal(t, infoBefore, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract))
+			var ops []types.TimelockedOperation
+			keepers.WasmKeeper.IterateTimelockedOperations(ctx, func(op types.TimelockedOperation) bool {
+				ops = append(ops, op)
+				return false
+			})
+			require.Len(t, ops, 1)
+			exp := spec.expOperation
+			exp.ID = ops[0].ID
+			exp.ContractAddress = example.Contract.String()
+			exp.Sender = example.CreatorAddr.String()
+			exp.ExecuteAtHeight = uint64(ctx.BlockHeight()) + 10
+			assert.Equal(t, exp, ops[0])
+
+			// and nothing is executed before the due height
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+			keepers.WasmKeeper.ExecuteTimelockedOperations(ctx)
+			assert.Equal(t, infoBefore, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract))
+			assert.NotNil(t, keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, exp.ID))
+
+			// when the due height is reached
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			em := sdk.NewEventManager()
+			keepers.WasmKeeper.ExecuteTimelockedOperations(ctx.WithEventManager(em))
+
+			// then
+			spec.assertExec(t, ctx)
+			assert.Nil(t, keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, exp.ID))
+			require.NotEmpty(t, em.Events())
+			for _, e := range em.Events() {
+				assert.NotEqual(t, types.EventTypeOperationFailed, e.Type)
+			}
+		})
+	}
+}
+
+func TestGovBypassesContractTimelock(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	keepers.WasmKeeper.storeContractTimelock(ctx, example.Contract, 10)
+	newAdmin := RandomAccountAddress(t)
+
+	// when
+	err := NewGovPermissionKeeper(keepers.WasmKeeper).UpdateContractAdmin(ctx, example.Contract, RandomAccountAddress(t), newAdmin)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, newAdmin.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+	keepers.WasmKeeper.IterateTimelockedOperations(ctx, func(op types.TimelockedOperation) bool {
+		t.Fatalf("unexpected operation: %v", op)
+		return true
+	})
+}
+
+func TestCancelTimelockedOperation(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	keepers.WasmKeeper.storeContractTimelock(parentCtx, example.Contract, 10)
+
+	specs := map[string]struct {
+		caller      sdk.AccAddress
+		operationID uint64
+		expErr      *sdkerrors.Error
+	}{
+		"admin cancels": {
+			caller:      example.CreatorAddr,
+			operationID: 1,
+		},
+		"non admin": {
+			caller:      RandomAccountAddress(t),
+			operationID: 1,
+			expErr:      sdkerrors.ErrUnauthorized,
+		},
+		"unknown operation": {
+			caller:      example.CreatorAddr,
+			operationID: 2,
+			expErr:      types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, example.Contract, example.CreatorAddr))
+			op := keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, 1)
+			require.NotNil(t, op)
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := keepers.ContractKeeper.CancelTimelockedOperation(ctx.WithEventManager(em), example.Contract, spec.caller, spec.operationID)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.NotNil(t, keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, 1))
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, 1))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeCancelOperation, em.Events()[0].Type)
+
+			// and the cancelled operation is not executed
+			keepers.WasmKeeper.ExecuteTimelockedOperations(ctx.WithBlockHeight(int64(op.ExecuteAtHeight)))
+			assert.Equal(t, example.CreatorAddr.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+		})
+	}
+}
+
+func TestExecuteTimelockedOperationFails(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	keepers.WasmKeeper.storeContractTimelock(ctx, example.Contract, 10)
+	otherAdmin := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, example.Contract, example.CreatorAddr))
+	// the admin is changed by gov in the meantime
+	require.NoError(t, NewGovPermissionKeeper(keepers.WasmKeeper).UpdateContractAdmin(ctx, example.Contract, RandomAccountAddress(t), otherAdmin))
+
+	// when
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	em := sdk.NewEventManager()
+	keepers.WasmKeeper.ExecuteTimelockedOperations(ctx.WithEventManager(em))
+
+	// then
+	assert.Equal(t, otherAdmin.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+	assert.Nil(t, keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, 1))
+	require.Len(t, em.Events(), 1)
+	assert.Equal(t, types.EventTypeOperationFailed, em.Events()[0].Type)
+	assert.Equal(t, "1", attrsToStringMap(em.Events()[0].Attributes)[types.AttributeKeyOperationID])
+}
+
+func TestAcceptContractAdminWithTimelock(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin, 0))
+	keepers.WasmKeeper.storeContractTimelock(ctx, example.Contract, 10)
+
+	// when
+	require.NoError(t, keepers.ContractKeeper.AcceptContractAdmin(ctx, example.Contract, newAdmin))
+
+	// then the admin change is queued
+	assert.Equal(t, example.CreatorAddr.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+	assert.Nil(t, keepers.WasmKeeper.GetPendingAdmin(ctx, example.Contract))
+	op := keepers.WasmKeeper.GetTimelockedOperation(ctx, example.Contract, 1)
+	require.NotNil(t, op)
+	assert.Equal(t, types.TimelockedOperationTypeUpdateAdmin, op.Type)
+	assert.Equal(t, example.CreatorAddr.String(), op.Sender)
+	assert.Equal(t, newAdmin.String(), op.NewAdmin)
+
+	// and executed when due
+	keepers.WasmKeeper.ExecuteTimelockedOperations(ctx.WithBlockHeight(int64(op.ExecuteAtHeight)))
+	assert.Equal(t, newAdmin.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+}
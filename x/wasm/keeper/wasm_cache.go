@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// WasmCacheEntry is the result of verifying and rebuilding the wasmvm cache for a single checksum
+type WasmCacheEntry struct {
+	Checksum []byte
+	CodeIDs  []uint64
+	// Err is set when the byte code is missing in the cache, does not match the checksum or can not be compiled
+	Err error
+}
+
+// RebuildWasmCache verifies that the wasm byte code of every stored code info is present in the wasmvm cache
+// and matches its checksum. Unless verifyOnly is set, the byte code is recompiled into the cache with the given
+// number of workers. The entries are returned in code id order.
+// Pinned codes are not pinned here as the in-memory cache does not outlive the process. They are pinned again
+// by InitializePinnedCodes when the node starts.
+func (k Keeper) RebuildWasmCache(ctx sdk.Context, verifyOnly bool, concurrency uint32) []WasmCacheEntry {
+	var entries []WasmCacheEntry
+	pos := make(map[string]int)
+	k.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		hexHash := hex.EncodeToString(info.CodeHash)
+		i, ok := pos[hexHash]
+		if !ok {
+			i = len(entries)
+			pos[hexHash] = i
+			entries = append(entries, WasmCacheEntry{Checksum: info.CodeHash})
+		}
+		entries[i].CodeIDs = append(entries[i].CodeIDs, codeID)
+		return false
+	})
+
+	if concurrency == 0 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range entries {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(e *WasmCacheEntry) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			e.Err = k.rebuildWasmCacheEntry(*e, verifyOnly)
+		}(&entries[i])
+	}
+	wg.Wait()
+	return entries
+}
+
+func (k Keeper) rebuildWasmCacheEntry(e WasmCacheEntry, verifyOnly bool) error {
+	wasmCode, err := k.wasmVM.GetCode(e.Checksum)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNotFound, err.Error())
+	}
+	if hash := sha256.Sum256(wasmCode); !bytes.Equal(hash[:], e.Checksum) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "byte code does not match checksum, got %X", hash[:])
+	}
+	if verifyOnly {
+		return nil
+	}
+	if _, err := k.wasmVM.Create(wasmCode); err != nil {
+		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	return nil
+}
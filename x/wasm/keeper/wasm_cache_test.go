@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wasmvm "github.com/Finschia/wasmvm"
+
+	"github.com/Finschia/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestRebuildWasmCache(t *testing.T) {
+	codeA, codeB := []byte("code a"), []byte("code b")
+	hashA, hashB := sha256.Sum256(codeA), sha256.Sum256(codeB)
+
+	specs := map[string]struct {
+		verifyOnly bool
+		cache      map[string][]byte
+		createErr  error
+		expErrs    []bool
+		expCreated int
+	}{
+		"all good": {
+			cache:      map[string][]byte{string(hashA[:]): codeA, string(hashB[:]): codeB},
+			expErrs:    []bool{false, false},
+			expCreated: 2,
+		},
+		"verify only": {
+			verifyOnly: true,
+			cache:      map[string][]byte{string(hashA[:]): codeA, string(hashB[:]): codeB},
+			expErrs:    []bool{false, false},
+		},
+		"missing code": {
+			cache:      map[string][]byte{string(hashB[:]): codeB},
+			expErrs:    []bool{true, false},
+			expCreated: 1,
+		},
+		"mismatched code": {
+			verifyOnly: true,
+			cache:      map[string][]byte{string(hashA[:]): codeB, string(hashB[:]): codeB},
+			expErrs:    []bool{true, false},
+		},
+		"compile fails": {
+			cache:     map[string][]byte{string(hashA[:]): codeA, string(hashB[:]): codeB},
+			createErr: errors.New("testing"),
+			expErrs:   []bool{true, true},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				created int
+			)
+			mock := wasmtesting.MockWasmer{
+				GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+					if code, ok := spec.cache[string(checksum)]; ok {
+						return code, nil
+					}
+					return nil, errors.New("not found")
+				},
+				CreateFn: func(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
+					if spec.createErr != nil {
+						return nil, spec.createErr
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					created++
+					hash := sha256.Sum256(code)
+					return hash[:], nil
+				},
+			}
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+			k := keepers.WasmKeeper
+			k.storeCodeInfo(ctx, 1, types.CodeInfoFixture(types.WithSHA256CodeHash(codeA)))
+			k.storeCodeInfo(ctx, 2, types.CodeInfoFixture(types.WithSHA256CodeHash(codeB)))
+			k.storeCodeInfo(ctx, 3, types.CodeInfoFixture(types.WithSHA256CodeHash(codeA)))
+			// pinned codes are not pinned offline, the mock panics on Pin
+			ctx.KVStore(k.storeKey).Set(types.GetPinnedCodeIndexPrefix(2), []byte{1})
+
+			// when
+			entries := k.RebuildWasmCache(ctx, spec.verifyOnly, 2)
+
+			// then
+			require.Len(t, entries, 2)
+			assert.Equal(t, hashA[:], entries[0].Checksum)
+			assert.Equal(t, []uint64{1, 3}, entries[0].CodeIDs)
+			assert.Equal(t, hashB[:], entries[1].Checksum)
+			assert.Equal(t, []uint64{2}, entries[1].CodeIDs)
+			for i, expErr := range spec.expErrs {
+				assert.Equal(t, expErr, entries[i].Err != nil, "entry %d: %v", i, entries[i].Err)
+			}
+			assert.Equal(t, spec.expCreated, created)
+		})
+	}
+}
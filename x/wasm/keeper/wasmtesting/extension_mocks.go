@@ -24,6 +24,44 @@ func (m *MockCoinTransferrer) TransferCoins(ctx sdk.Context, fromAddr sdk.AccAdd
 	return m.TransferCoinsFn(ctx, fromAddr, toAddr, amt)
 }
 
+type MockContractFeeDeducter struct {
+	DeductContractFeesFn func(ctx sdk.Context, contractAddr sdk.AccAddress, fees sdk.Coins) error
+}
+
+func (m *MockContractFeeDeducter) DeductContractFees(ctx sdk.Context, contractAddr sdk.AccAddress, fees sdk.Coins) error {
+	if m.DeductContractFeesFn == nil {
+		panic("not expected to be called")
+	}
+	return m.DeductContractFeesFn(ctx, contractAddr, fees)
+}
+
+type MockStorageDepositKeeper struct {
+	ChargeStorageDepositFn  func(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error
+	RefundStorageDepositFn  func(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error
+	StorageDepositBalanceFn func(ctx sdk.Context) sdk.Coins
+}
+
+func (m *MockStorageDepositKeeper) ChargeStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error {
+	if m.ChargeStorageDepositFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ChargeStorageDepositFn(ctx, contractAddr, amt)
+}
+
+func (m *MockStorageDepositKeeper) RefundStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amt sdk.Coins) error {
+	if m.RefundStorageDepositFn == nil {
+		panic("not expected to be called")
+	}
+	return m.RefundStorageDepositFn(ctx, contractAddr, amt)
+}
+
+func (m *MockStorageDepositKeeper) StorageDepositBalance(ctx sdk.Context) sdk.Coins {
+	if m.StorageDepositBalanceFn == nil {
+		panic("not expected to be called")
+	}
+	return m.StorageDepositBalanceFn(ctx)
+}
+
 type AccountPrunerMock struct {
 	CleanupExistingAccountFn func(ctx sdk.Context, existingAccount authtypes.AccountI) (handled bool, err error)
 }
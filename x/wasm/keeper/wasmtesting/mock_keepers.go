@@ -118,3 +118,14 @@ func (m MockIBCTransferKeeper) GetPort(ctx sdk.Context) string {
 	}
 	return m.GetPortFn(ctx)
 }
+
+type MockIBCPacketRateLimiter struct {
+	ConsumeIBCPacketQuotaFn func(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, dataLen uint64) error
+}
+
+func (m MockIBCPacketRateLimiter) ConsumeIBCPacketQuota(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, dataLen uint64) error {
+	if m.ConsumeIBCPacketQuotaFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ConsumeIBCPacketQuotaFn(ctx, contractAddr, channelID, dataLen)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/rand"
 	"runtime/debug"
+	"strconv"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -39,6 +40,16 @@ const (
 	flagWasmMemoryCacheSize    = "wasm.memory_cache_size"
 	flagWasmQueryGasLimit      = "wasm.query_gas_limit"
 	flagWasmSimulationGasLimit = "wasm.simulation_gas_limit"
+
+	flagWasmSnapshotRestoreConcurrency = "wasm.snapshot_restore_concurrency"
+	flagWasmGenesisStreamFile          = "wasm.genesis_stream_file"
+)
+
+// Genesis export related flags
+const (
+	flagWasmExcludeStateForCode = "wasm-exclude-state-for-code"
+	flagWasmOnlyContracts       = "wasm-only-contracts"
+	flagWasmStripCodeBytes      = "wasm-strip-code-bytes"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -109,7 +120,7 @@ type AppModule struct {
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // NewAppModule creates a new AppModule object
 func NewAppModule(
@@ -132,6 +143,11 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(keeper.NewDefaultPermissionKeeper(am.keeper)))
 	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(am.keeper))
+
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 func (am AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier { //nolint:staticcheck
@@ -139,7 +155,9 @@ func (am AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 }
 
 // RegisterInvariants registers the wasm module invariants.
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the wasm module.
 func (am AppModule) Route() sdk.Route {
@@ -170,12 +188,21 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the wasm module.
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the wasm module. It calls the
+// contracts that are registered as begin block hooks.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.ExecuteBlockHooks(ctx, types.BlockHookPhaseBeginBlock)
+}
 
-// EndBlock returns the end blocker for the wasm module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the wasm module. It calls the contracts
+// that are registered as end block hooks, executes the due timelocked
+// operations, removes outdated IBC packet usages, updates the contract state
+// metrics and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ExecuteBlockHooks(ctx, types.BlockHookPhaseEndBlock)
+	am.keeper.ExecuteTimelockedOperations(ctx)
+	am.keeper.PruneIBCPacketUsages(ctx)
+	am.keeper.UpdateContractStateMetrics(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -188,9 +215,9 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
 }
 
-// ProposalContents doesn't return any content functions for governance proposals.
-func (AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
-	return nil
+// ProposalContents returns all the wasm content functions used to simulate governance proposals.
+func (am AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
+	return simulation.ProposalContents(am.bankKeeper, am.keeper)
 }
 
 // RandomizedParams creates randomized bank param changes for the simulator.
@@ -215,10 +242,58 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
 	startCmd.Flags().String(flagWasmSimulationGasLimit, "", "Set the max gas that can be spent when executing a simulation TX")
+	startCmd.Flags().Uint32(flagWasmSnapshotRestoreConcurrency, defaults.SnapshotRestoreConcurrency, "Set the max number of Wasm codes that are compiled in parallel when restoring a state-sync snapshot")
+	startCmd.Flags().String(flagWasmGenesisStreamFile, "", "Import the Wasm codes, contracts and sequences from this genesis stream file on chain init")
 
 	startCmd.PreRunE = chainPreRuns(checkLibwasmVersion, startCmd.PreRunE)
 }
 
+// AddModuleExportFlags adds the flags to limit the exported wasm state to the export command
+func AddModuleExportFlags(exportCmd *cobra.Command) {
+	exportCmd.Flags().StringSlice(flagWasmExcludeStateForCode, []string{}, "Comma-separated list of code ids of contracts that are exported without their state and storage deposit")
+	exportCmd.Flags().StringSlice(flagWasmOnlyContracts, []string{}, "Comma-separated list of contract addresses to export. All contracts are exported when empty")
+	exportCmd.Flags().Bool(flagWasmStripCodeBytes, false, "Export the codes without wasm byte code. The byte code is loaded from the wasm cache dir by checksum on import")
+	exportCmd.Flags().String(flagWasmGenesisStreamFile, "", "Stream the Wasm codes, contracts and sequences to this file instead of the genesis JSON")
+}
+
+// ReadGenesisExportFilter reads the wasm genesis export filter from the export flags
+func ReadGenesisExportFilter(opts servertypes.AppOptions) (keeper.GenesisExportFilter, error) {
+	var filter keeper.GenesisExportFilter
+	if v := opts.Get(flagWasmExcludeStateForCode); v != nil {
+		ids, err := cast.ToStringSliceE(v)
+		if err != nil {
+			return filter, err
+		}
+		for _, s := range ids {
+			id, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return filter, fmt.Errorf("%s: %w", flagWasmExcludeStateForCode, err)
+			}
+			filter.ExcludeStateForCodes = append(filter.ExcludeStateForCodes, id)
+		}
+	}
+	if v := opts.Get(flagWasmOnlyContracts); v != nil {
+		addrs, err := cast.ToStringSliceE(v)
+		if err != nil {
+			return filter, err
+		}
+		for _, s := range addrs {
+			addr, err := sdk.AccAddressFromBech32(s)
+			if err != nil {
+				return filter, fmt.Errorf("%s: %w", flagWasmOnlyContracts, err)
+			}
+			filter.OnlyContracts = append(filter.OnlyContracts, addr)
+		}
+	}
+	if v := opts.Get(flagWasmStripCodeBytes); v != nil {
+		var err error
+		if filter.StripCodeBytes, err = cast.ToBoolE(v); err != nil {
+			return filter, err
+		}
+	}
+	return filter, nil
+}
+
 // ReadWasmConfig reads the wasm specifig configuration
 func ReadWasmConfig(opts servertypes.AppOptions) (types.WasmConfig, error) {
 	cfg := types.DefaultWasmConfig()
@@ -242,6 +317,16 @@ func ReadWasmConfig(opts servertypes.AppOptions) (types.WasmConfig, error) {
 			cfg.SimulationGasLimit = &limit
 		}
 	}
+	if v := opts.Get(flagWasmSnapshotRestoreConcurrency); v != nil {
+		if cfg.SnapshotRestoreConcurrency, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmGenesisStreamFile); v != nil {
+		if cfg.GenesisStreamFile, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
 	// attach contract debugging to global "trace" flag
 	if v := opts.Get(server.FlagTrace); v != nil {
 		if cfg.ContractDebugMode, err = cast.ToBoolE(v); err != nil {
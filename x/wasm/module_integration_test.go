@@ -13,6 +13,7 @@ import (
 
 	"github.com/Finschia/wasmd/app"
 	"github.com/Finschia/wasmd/x/wasm"
+	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
 func TestModuleMigrations(t *testing.T) {
@@ -28,5 +29,7 @@ func TestModuleMigrations(t *testing.T) {
 	gotVM, err := wasmApp.ModuleManager().RunMigrations(ctx, wasmApp.ModuleConfigurator(), fromVM)
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, uint64(1), gotVM[wasm.ModuleName])
+	assert.Equal(t, uint64(2), gotVM[wasm.ModuleName])
+	assert.Equal(t, types.DefaultBlockHookMaxFailures, wasmApp.WasmKeeper.GetParams(ctx).BlockHookMaxFailures)
+	assert.Equal(t, types.DefaultBlockHookMaxGasLimit, wasmApp.WasmKeeper.GetParams(ctx).BlockHookMaxGasLimit)
 }
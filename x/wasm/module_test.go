@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/dvsekhvalnov/jose2go/base64url"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -419,8 +420,9 @@ func TestReadWasmConfig(t *testing.T) {
 				"wasm.query_gas_limit": 1,
 			},
 			exp: types.WasmConfig{
-				SmartQueryGasLimit: 1,
-				MemoryCacheSize:    defaults.MemoryCacheSize,
+				SmartQueryGasLimit:         1,
+				MemoryCacheSize:            defaults.MemoryCacheSize,
+				SnapshotRestoreConcurrency: defaults.SnapshotRestoreConcurrency,
 			},
 		},
 		"set cache via opts": {
@@ -428,8 +430,19 @@ func TestReadWasmConfig(t *testing.T) {
 				"wasm.memory_cache_size": 2,
 			},
 			exp: types.WasmConfig{
-				MemoryCacheSize:    2,
-				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:            2,
+				SmartQueryGasLimit:         defaults.SmartQueryGasLimit,
+				SnapshotRestoreConcurrency: defaults.SnapshotRestoreConcurrency,
+			},
+		},
+		"set snapshot restore concurrency via opts": {
+			src: AppOptionsMock{
+				"wasm.snapshot_restore_concurrency": 8,
+			},
+			exp: types.WasmConfig{
+				MemoryCacheSize:            defaults.MemoryCacheSize,
+				SmartQueryGasLimit:         defaults.SmartQueryGasLimit,
+				SnapshotRestoreConcurrency: 8,
 			},
 		},
 		"set debug via opts": {
@@ -437,9 +450,21 @@ func TestReadWasmConfig(t *testing.T) {
 				"trace": true,
 			},
 			exp: types.WasmConfig{
-				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
-				MemoryCacheSize:    defaults.MemoryCacheSize,
-				ContractDebugMode:  true,
+				SmartQueryGasLimit:         defaults.SmartQueryGasLimit,
+				MemoryCacheSize:            defaults.MemoryCacheSize,
+				ContractDebugMode:          true,
+				SnapshotRestoreConcurrency: defaults.SnapshotRestoreConcurrency,
+			},
+		},
+		"set genesis stream file via opts": {
+			src: AppOptionsMock{
+				"wasm.genesis_stream_file": "/tmp/wasm_genesis.bin",
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:         defaults.SmartQueryGasLimit,
+				MemoryCacheSize:            defaults.MemoryCacheSize,
+				SnapshotRestoreConcurrency: defaults.SnapshotRestoreConcurrency,
+				GenesisStreamFile:          "/tmp/wasm_genesis.bin",
 			},
 		},
 		"all defaults when no options set": {
@@ -455,6 +480,80 @@ func TestReadWasmConfig(t *testing.T) {
 	}
 }
 
+func TestConfigTemplate(t *testing.T) {
+	custom := DefaultWasmConfig()
+	custom.SmartQueryGasLimit = 1
+	custom.MemoryCacheSize = 2
+	custom.SnapshotRestoreConcurrency = 8
+	specs := map[string]struct {
+		src string
+		exp types.WasmConfig
+	}{
+		"defaults": {
+			src: types.DefaultConfigTemplate(),
+			exp: DefaultWasmConfig(),
+		},
+		"custom values": {
+			src: types.ConfigTemplate(custom),
+			exp: custom,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			v := viper.New()
+			v.SetConfigType("toml")
+			require.NoError(t, v.ReadConfig(strings.NewReader(spec.src)))
+			got, err := ReadWasmConfig(v)
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestReadGenesisExportFilter(t *testing.T) {
+	myContract := keeper.RandomAccountAddress(t)
+	specs := map[string]struct {
+		src    AppOptionsMock
+		exp    keeper.GenesisExportFilter
+		expErr bool
+	}{
+		"exclude state for code": {
+			src: AppOptionsMock{"wasm-exclude-state-for-code": []string{"1", "3"}},
+			exp: keeper.GenesisExportFilter{ExcludeStateForCodes: []uint64{1, 3}},
+		},
+		"only contracts": {
+			src: AppOptionsMock{"wasm-only-contracts": []string{myContract.String()}},
+			exp: keeper.GenesisExportFilter{OnlyContracts: []sdk.AccAddress{myContract}},
+		},
+		"strip code bytes": {
+			src: AppOptionsMock{"wasm-strip-code-bytes": true},
+			exp: keeper.GenesisExportFilter{StripCodeBytes: true},
+		},
+		"no options set": {
+			exp: keeper.GenesisExportFilter{},
+		},
+		"invalid code id": {
+			src:    AppOptionsMock{"wasm-exclude-state-for-code": []string{"foo"}},
+			expErr: true,
+		},
+		"invalid contract address": {
+			src:    AppOptionsMock{"wasm-only-contracts": []string{"foo"}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := ReadGenesisExportFilter(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 type AppOptionsMock map[string]interface{}
 
 func (a AppOptionsMock) Get(s string) interface{} {
@@ -605,6 +704,11 @@ func TestCheckLibwasmVersion(t *testing.T) {
 	assert.Contains(t, expected, got)
 }
 
+func TestLatestGenesisVersion(t *testing.T) {
+	// a new consensus version requires a genesis migration
+	assert.Equal(t, AppModule{}.ConsensusVersion(), types.LatestGenesisVersion)
+}
+
 func moduleBasePath(t *testing.T) string {
 	t.Helper()
 
@@ -1,28 +1,82 @@
 package simulation
 
 import (
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
 
+	"github.com/Finschia/wasmd/x/wasm/keeper"
+	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
 // RandomizedGenState generates a random GenesisState for wasm
 func RandomizedGenState(simstate *module.SimulationState) {
-	params := types.DefaultParams()
-	wasmGenesis := types.GenesisState{
-		Params:    params,
+	wasmGenesis := RandomGenesisState(simstate)
+
+	_, err := simstate.Cdc.MarshalJSON(&wasmGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	simstate.GenState[types.ModuleName] = simstate.Cdc.MustMarshalJSON(&wasmGenesis)
+}
+
+// RandomGenesisState generates a random GenesisState for wasm. The genesis messages store the reflect and the
+// hackatom code and instantiate a random number of reflect contracts that are owned by simulation accounts.
+func RandomGenesisState(simstate *module.SimulationState) types.GenesisState {
+	firstCodeID := simstate.Rand.Uint64() >> 1
+	return types.GenesisState{
+		Params:    types.DefaultParams(),
 		Codes:     nil,
 		Contracts: nil,
 		Sequences: []types.Sequence{
-			{IDKey: types.KeyLastCodeID, Value: simstate.Rand.Uint64()},
+			{IDKey: types.KeyLastCodeID, Value: firstCodeID},
 		},
-		GenMsgs: nil,
+		GenMsgs: randomGenesisMsgs(simstate, firstCodeID),
 	}
+}
 
-	_, err := simstate.Cdc.MarshalJSON(&wasmGenesis)
-	if err != nil {
-		panic(err)
+func randomGenesisMsgs(simstate *module.SimulationState, reflectCodeID uint64) []types.GenesisState_GenMsgs {
+	creator, _ := simtypes.RandomAcc(simstate.Rand, simstate.Accounts)
+	msgs := []types.GenesisState_GenMsgs{
+		{Sum: &types.GenesisState_GenMsgs_StoreCode{StoreCode: &types.MsgStoreCode{
+			Sender:                creator.Address.String(),
+			WASMByteCode:          testdata.ReflectContractWasm(),
+			InstantiatePermission: &types.AllowEverybody,
+		}}},
+		{Sum: &types.GenesisState_GenMsgs_StoreCode{StoreCode: &types.MsgStoreCode{
+			Sender:                creator.Address.String(),
+			WASMByteCode:          testdata.HackatomContractWasm(),
+			InstantiatePermission: &types.AllowEverybody,
+		}}},
 	}
+	for i := simtypes.RandIntBetween(simstate.Rand, 1, 6); i > 0; i-- {
+		sender, _ := simtypes.RandomAcc(simstate.Rand, simstate.Accounts)
+		admin, _ := simtypes.RandomAcc(simstate.Rand, simstate.Accounts)
+		msgs = append(msgs, types.GenesisState_GenMsgs{
+			Sum: &types.GenesisState_GenMsgs_InstantiateContract{InstantiateContract: &types.MsgInstantiateContract{
+				Sender: sender.Address.String(),
+				Admin:  admin.Address.String(),
+				CodeID: reflectCodeID,
+				Label:  simtypes.RandStringOfLength(simstate.Rand, 10),
+				Msg:    []byte("{}"),
+			}},
+		})
+	}
+	return msgs
+}
 
-	simstate.GenState[types.ModuleName] = simstate.Cdc.MustMarshalJSON(&wasmGenesis)
+// GenesisContractAddresses returns the addresses of the contracts that are instantiated by the genesis messages
+// of the given GenesisState.
+func GenesisContractAddresses(state types.GenesisState) []sdk.AccAddress {
+	var r []sdk.AccAddress
+	for _, m := range state.GenMsgs {
+		msg := m.GetInstantiateContract()
+		if msg == nil {
+			continue
+		}
+		r = append(r, keeper.BuildContractAddressClassic(msg.CodeID, uint64(len(r)+1)))
+	}
+	return r
 }
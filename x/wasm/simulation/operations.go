@@ -1,6 +1,8 @@
 package simulation
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"math/rand"
 	"os"
@@ -23,10 +25,14 @@ import (
 //
 //nolint:gosec
 const (
-	OpWeightMsgStoreCode           = "op_weight_msg_store_code"
-	OpWeightMsgInstantiateContract = "op_weight_msg_instantiate_contract"
-	OpWeightMsgExecuteContract     = "op_weight_msg_execute_contract"
-	OpReflectContractPath          = "op_reflect_contract_path"
+	OpWeightMsgStoreCode            = "op_weight_msg_store_code"
+	OpWeightMsgInstantiateContract  = "op_weight_msg_instantiate_contract"
+	OpWeightMsgInstantiateContract2 = "op_weight_msg_instantiate_contract2"
+	OpWeightMsgExecuteContract      = "op_weight_msg_execute_contract"
+	OpWeightMsgMigrateContract      = "op_weight_msg_migrate_contract"
+	OpWeightMsgUpdateAdmin          = "op_weight_msg_update_admin"
+	OpWeightMsgClearAdmin           = "op_weight_msg_clear_admin"
+	OpReflectContractPath           = "op_reflect_contract_path"
 )
 
 // WasmKeeper is a subset of the wasm keeper used by simulations
@@ -34,6 +40,9 @@ type WasmKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	IterateCodeInfos(ctx sdk.Context, cb func(uint64, types.CodeInfo) bool)
 	IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+	GetCodeInfo(ctx sdk.Context, codeID uint64) *types.CodeInfo
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
+	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 	PeekAutoIncrementID(ctx sdk.Context, lastIDKey []byte) uint64
 }
@@ -50,10 +59,14 @@ func WeightedOperations(
 	wasmKeeper WasmKeeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgStoreCode           int
-		weightMsgInstantiateContract int
-		weightMsgExecuteContract     int
-		wasmContractPath             string
+		weightMsgStoreCode            int
+		weightMsgInstantiateContract  int
+		weightMsgInstantiateContract2 int
+		weightMsgExecuteContract      int
+		weightMsgMigrateContract      int
+		weightMsgUpdateAdmin          int
+		weightMsgClearAdmin           int
+		wasmContractPath              string
 	)
 
 	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgStoreCode, &weightMsgStoreCode, nil,
@@ -67,11 +80,31 @@ func WeightedOperations(
 			weightMsgInstantiateContract = params.DefaultWeightMsgInstantiateContract
 		},
 	)
-	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgExecuteContract, &weightMsgInstantiateContract, nil,
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgInstantiateContract2, &weightMsgInstantiateContract2, nil,
+		func(_ *rand.Rand) {
+			weightMsgInstantiateContract2 = params.DefaultWeightMsgInstantiateContract2
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgExecuteContract, &weightMsgExecuteContract, nil,
 		func(_ *rand.Rand) {
 			weightMsgExecuteContract = params.DefaultWeightMsgExecuteContract
 		},
 	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgMigrateContract, &weightMsgMigrateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgMigrateContract = params.DefaultWeightMsgMigrateContract
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgUpdateAdmin, &weightMsgUpdateAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateAdmin = params.DefaultWeightMsgUpdateAdmin
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgClearAdmin, &weightMsgClearAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgClearAdmin = params.DefaultWeightMsgClearAdmin
+		},
+	)
 	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpReflectContractPath, &wasmContractPath, nil,
 		func(_ *rand.Rand) {
 			wasmContractPath = ""
@@ -109,6 +142,29 @@ func WeightedOperations(
 				DefaultSimulationExecutePayloader,
 			),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgInstantiateContract2,
+			SimulateMsgInstantiateContract2(ak, bk, wasmKeeper, DefaultSimulationInstantiate2CodeIDSelector, DefaultSimulationInstantiate2Payloader),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMigrateContract,
+			SimulateMsgMigrateContract(
+				ak,
+				bk,
+				wasmKeeper,
+				DefaultSimulationMigrateContractSelector,
+				DefaultSimulationMigrateCodeIDSelector,
+				DefaultSimulationMigratePayloader,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateAdmin,
+			SimulateMsgUpdateAdmin(ak, bk, wasmKeeper, DefaultSimulationContractWithAdminSelector),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClearAdmin,
+			SimulateMsgClearAdmin(ak, bk, wasmKeeper, DefaultSimulationContractWithAdminSelector),
+		),
 	}
 }
 
@@ -143,19 +199,54 @@ func SimulateMsgStoreCode(ak types.AccountKeeper, bk BankKeeper, wasmKeeper Wasm
 // CodeIDSelector returns code id to be used in simulations
 type CodeIDSelector = func(ctx sdk.Context, wasmKeeper WasmKeeper) uint64
 
-// DefaultSimulationCodeIDSelector picks the first code id
+// DefaultSimulationCodeIDSelector picks the first code id that is not a hackatom code
 func DefaultSimulationCodeIDSelector(ctx sdk.Context, wasmKeeper WasmKeeper) uint64 {
-	var codeID uint64
+	codeID, _ := FindCode(ctx, wasmKeeper, func(_ uint64, info types.CodeInfo) bool {
+		return info.InstantiateConfig.Permission == types.AccessTypeEverybody && !IsHackatomCode(info)
+	})
+	return codeID
+}
+
+// FindCode returns the first code that matches the given filter or 0 when none does
+func FindCode(ctx sdk.Context, wasmKeeper WasmKeeper, filter func(uint64, types.CodeInfo) bool) (uint64, types.CodeInfo) {
+	var (
+		codeID   uint64
+		codeInfo types.CodeInfo
+	)
 	wasmKeeper.IterateCodeInfos(ctx, func(u uint64, info types.CodeInfo) bool {
-		if info.InstantiateConfig.Permission != types.AccessTypeEverybody {
+		if !filter(u, info) {
 			return false
 		}
-		codeID = u
+		codeID, codeInfo = u, info
 		return true
 	})
-	return codeID
+	return codeID, codeInfo
+}
+
+// FindContract returns the first contract that matches the given filter or nil when none does
+func FindContract(ctx sdk.Context, wasmKeeper WasmKeeper, filter func(sdk.AccAddress, types.ContractInfo) bool) (sdk.AccAddress, types.ContractInfo) {
+	var (
+		contractAddr sdk.AccAddress
+		contractInfo types.ContractInfo
+	)
+	wasmKeeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+		if !filter(addr, info) {
+			return false
+		}
+		contractAddr, contractInfo = addr, info
+		return true
+	})
+	return contractAddr, contractInfo
 }
 
+// IsHackatomCode returns true when the code is the hackatom contract that is used for the migrate and sudo
+// simulations
+func IsHackatomCode(info types.CodeInfo) bool {
+	return bytes.Equal(info.CodeHash, hackatomChecksum[:])
+}
+
+var hackatomChecksum = sha256.Sum256(testdata.HackatomContractWasm())
+
 // SimulateMsgInstantiateContract generates a MsgInstantiateContract with random values
 func SimulateMsgInstantiateContract(ak types.AccountKeeper, bk BankKeeper, wasmKeeper WasmKeeper, codeSelector CodeIDSelector) simtypes.Operation {
 	return func(
@@ -179,9 +270,10 @@ func SimulateMsgInstantiateContract(ak types.AccountKeeper, bk BankKeeper, wasmK
 			}
 		}
 
+		adminAccount, _ := simtypes.RandomAcc(r, accs)
 		msg := types.MsgInstantiateContract{
 			Sender: simAccount.Address.String(),
-			Admin:  simtypes.RandomAccounts(r, 1)[0].Address.String(),
+			Admin:  adminAccount.Address.String(),
 			CodeID: codeID,
 			Label:  simtypes.RandStringOfLength(r, 10),
 			Msg:    []byte(`{}`),
@@ -250,6 +342,232 @@ func SimulateMsgExecuteContract(
 	}
 }
 
+// MsgInstantiate2Payloader extension point to modify msg with custom payload
+type MsgInstantiate2Payloader func(r *rand.Rand, msg *types.MsgInstantiateContract2, accs []simtypes.Account) error
+
+// SimulateMsgInstantiateContract2 generates a MsgInstantiateContract2 with random values and a random salt
+func SimulateMsgInstantiateContract2(
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	wasmKeeper WasmKeeper,
+	codeSelector CodeIDSelector,
+	payloader MsgInstantiate2Payloader,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		codeID := codeSelector(ctx, wasmKeeper)
+		if codeID == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgInstantiateContract2{}.Type(), "no codes with permission available"), nil, nil
+		}
+		deposit := sdk.Coins{}
+		spendableCoins := bk.SpendableCoins(ctx, simAccount.Address)
+		for _, v := range spendableCoins {
+			if bk.IsSendEnabledCoin(ctx, v) {
+				deposit = deposit.Add(simtypes.RandSubsetCoins(r, sdk.NewCoins(v))...)
+			}
+		}
+
+		adminAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.MsgInstantiateContract2{
+			Sender: simAccount.Address.String(),
+			Admin:  adminAccount.Address.String(),
+			CodeID: codeID,
+			Label:  simtypes.RandStringOfLength(r, 10),
+			Funds:  deposit,
+			Salt:   []byte(simtypes.RandStringOfLength(r, 16)),
+			FixMsg: r.Intn(2) == 0,
+		}
+		if err := payloader(r, &msg, accs); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgInstantiateContract2{}.Type(), "contract instantiate payload"), nil, err
+		}
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, deposit)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// DefaultSimulationInstantiate2CodeIDSelector picks the first hackatom code that everybody can instantiate
+func DefaultSimulationInstantiate2CodeIDSelector(ctx sdk.Context, wasmKeeper WasmKeeper) uint64 {
+	codeID, _ := FindCode(ctx, wasmKeeper, func(_ uint64, info types.CodeInfo) bool {
+		return info.InstantiateConfig.Permission == types.AccessTypeEverybody && IsHackatomCode(info)
+	})
+	return codeID
+}
+
+// DefaultSimulationInstantiate2Payloader sets the hackatom init msg with random simulation accounts
+func DefaultSimulationInstantiate2Payloader(r *rand.Rand, msg *types.MsgInstantiateContract2, accs []simtypes.Account) error {
+	verifier, _ := simtypes.RandomAcc(r, accs)
+	beneficiary, _ := simtypes.RandomAcc(r, accs)
+	bz, err := json.Marshal(testdata.HackatomInitMsg{
+		Verifier:    verifier.Address.String(),
+		Beneficiary: beneficiary.Address.String(),
+	})
+	if err != nil {
+		return err
+	}
+	msg.Msg = bz
+	return nil
+}
+
+// MsgMigrateContractSelector returns the contract address and info to be used in migrate simulations
+type MsgMigrateContractSelector = func(ctx sdk.Context, wasmKeeper WasmKeeper) (sdk.AccAddress, types.ContractInfo)
+
+// MsgMigrateCodeIDSelector returns the code id to migrate the contract to
+type MsgMigrateCodeIDSelector = func(ctx sdk.Context, wasmKeeper WasmKeeper, contractInfo types.ContractInfo) uint64
+
+// MsgMigratePayloader extension point to modify msg with custom payload
+type MsgMigratePayloader func(msg *types.MsgMigrateContract) error
+
+// SimulateMsgMigrateContract generates a MsgMigrateContract that is signed by the contract admin
+func SimulateMsgMigrateContract(
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	wasmKeeper WasmKeeper,
+	contractSelector MsgMigrateContractSelector,
+	codeIDSelector MsgMigrateCodeIDSelector,
+	payloader MsgMigratePayloader,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractAddr, contractInfo := contractSelector(ctx, wasmKeeper)
+		if contractAddr == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgMigrateContract{}.Type(), "no contract instance available"), nil, nil
+		}
+		simAccount, ok := simtypes.FindAccount(accs, contractInfo.AdminAddr())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgMigrateContract{}.Type(), "unknown contract admin address"), nil, nil
+		}
+		codeID := codeIDSelector(ctx, wasmKeeper, contractInfo)
+		if codeID == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgMigrateContract{}.Type(), "no target code available"), nil, nil
+		}
+		if codeInfo := wasmKeeper.GetCodeInfo(ctx, codeID); codeInfo == nil || !codeInfo.InstantiateConfig.Allowed(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgMigrateContract{}.Type(), "no permission for target code"), nil, nil
+		}
+
+		msg := types.MsgMigrateContract{
+			Sender:   simAccount.Address.String(),
+			Contract: contractAddr.String(),
+			CodeID:   codeID,
+		}
+		if err := payloader(&msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgMigrateContract{}.Type(), "contract migrate payload"), nil, err
+		}
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, nil)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// DefaultSimulationMigrateContractSelector picks the first hackatom contract with an admin
+func DefaultSimulationMigrateContractSelector(ctx sdk.Context, wasmKeeper WasmKeeper) (sdk.AccAddress, types.ContractInfo) {
+	return FindContract(ctx, wasmKeeper, func(_ sdk.AccAddress, info types.ContractInfo) bool {
+		codeInfo := wasmKeeper.GetCodeInfo(ctx, info.CodeID)
+		return info.Admin != "" && codeInfo != nil && IsHackatomCode(*codeInfo)
+	})
+}
+
+// DefaultSimulationMigrateCodeIDSelector picks the first code with the same checksum as the current contract code
+func DefaultSimulationMigrateCodeIDSelector(ctx sdk.Context, wasmKeeper WasmKeeper, contractInfo types.ContractInfo) uint64 {
+	current := wasmKeeper.GetCodeInfo(ctx, contractInfo.CodeID)
+	if current == nil {
+		return 0
+	}
+	codeID, _ := FindCode(ctx, wasmKeeper, func(_ uint64, info types.CodeInfo) bool {
+		return bytes.Equal(info.CodeHash, current.CodeHash)
+	})
+	return codeID
+}
+
+// DefaultSimulationMigratePayloader sets the hackatom migrate msg with the sender as new verifier
+func DefaultSimulationMigratePayloader(msg *types.MsgMigrateContract) error {
+	bz, err := json.Marshal(testdata.HackatomMigrateMsg{Verifier: msg.Sender})
+	if err != nil {
+		return err
+	}
+	msg.Msg = bz
+	return nil
+}
+
+// MsgContractAdminSelector returns the contract address and info to be used in admin simulations
+type MsgContractAdminSelector = func(ctx sdk.Context, wasmKeeper WasmKeeper) (sdk.AccAddress, types.ContractInfo)
+
+// SimulateMsgUpdateAdmin generates a MsgUpdateAdmin that sets a random simulation account as new admin
+func SimulateMsgUpdateAdmin(ak types.AccountKeeper, bk BankKeeper, wasmKeeper WasmKeeper, contractSelector MsgContractAdminSelector) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractAddr, contractInfo := contractSelector(ctx, wasmKeeper)
+		if contractAddr == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgUpdateAdmin{}.Type(), "no contract instance available"), nil, nil
+		}
+		simAccount, ok := simtypes.FindAccount(accs, contractInfo.AdminAddr())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgUpdateAdmin{}.Type(), "unknown contract admin address"), nil, nil
+		}
+		newAdmin, _ := simtypes.RandomAcc(r, accs)
+		if newAdmin.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgUpdateAdmin{}.Type(), "new admin is the current admin"), nil, nil
+		}
+
+		msg := types.MsgUpdateAdmin{
+			Sender:   simAccount.Address.String(),
+			NewAdmin: newAdmin.Address.String(),
+			Contract: contractAddr.String(),
+		}
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, nil)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgClearAdmin generates a MsgClearAdmin that is signed by the contract admin
+func SimulateMsgClearAdmin(ak types.AccountKeeper, bk BankKeeper, wasmKeeper WasmKeeper, contractSelector MsgContractAdminSelector) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractAddr, contractInfo := contractSelector(ctx, wasmKeeper)
+		if contractAddr == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgClearAdmin{}.Type(), "no contract instance available"), nil, nil
+		}
+		simAccount, ok := simtypes.FindAccount(accs, contractInfo.AdminAddr())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgClearAdmin{}.Type(), "unknown contract admin address"), nil, nil
+		}
+
+		msg := types.MsgClearAdmin{
+			Sender:   simAccount.Address.String(),
+			Contract: contractAddr.String(),
+		}
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, nil)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// DefaultSimulationContractWithAdminSelector picks the first contract with an admin
+func DefaultSimulationContractWithAdminSelector(ctx sdk.Context, wasmKeeper WasmKeeper) (sdk.AccAddress, types.ContractInfo) {
+	return FindContract(ctx, wasmKeeper, func(_ sdk.AccAddress, info types.ContractInfo) bool {
+		return info.Admin != ""
+	})
+}
+
 // BuildOperationInput helper to build object
 func BuildOperationInput(
 	r *rand.Rand,
@@ -280,12 +598,11 @@ func BuildOperationInput(
 	}
 }
 
-// DefaultSimulationExecuteContractSelector picks the first contract address
+// DefaultSimulationExecuteContractSelector picks the first contract address that is not a hackatom contract
 func DefaultSimulationExecuteContractSelector(ctx sdk.Context, wasmKeeper WasmKeeper) sdk.AccAddress {
-	var r sdk.AccAddress
-	wasmKeeper.IterateContractInfo(ctx, func(address sdk.AccAddress, info types.ContractInfo) bool {
-		r = address
-		return true
+	r, _ := FindContract(ctx, wasmKeeper, func(_ sdk.AccAddress, info types.ContractInfo) bool {
+		codeInfo := wasmKeeper.GetCodeInfo(ctx, info.CodeID)
+		return codeInfo != nil && !IsHackatomCode(*codeInfo)
 	})
 	return r
 }
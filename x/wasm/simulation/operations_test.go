@@ -50,6 +50,21 @@ func TestWeightedOperations(t *testing.T) {
 					SimulateMsgExecuteContract(params.ak, params.bk, params.wasmKeeper,
 						DefaultSimulationExecuteContractSelector, DefaultSimulationExecuteSenderSelector,
 						DefaultSimulationExecutePayloader)),
+				simulation.NewWeightedOperation(
+					wasmappparams.DefaultWeightMsgInstantiateContract2,
+					SimulateMsgInstantiateContract2(params.ak, params.bk, params.wasmKeeper,
+						DefaultSimulationInstantiate2CodeIDSelector, DefaultSimulationInstantiate2Payloader)),
+				simulation.NewWeightedOperation(
+					wasmappparams.DefaultWeightMsgMigrateContract,
+					SimulateMsgMigrateContract(params.ak, params.bk, params.wasmKeeper,
+						DefaultSimulationMigrateContractSelector, DefaultSimulationMigrateCodeIDSelector,
+						DefaultSimulationMigratePayloader)),
+				simulation.NewWeightedOperation(
+					wasmappparams.DefaultWeightMsgUpdateAdmin,
+					SimulateMsgUpdateAdmin(params.ak, params.bk, params.wasmKeeper, DefaultSimulationContractWithAdminSelector)),
+				simulation.NewWeightedOperation(
+					wasmappparams.DefaultWeightMsgClearAdmin,
+					SimulateMsgClearAdmin(params.ak, params.bk, params.wasmKeeper, DefaultSimulationContractWithAdminSelector)),
 			},
 		},
 	}
@@ -57,6 +72,7 @@ func TestWeightedOperations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := WeightedOperations(tt.args.simstate, tt.args.ak, tt.args.bk, tt.args.wasmKeeper)
+			require.Len(t, got, len(tt.want))
 			for i := range got {
 				require.Equal(t, tt.want[i].Weight(), got[i].Weight(), "WeightedOperations().Weight()")
 
@@ -0,0 +1,166 @@
+package simulation
+
+import (
+	"encoding/json"
+	"math/rand"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+
+	"github.com/Finschia/wasmd/app/params"
+	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
+	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// Simulation proposal weights constants
+//
+//nolint:gosec
+const (
+	OpWeightPinCodesProposal                = "op_weight_pin_codes_proposal"
+	OpWeightUnpinCodesProposal              = "op_weight_unpin_codes_proposal"
+	OpWeightSudoContractProposal            = "op_weight_sudo_contract_proposal"
+	OpWeightUpdateInstantiateConfigProposal = "op_weight_update_instantiate_config_proposal"
+)
+
+// ProposalContents returns all the wasm content functions used to simulate governance proposals
+func ProposalContents(bk BankKeeper, wasmKeeper WasmKeeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightPinCodesProposal,
+			params.DefaultWeightPinCodesProposal,
+			SimulatePinCodesProposal(wasmKeeper),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightUnpinCodesProposal,
+			params.DefaultWeightUnpinCodesProposal,
+			SimulateUnpinCodesProposal(wasmKeeper),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSudoContractProposal,
+			params.DefaultWeightSudoContractProposal,
+			SimulateSudoContractProposal(bk, wasmKeeper, DefaultSimulationSudoContractSelector),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightUpdateInstantiateConfigProposal,
+			params.DefaultWeightUpdateInstantiateConfigProposal,
+			SimulateUpdateInstantiateConfigProposal(wasmKeeper),
+		),
+	}
+}
+
+// SimulatePinCodesProposal generates a PinCodesProposal for a random unpinned code
+func SimulatePinCodesProposal(wasmKeeper WasmKeeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		codeID := RandomCodeID(r, ctx, wasmKeeper, func(codeID uint64, _ types.CodeInfo) bool {
+			return !wasmKeeper.IsPinnedCode(ctx, codeID)
+		})
+		if codeID == 0 {
+			return nil
+		}
+		return &types.PinCodesProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			CodeIDs:     []uint64{codeID},
+		}
+	}
+}
+
+// SimulateUnpinCodesProposal generates an UnpinCodesProposal for a random pinned code
+func SimulateUnpinCodesProposal(wasmKeeper WasmKeeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		codeID := RandomCodeID(r, ctx, wasmKeeper, func(codeID uint64, _ types.CodeInfo) bool {
+			return wasmKeeper.IsPinnedCode(ctx, codeID)
+		})
+		if codeID == 0 {
+			return nil
+		}
+		return &types.UnpinCodesProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			CodeIDs:     []uint64{codeID},
+		}
+	}
+}
+
+// SudoContractSelector returns the contract address to be used in sudo simulations
+type SudoContractSelector = func(ctx sdk.Context, wasmKeeper WasmKeeper, bk BankKeeper) sdk.AccAddress
+
+// SimulateSudoContractProposal generates a SudoContractProposal that sends a random subset of the hackatom
+// contract funds to a random simulation account
+func SimulateSudoContractProposal(bk BankKeeper, wasmKeeper WasmKeeper, contractSelector SudoContractSelector) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		contractAddr := contractSelector(ctx, wasmKeeper, bk)
+		if contractAddr == nil {
+			return nil
+		}
+		amount := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, contractAddr))
+		if amount.IsZero() {
+			return nil
+		}
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg, err := json.Marshal(testdata.HackatomSudoMsg{
+			StealFunds: &testdata.StealFundsPayload{
+				Recipient: recipient.Address.String(),
+				Amount:    wasmkeeper.ConvertSdkCoinsToWasmCoins(amount),
+			},
+		})
+		if err != nil {
+			panic(err)
+		}
+		return &types.SudoContractProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			Contract:    contractAddr.String(),
+			Msg:         msg,
+		}
+	}
+}
+
+// DefaultSimulationSudoContractSelector picks the first hackatom contract with spendable funds
+func DefaultSimulationSudoContractSelector(ctx sdk.Context, wasmKeeper WasmKeeper, bk BankKeeper) sdk.AccAddress {
+	r, _ := FindContract(ctx, wasmKeeper, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+		codeInfo := wasmKeeper.GetCodeInfo(ctx, info.CodeID)
+		return codeInfo != nil && IsHackatomCode(*codeInfo) && !bk.SpendableCoins(ctx, addr).IsZero()
+	})
+	return r
+}
+
+// SimulateUpdateInstantiateConfigProposal generates an UpdateInstantiateConfigProposal for a random code that
+// either allows everybody or a random simulation account to instantiate
+func SimulateUpdateInstantiateConfigProposal(wasmKeeper WasmKeeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		codeID := RandomCodeID(r, ctx, wasmKeeper, func(uint64, types.CodeInfo) bool { return true })
+		if codeID == 0 {
+			return nil
+		}
+		config := types.AllowEverybody
+		if r.Intn(2) == 0 {
+			simAccount, _ := simtypes.RandomAcc(r, accs)
+			config = types.AccessTypeAnyOfAddresses.With(simAccount.Address)
+		}
+		return &types.UpdateInstantiateConfigProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			AccessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: codeID, InstantiatePermission: config},
+			},
+		}
+	}
+}
+
+// RandomCodeID returns a random code id of the codes that match the given filter or 0 when none does
+func RandomCodeID(r *rand.Rand, ctx sdk.Context, wasmKeeper WasmKeeper, filter func(uint64, types.CodeInfo) bool) uint64 {
+	var codeIDs []uint64
+	wasmKeeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		if filter(codeID, info) {
+			codeIDs = append(codeIDs, codeID)
+		}
+		return false
+	})
+	if len(codeIDs) == 0 {
+		return 0
+	}
+	return codeIDs[r.Intn(len(codeIDs))]
+}
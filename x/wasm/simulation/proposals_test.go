@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+
+	"github.com/Finschia/wasmd/x/wasm/keeper"
+	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestProposalContents(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, SupportedFeatures)
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+	wasmKeeper, bk := keepers.WasmKeeper, keepers.BankKeeper
+
+	// no codes, no contents
+	for _, c := range ProposalContents(bk, wasmKeeper) {
+		assert.Nil(t, c.ContentSimulatorFn()(r, ctx, accs), c.AppParamsKey())
+	}
+
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1000))
+	codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	initMsg, err := json.Marshal(testdata.HackatomInitMsg{Verifier: creator.String(), Beneficiary: creator.String()})
+	require.NoError(t, err)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, creator, initMsg, "label", sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+	require.NoError(t, err)
+
+	// pin
+	content := SimulatePinCodesProposal(wasmKeeper)(r, ctx, accs)
+	require.NoError(t, content.ValidateBasic())
+	assert.Equal(t, []uint64{codeID}, content.(*types.PinCodesProposal).CodeIDs)
+	assert.Nil(t, SimulateUnpinCodesProposal(wasmKeeper)(r, ctx, accs))
+
+	// unpin
+	require.NoError(t, keepers.ContractKeeper.PinCode(ctx, codeID))
+	assert.Nil(t, SimulatePinCodesProposal(wasmKeeper)(r, ctx, accs))
+	content = SimulateUnpinCodesProposal(wasmKeeper)(r, ctx, accs)
+	require.NoError(t, content.ValidateBasic())
+	assert.Equal(t, []uint64{codeID}, content.(*types.UnpinCodesProposal).CodeIDs)
+
+	// sudo
+	content = SimulateSudoContractProposal(bk, wasmKeeper, DefaultSimulationSudoContractSelector)(r, ctx, accs)
+	require.NoError(t, content.ValidateBasic())
+	sudoProposal := content.(*types.SudoContractProposal)
+	assert.Equal(t, contractAddr.String(), sudoProposal.Contract)
+	_, err = keepers.ContractKeeper.Sudo(ctx, contractAddr, sudoProposal.Msg)
+	require.NoError(t, err)
+
+	// update instantiate config
+	content = SimulateUpdateInstantiateConfigProposal(wasmKeeper)(r, ctx, accs)
+	require.NoError(t, content.ValidateBasic())
+	assert.Equal(t, codeID, content.(*types.UpdateInstantiateConfigProposal).AccessConfigUpdates[0].CodeID)
+}
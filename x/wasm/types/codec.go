@@ -21,6 +21,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	legacy.RegisterAminoMsg(cdc, &MsgMigrateContract{}, "wasm/MsgMigrateContract")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin")
 	legacy.RegisterAminoMsg(cdc, &MsgClearAdmin{}, "wasm/MsgClearAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeAdmin{}, "wasm/MsgProposeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptAdmin{}, "wasm/MsgAcceptAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelAdminProposal{}, "wasm/MsgCancelAdminProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgSetContractTimelock{}, "wasm/MsgSetContractTimelock")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelTimelockedOperation{}, "wasm/MsgCancelTimelockedOperation")
+	legacy.RegisterAminoMsg(cdc, &MsgMakeContractImmutable{}, "wasm/MsgMakeContractImmutable")
+	legacy.RegisterAminoMsg(cdc, &MsgVerifyCode{}, "wasm/MsgVerifyCode")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCodeStatus{}, "wasm/MsgSetCodeStatus")
+	legacy.RegisterAminoMsg(cdc, &MsgPruneCodes{}, "wasm/MsgPruneCodes")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMigrationPolicy{}, "wasm/MsgSetMigrationPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgIBCSend{}, "wasm/MsgIBCSend")
 	legacy.RegisterAminoMsg(cdc, &MsgIBCCloseChannel{}, "wasm/MsgIBCCloseChannel")
 
@@ -34,6 +44,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	cdc.RegisterConcrete(&UpdateAdminProposal{}, "wasm/UpdateAdminProposal", nil)
 	cdc.RegisterConcrete(&ClearAdminProposal{}, "wasm/ClearAdminProposal", nil)
 	cdc.RegisterConcrete(&UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal", nil)
+	cdc.RegisterConcrete(&RegisterBlockHookProposal{}, "wasm/RegisterBlockHookProposal", nil)
+	cdc.RegisterConcrete(&DeregisterBlockHookProposal{}, "wasm/DeregisterBlockHookProposal", nil)
+	cdc.RegisterConcrete(&SetCodeStatusProposal{}, "wasm/SetCodeStatusProposal", nil)
+	cdc.RegisterConcrete(&PruneCodesProposal{}, "wasm/PruneCodesProposal", nil)
+	cdc.RegisterConcrete(&SetCodeStateLimitProposal{}, "wasm/SetCodeStateLimitProposal", nil)
+	cdc.RegisterConcrete(&SetMigrationPolicyProposal{}, "wasm/SetMigrationPolicyProposal", nil)
+	cdc.RegisterConcrete(&SetIBCRateLimitProposal{}, "wasm/SetIBCRateLimitProposal", nil)
+	cdc.RegisterConcrete(&SetContractAnteHookProposal{}, "wasm/SetContractAnteHookProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -46,6 +64,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgMigrateContract{},
 		&MsgUpdateAdmin{},
 		&MsgClearAdmin{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelAdminProposal{},
+		&MsgSetContractTimelock{},
+		&MsgCancelTimelockedOperation{},
+		&MsgMakeContractImmutable{},
+		&MsgVerifyCode{},
+		&MsgSetCodeStatus{},
+		&MsgPruneCodes{},
+		&MsgSetMigrationPolicy{},
 		&MsgIBCCloseChannel{},
 		&MsgIBCSend{},
 	)
@@ -61,6 +89,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&PinCodesProposal{},
 		&UnpinCodesProposal{},
 		&UpdateInstantiateConfigProposal{},
+		&RegisterBlockHookProposal{},
+		&DeregisterBlockHookProposal{},
+		&SetCodeStatusProposal{},
+		&PruneCodesProposal{},
+		&SetCodeStateLimitProposal{},
+		&SetMigrationPolicyProposal{},
+		&SetIBCRateLimitProposal{},
+		&SetContractAnteHookProposal{},
 	)
 
 	registry.RegisterInterface("ContractInfoExtension", (*ContractInfoExtension)(nil))
@@ -88,6 +88,30 @@ var (
 
 	// ErrExceedMaxQueryStackSize error if max query stack size is exceeded
 	ErrExceedMaxQueryStackSize = sdkErrors.Register(DefaultCodespace, 27, "max query stack size exceeded")
+
+	// ErrIBCRateLimitExceeded error if a contract exceeds the raw IBC packet rate limit of a channel
+	ErrIBCRateLimitExceeded = sdkErrors.Register(DefaultCodespace, 28, "ibc rate limit exceeded")
+
+	// ErrCodeNotActive error if a deprecated or revoked code is used to instantiate or migrate a contract
+	ErrCodeNotActive = sdkErrors.Register(DefaultCodespace, 29, "code not active")
+
+	// ErrCodeInUse error if a code that is used by a contract or pinned is pruned
+	ErrCodeInUse = sdkErrors.Register(DefaultCodespace, 30, "code in use")
+
+	// ErrInsufficientStorageDeposit error if a contract can not pay the deposit for its state growth
+	ErrInsufficientStorageDeposit = sdkErrors.Register(DefaultCodespace, 31, "insufficient storage deposit")
+
+	// ErrContractStateLimit error if a write grows the contract state beyond the max state bytes
+	ErrContractStateLimit = sdkErrors.Register(DefaultCodespace, 32, "contract state limit exceeded")
+
+	// ErrMigrationNotAllowed error if the migration policy of the current code does not allow the new code
+	ErrMigrationNotAllowed = sdkErrors.Register(DefaultCodespace, 33, "migration not allowed")
+
+	// ErrPendingAdminExpired error if the proposed admin accepts after the expiry height
+	ErrPendingAdminExpired = sdkErrors.Register(DefaultCodespace, 34, "pending admin expired")
+
+	// ErrImmutableContract error if an immutable contract is migrated or its admin is changed
+	ErrImmutableContract = sdkErrors.Register(DefaultCodespace, 35, "immutable contract")
 )
 
 type ErrNoSuchContract struct {
@@ -17,6 +17,24 @@ const (
 	EventTypeGovContractResult      = "gov_contract_result"
 	EventTypeUpdateContractAdmin    = "update_contract_admin"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
+	EventTypeSponsorFees            = "sponsor_fees"
+	EventTypeRegisterBlockHook      = "register_block_hook"
+	EventTypeDeregisterBlockHook    = "deregister_block_hook"
+	EventTypeBlockHookFailed        = "block_hook_failed"
+	EventTypeUpdateCodeStatus       = "update_code_status"
+	EventTypePruneCode              = "prune_code"
+	EventTypeUpdateCodeStateLimit   = "update_code_state_limit"
+	EventTypeUpdateMigrationPolicy  = "update_migration_policy"
+	EventTypeProposeContractAdmin   = "propose_contract_admin"
+	EventTypeCancelContractAdmin    = "cancel_contract_admin_proposal"
+	EventTypeUpdateTimelock         = "update_contract_timelock"
+	EventTypeQueueOperation         = "queue_timelocked_operation"
+	EventTypeCancelOperation        = "cancel_timelocked_operation"
+	EventTypeOperationFailed        = "timelocked_operation_failed"
+	EventTypeMakeContractImmutable  = "make_contract_immutable"
+	EventTypeVerifyCode             = "verify_code"
+	EventTypeUpdateIBCRateLimit     = "update_ibc_rate_limit"
+	EventTypeUpdateContractAnteHook = "update_contract_ante_hook"
 )
 
 // event attributes returned from contract execution
@@ -31,4 +49,27 @@ const (
 	AttributeKeyNewAdmin            = "new_admin_address"
 	AttributeKeyCodePermission      = "code_permission"
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
+	AttributeKeyBlockHookPhase      = "phase"
+	AttributeKeyGasLimit            = "gas_limit"
+	AttributeKeyError               = "error"
+	AttributeKeyCodeStatus          = "code_status"
+	AttributeKeyStatusReason        = "reason"
+	AttributeKeySupersededByCodeID  = "superseded_by_code_id"
+	AttributeKeyMaxStateBytes       = "max_state_bytes"
+	AttributeKeyAllowedCodeIDs      = "allowed_code_ids"
+	AttributeKeyAllowedChecksums    = "allowed_checksums"
+	AttributeKeyDenyAll             = "deny_all"
+	AttributeKeyPendingAdmin        = "pending_admin_address"
+	AttributeKeyExpiresAtHeight     = "expires_at_height"
+	AttributeKeyDelayBlocks         = "delay_blocks"
+	AttributeKeyVerifier            = "verifier"
+	AttributeKeyOperationID         = "operation_id"
+	AttributeKeyOperationType       = "operation_type"
+	AttributeKeyExecuteAtHeight     = "execute_at_height"
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeyMaxPacketsPerBlock  = "max_packets_per_block"
+	AttributeKeyMaxBytesPerWindow   = "max_bytes_per_window"
+	AttributeKeyWindowBlocks        = "window_blocks"
+	AttributeKeyAnteHook            = "ante_hook"
+	AttributeKeyEnabled             = "enabled"
 )
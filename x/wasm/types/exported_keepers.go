@@ -21,6 +21,14 @@ type ViewKeeper interface {
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 	GetParams(ctx sdk.Context) Params
+	GetIBCRateLimit(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string) IBCRateLimit
+	GetIBCPacketUsage(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string) IBCPacketUsage
+	GetContractStateSize(ctx sdk.Context, contractAddr sdk.AccAddress) ContractStateSize
+	GetStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress) sdk.Coins
+	GetMaxContractStateBytes(ctx sdk.Context, codeID uint64) uint64
+	GetPendingAdmin(ctx sdk.Context, contractAddr sdk.AccAddress) *PendingAdmin
+	GetContractTimelock(ctx sdk.Context, contractAddr sdk.AccAddress) uint64
+	BuildPredictableAddress(addressGenerator string, checksum []byte, creator sdk.AccAddress, salt, initMsg []byte) (sdk.AccAddress, error)
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -38,7 +46,8 @@ type ContractOpsKeeper interface {
 		deposit sdk.Coins,
 	) (sdk.AccAddress, []byte, error)
 
-	// Instantiate2 creates an instance of a WASM contract using the predictable address generator
+	// Instantiate2 creates an instance of a WASM contract using the predictable address generator that is
+	// registered for the given name. The default generator is used for an empty name.
 	Instantiate2(
 		ctx sdk.Context,
 		codeID uint64,
@@ -48,6 +57,7 @@ type ContractOpsKeeper interface {
 		deposit sdk.Coins,
 		salt []byte,
 		fixMsg bool,
+		addressGenerator string,
 	) (sdk.AccAddress, []byte, error)
 
 	// Execute executes the contract instance
@@ -62,6 +72,24 @@ type ContractOpsKeeper interface {
 	// UpdateContractAdmin sets the admin value on the ContractInfo. It must be a valid address (use ClearContractAdmin to remove it)
 	UpdateContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) error
 
+	// ProposeContractAdmin proposes a new admin for the contract that has to accept it
+	ProposeContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress, expiresAtHeight uint64) error
+
+	// AcceptContractAdmin sets the caller as contract admin when it was proposed before
+	AcceptContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// CancelContractAdminProposal removes the proposed admin of the contract
+	CancelContractAdminProposal(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// SetContractTimelock sets the number of blocks that migrations and admin changes of the contract are queued
+	SetContractTimelock(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, delayBlocks uint64) error
+
+	// CancelTimelockedOperation removes a queued operation of the contract
+	CancelTimelockedOperation(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, operationID uint64) error
+
+	// MakeContractImmutable permanently disables migrations and admin changes of the contract
+	MakeContractImmutable(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
 	// ClearContractAdmin sets the admin value on the ContractInfo to nil, to disable further migrations/ updates.
 	ClearContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
 
@@ -76,6 +104,36 @@ type ContractOpsKeeper interface {
 
 	// SetAccessConfig updates the access config of a code id.
 	SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig AccessConfig) error
+
+	// RegisterBlockHook registers the contract to be called with sudo in every block of the given phase
+	RegisterBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress, phase BlockHookPhase, gasLimit uint64) error
+
+	// DeregisterBlockHook removes the block hook of the contract in the given phase
+	DeregisterBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress, phase BlockHookPhase) error
+
+	// SetCodeStatus updates the lifecycle status of a code id.
+	SetCodeStatus(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, status CodeStatus, reason string, supersededBy uint64) error
+
+	// PruneCode removes a code that is not used by any contract and not pinned
+	PruneCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error
+
+	// SetCodeStateLimit overrides the max contract state bytes param for the contracts of a code
+	SetCodeStateLimit(ctx sdk.Context, codeID, maxStateBytes uint64) error
+
+	// SetIBCRateLimit overrides the IBC rate limit param for a contract on a channel. A nil limit removes the override.
+	SetIBCRateLimit(ctx sdk.Context, contractAddr sdk.AccAddress, channelID string, limit *IBCRateLimit) error
+
+	// SetContractAnteHook enables or disables an ante hook for a contract
+	SetContractAnteHook(ctx sdk.Context, contractAddr sdk.AccAddress, hook AnteHook, enabled bool) error
+
+	// SetMigrationPolicy updates the codes that contracts of a code can be migrated to
+	SetMigrationPolicy(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, policy MigrationPolicy) error
+
+	// SetCodeBuildMetadata attaches the build metadata to a code. It can be set only once by the code creator.
+	SetCodeBuildMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata CodeBuildMetadata) error
+
+	// VerifyCode attests that the code matches a reproducible build of its build metadata
+	VerifyCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, checksum []byte) error
 }
 
 // IBCContractKeeper IBC lifecycle event handler
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"math"
+
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
@@ -32,6 +35,27 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	if err := ValidateBlockHooks(s.BlockHooks, s.Params.BlockHookMaxGasLimit); err != nil {
+		return err
+	}
+	if err := ValidateCodeStateLimits(s.CodeStateLimits); err != nil {
+		return err
+	}
+	if err := ValidatePendingAdmins(s.PendingAdmins); err != nil {
+		return err
+	}
+	if err := ValidateContractTimelocks(s.ContractTimelocks); err != nil {
+		return err
+	}
+	if err := ValidateTimelockedOperations(s.TimelockedOperations); err != nil {
+		return err
+	}
+	if err := ValidateIBCRateLimitOverrides(s.IBCRateLimitOverrides); err != nil {
+		return err
+	}
+	if err := ValidateContractAnteHooks(s.ContractAnteHooks); err != nil {
+		return err
+	}
 	for i := range s.GenMsgs {
 		if err := s.GenMsgs[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "gen message: %d", i)
@@ -40,6 +64,172 @@ func (s GenesisState) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBlockHooks validates the block hooks against the max gas limit and ensures that a contract is
+// registered only once per phase
+func ValidateBlockHooks(hooks []BlockHook, maxGasLimit uint64) error {
+	idx := make(map[string]struct{}, len(hooks))
+	for i, h := range hooks {
+		if err := h.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "block hook: %d", i)
+		}
+		if h.GasLimit > maxGasLimit {
+			return sdkerrors.Wrapf(ErrLimit, "block hook: %d: gas limit above %d", i, maxGasLimit)
+		}
+		key := string(GetBlockHookKey(h.Phase, sdk.MustAccAddressFromBech32(h.ContractAddress)))
+		if _, exists := idx[key]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "block hook: %d", i)
+		}
+		idx[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateCodeStateLimits validates the code state limits and ensures that a code has one limit only
+func ValidateCodeStateLimits(limits []CodeStateLimit) error {
+	idx := make(map[uint64]struct{}, len(limits))
+	for i, l := range limits {
+		if err := l.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "code state limit: %d", i)
+		}
+		if _, exists := idx[l.CodeID]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "code state limit: %d", i)
+		}
+		idx[l.CodeID] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation
+func (l CodeStateLimit) ValidateBasic() error {
+	if l.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if l.MaxStateBytes == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "max state bytes")
+	}
+	return nil
+}
+
+// ValidatePendingAdmins validates the pending admins and ensures that a contract has one pending admin only
+func ValidatePendingAdmins(pendingAdmins []PendingAdmin) error {
+	idx := make(map[string]struct{}, len(pendingAdmins))
+	for i, p := range pendingAdmins {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "pending admin: %d", i)
+		}
+		if _, exists := idx[p.ContractAddress]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "pending admin: %d", i)
+		}
+		idx[p.ContractAddress] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation
+func (p PendingAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.NewAdmin); err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	return nil
+}
+
+// IsExpired returns true when the new admin can not accept at the given block height anymore
+func (p PendingAdmin) IsExpired(height int64) bool {
+	return p.ExpiresAtHeight != 0 && uint64(height) >= p.ExpiresAtHeight
+}
+
+// ValidateContractTimelocks validates the contract timelocks and ensures that a contract has one timelock only
+func ValidateContractTimelocks(timelocks []ContractTimelock) error {
+	idx := make(map[string]struct{}, len(timelocks))
+	for i, l := range timelocks {
+		if err := l.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract timelock: %d", i)
+		}
+		if _, exists := idx[l.ContractAddress]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract timelock: %d", i)
+		}
+		idx[l.ContractAddress] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation
+func (l ContractTimelock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(l.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if l.DelayBlocks == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "delay blocks")
+	}
+	return ValidateTimelockDelay(l.DelayBlocks)
+}
+
+// ValidateTimelockDelay ensures that the delay added to a block height does not overflow
+func ValidateTimelockDelay(delayBlocks uint64) error {
+	if delayBlocks > math.MaxInt64 {
+		return sdkerrors.Wrapf(ErrLimit, "delay blocks must not be greater than %d", int64(math.MaxInt64))
+	}
+	return nil
+}
+
+// ValidateTimelockedOperations validates the queued operations and ensures that an operation id is used once only
+func ValidateTimelockedOperations(ops []TimelockedOperation) error {
+	idx := make(map[uint64]struct{}, len(ops))
+	for i, op := range ops {
+		if err := op.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "timelocked operation: %d", i)
+		}
+		if _, exists := idx[op.ID]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "timelocked operation: %d", i)
+		}
+		idx[op.ID] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation
+func (op TimelockedOperation) ValidateBasic() error {
+	if op.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(op.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(op.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if op.ExecuteAtHeight == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "execute at height")
+	}
+	switch op.Type {
+	case TimelockedOperationTypeMigrate:
+		if op.Migration == nil {
+			return sdkerrors.Wrap(ErrEmpty, "migration")
+		}
+		if op.Migration.CodeID == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if err := op.Migration.Msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "payload msg")
+		}
+	case TimelockedOperationTypeUpdateAdmin:
+		if _, err := sdk.AccAddressFromBech32(op.NewAdmin); err != nil {
+			return sdkerrors.Wrap(err, "new admin")
+		}
+	case TimelockedOperationTypeClearAdmin:
+	case TimelockedOperationTypeSetTimelock:
+		if err := ValidateTimelockDelay(op.DelayBlocks); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "operation type: %s", op.Type)
+	}
+	return nil
+}
+
 func (c Code) ValidateBasic() error {
 	if c.CodeID == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "code id")
@@ -47,6 +237,13 @@ func (c Code) ValidateBasic() error {
 	if err := c.CodeInfo.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "code info")
 	}
+	if len(c.CodeBytes) == 0 {
+		// stripped code bytes are referenced by the checksum
+		if len(c.CodeInfo.CodeHash) != sha256.Size {
+			return sdkerrors.Wrap(ErrInvalid, "code bytes: checksum reference must be a sha256 hash")
+		}
+		return nil
+	}
 	if err := validateWasmCode(c.CodeBytes); err != nil {
 		return sdkerrors.Wrap(err, "code bytes")
 	}
@@ -69,6 +266,9 @@ func (c Contract) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "contract state %d", i)
 		}
 	}
+	if err := c.StorageDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "storage deposit")
+	}
 	return nil
 }
 
@@ -118,3 +318,47 @@ var _ codectypes.UnpackInterfacesMessage = &Contract{}
 func (c *Contract) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return c.ContractInfo.UnpackInterfaces(unpacker)
 }
+
+// ValidateIBCRateLimitOverrides validates the IBC rate limit overrides and ensures that a contract has one override
+// per channel only
+func ValidateIBCRateLimitOverrides(overrides []IBCRateLimitOverride) error {
+	idx := make(map[string]struct{}, len(overrides))
+	for i, o := range overrides {
+		if err := o.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "ibc rate limit override: %d", i)
+		}
+		key := string(GetIBCRateLimitOverrideKey(sdk.MustAccAddressFromBech32(o.ContractAddress), o.ChannelID))
+		if _, exists := idx[key]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "ibc rate limit override: %d", i)
+		}
+		idx[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation
+func (o IBCRateLimitOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(o.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := ValidateIBCChannelID(o.ChannelID); err != nil {
+		return err
+	}
+	return sdkerrors.Wrap(o.Limit.ValidateBasic(), "limit")
+}
+
+// ValidateContractAnteHooks validates the contract ante hooks and ensures that a hook is enabled only once per contract
+func ValidateContractAnteHooks(hooks []ContractAnteHook) error {
+	idx := make(map[string]struct{}, len(hooks))
+	for i, h := range hooks {
+		if err := h.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract ante hook: %d", i)
+		}
+		key := string(GetContractAnteHookKey(h.Hook, sdk.MustAccAddressFromBech32(h.ContractAddress)))
+		if _, exists := idx[key]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract ante hook: %d", i)
+		}
+		idx[key] = struct{}{}
+	}
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
@@ -29,11 +31,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/wasm
 type GenesisState struct {
-	Params    Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Codes     []Code                 `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
-	Contracts []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	Sequences []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
-	GenMsgs   []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Codes                 []Code                 `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	Contracts             []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Sequences             []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	GenMsgs               []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	BlockHooks            []BlockHook            `protobuf:"bytes,6,rep,name=block_hooks,json=blockHooks,proto3" json:"block_hooks,omitempty"`
+	CodeStateLimits       []CodeStateLimit       `protobuf:"bytes,7,rep,name=code_state_limits,json=codeStateLimits,proto3" json:"code_state_limits,omitempty"`
+	PendingAdmins         []PendingAdmin         `protobuf:"bytes,8,rep,name=pending_admins,json=pendingAdmins,proto3" json:"pending_admins,omitempty"`
+	ContractTimelocks     []ContractTimelock     `protobuf:"bytes,9,rep,name=contract_timelocks,json=contractTimelocks,proto3" json:"contract_timelocks,omitempty"`
+	TimelockedOperations  []TimelockedOperation  `protobuf:"bytes,10,rep,name=timelocked_operations,json=timelockedOperations,proto3" json:"timelocked_operations,omitempty"`
+	IBCRateLimitOverrides []IBCRateLimitOverride `protobuf:"bytes,11,rep,name=ibc_rate_limit_overrides,json=ibcRateLimitOverrides,proto3" json:"ibc_rate_limit_overrides,omitempty"`
+	ContractAnteHooks     []ContractAnteHook     `protobuf:"bytes,12,rep,name=contract_ante_hooks,json=contractAnteHooks,proto3" json:"contract_ante_hooks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +118,55 @@ func (m *GenesisState) GetGenMsgs() []GenesisState_GenMsgs {
 	return nil
 }
 
+func (m *GenesisState) GetBlockHooks() []BlockHook {
+	if m != nil {
+		return m.BlockHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeStateLimits() []CodeStateLimit {
+	if m != nil {
+		return m.CodeStateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAdmins() []PendingAdmin {
+	if m != nil {
+		return m.PendingAdmins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractTimelocks() []ContractTimelock {
+	if m != nil {
+		return m.ContractTimelocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockedOperations() []TimelockedOperation {
+	if m != nil {
+		return m.TimelockedOperations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIBCRateLimitOverrides() []IBCRateLimitOverride {
+	if m != nil {
+		return m.IBCRateLimitOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractAnteHooks() []ContractAnteHook {
+	if m != nil {
+		return m.ContractAnteHooks
+	}
+	return nil
+}
+
 // GenMsgs define the messages that can be executed during genesis phase in
 // order. The intention is to have more human readable data that is auditable.
 type GenesisState_GenMsgs struct {
@@ -218,9 +276,11 @@ func (*GenesisState_GenMsgs) XXX_OneofWrappers() []interface{} {
 
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
-	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	CodeInfo  CodeInfo `protobuf:"bytes,2,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
-	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	CodeID   uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	CodeInfo CodeInfo `protobuf:"bytes,2,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
+	// CodeBytes is the wasm byte code. When empty, the code info checksum
+	// references the byte code in the wasm cache dir
+	CodeBytes []byte `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
 	// Pinned to wasmvm cache
 	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
 }
@@ -296,6 +356,8 @@ type Contract struct {
 	ContractAddress string       `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo    ContractInfo `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState   []Model      `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
+	// storage_deposit is the deposit held for the contract state
+	StorageDeposit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,4,rep,name=storage_deposit,json=storageDeposit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"storage_deposit"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -357,6 +419,13 @@ func (m *Contract) GetContractState() []Model {
 	return nil
 }
 
+func (m *Contract) GetStorageDeposit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.StorageDeposit
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -415,59 +484,205 @@ func (m *Sequence) GetValue() uint64 {
 	return 0
 }
 
+// GenesisStreamRecord is a single length-prefixed record of a streamed genesis
+// state. The state models of a contract follow its contract record.
+type GenesisStreamRecord struct {
+	// Types that are valid to be assigned to Sum:
+	//	*GenesisStreamRecord_Code
+	//	*GenesisStreamRecord_Contract
+	//	*GenesisStreamRecord_Model
+	//	*GenesisStreamRecord_Sequence
+	Sum isGenesisStreamRecord_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *GenesisStreamRecord) Reset()         { *m = GenesisStreamRecord{} }
+func (m *GenesisStreamRecord) String() string { return proto.CompactTextString(m) }
+func (*GenesisStreamRecord) ProtoMessage()    {}
+func (*GenesisStreamRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{4}
+}
+
+func (m *GenesisStreamRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GenesisStreamRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisStreamRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *GenesisStreamRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisStreamRecord.Merge(m, src)
+}
+
+func (m *GenesisStreamRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GenesisStreamRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisStreamRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisStreamRecord proto.InternalMessageInfo
+
+type isGenesisStreamRecord_Sum interface {
+	isGenesisStreamRecord_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type GenesisStreamRecord_Code struct {
+	Code *Code `protobuf:"bytes,1,opt,name=code,proto3,oneof" json:"code,omitempty"`
+}
+type GenesisStreamRecord_Contract struct {
+	Contract *Contract `protobuf:"bytes,2,opt,name=contract,proto3,oneof" json:"contract,omitempty"`
+}
+type GenesisStreamRecord_Model struct {
+	Model *Model `protobuf:"bytes,3,opt,name=model,proto3,oneof" json:"model,omitempty"`
+}
+type GenesisStreamRecord_Sequence struct {
+	Sequence *Sequence `protobuf:"bytes,4,opt,name=sequence,proto3,oneof" json:"sequence,omitempty"`
+}
+
+func (*GenesisStreamRecord_Code) isGenesisStreamRecord_Sum()     {}
+func (*GenesisStreamRecord_Contract) isGenesisStreamRecord_Sum() {}
+func (*GenesisStreamRecord_Model) isGenesisStreamRecord_Sum()    {}
+func (*GenesisStreamRecord_Sequence) isGenesisStreamRecord_Sum() {}
+
+func (m *GenesisStreamRecord) GetSum() isGenesisStreamRecord_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *GenesisStreamRecord) GetCode() *Code {
+	if x, ok := m.GetSum().(*GenesisStreamRecord_Code); ok {
+		return x.Code
+	}
+	return nil
+}
+
+func (m *GenesisStreamRecord) GetContract() *Contract {
+	if x, ok := m.GetSum().(*GenesisStreamRecord_Contract); ok {
+		return x.Contract
+	}
+	return nil
+}
+
+func (m *GenesisStreamRecord) GetModel() *Model {
+	if x, ok := m.GetSum().(*GenesisStreamRecord_Model); ok {
+		return x.Model
+	}
+	return nil
+}
+
+func (m *GenesisStreamRecord) GetSequence() *Sequence {
+	if x, ok := m.GetSum().(*GenesisStreamRecord_Sequence); ok {
+		return x.Sequence
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GenesisStreamRecord) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GenesisStreamRecord_Code)(nil),
+		(*GenesisStreamRecord_Contract)(nil),
+		(*GenesisStreamRecord_Model)(nil),
+		(*GenesisStreamRecord_Sequence)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.wasm.v1.GenesisState")
 	proto.RegisterType((*GenesisState_GenMsgs)(nil), "cosmwasm.wasm.v1.GenesisState.GenMsgs")
 	proto.RegisterType((*Code)(nil), "cosmwasm.wasm.v1.Code")
 	proto.RegisterType((*Contract)(nil), "cosmwasm.wasm.v1.Contract")
 	proto.RegisterType((*Sequence)(nil), "cosmwasm.wasm.v1.Sequence")
+	proto.RegisterType((*GenesisStreamRecord)(nil), "cosmwasm.wasm.v1.GenesisStreamRecord")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xe3, 0x26, 0x4e, 0x93, 0x69, 0x7e, 0xbf, 0x56, 0xdb, 0xaa, 0x35, 0x06, 0x9c, 0x28,
-	0xa0, 0x2a, 0x48, 0x28, 0x51, 0x8b, 0xc4, 0x0d, 0x01, 0xa6, 0x85, 0x46, 0x55, 0x25, 0x70, 0xc5,
-	0x05, 0xa9, 0x8a, 0x5c, 0x7b, 0xeb, 0xae, 0xa8, 0xbd, 0x21, 0xbb, 0x29, 0xcd, 0x99, 0x17, 0xe0,
-	0x11, 0xe0, 0x65, 0x50, 0x8f, 0x3d, 0x72, 0x8a, 0x50, 0x7a, 0xe3, 0x29, 0xd0, 0xfe, 0xb1, 0x6b,
-	0x70, 0x7a, 0xb1, 0xb2, 0x33, 0xdf, 0xf9, 0xcc, 0x9f, 0xcc, 0x2e, 0x38, 0x01, 0x65, 0xf1, 0x67,
-	0x9f, 0xc5, 0x3d, 0xf9, 0x39, 0xdf, 0xea, 0x45, 0x38, 0xc1, 0x8c, 0xb0, 0xee, 0x70, 0x44, 0x39,
-	0x45, 0x2b, 0xa9, 0xbf, 0x2b, 0x3f, 0xe7, 0x5b, 0xf6, 0x5a, 0x44, 0x23, 0x2a, 0x9d, 0x3d, 0xf1,
-	0x4b, 0xe9, 0xec, 0x7b, 0x05, 0x0e, 0x9f, 0x0c, 0xb1, 0xa6, 0xd8, 0x77, 0x8a, 0xde, 0x0b, 0xe5,
-	0x6a, 0x7f, 0x33, 0xa1, 0xf1, 0x46, 0xa5, 0x3c, 0xe4, 0x3e, 0xc7, 0xe8, 0x29, 0x54, 0x87, 0xfe,
-	0xc8, 0x8f, 0x99, 0x65, 0xb4, 0x8c, 0xce, 0xd2, 0xb6, 0xd5, 0xfd, 0xb7, 0x84, 0xee, 0x5b, 0xe9,
-	0x77, 0x2b, 0x97, 0xd3, 0x66, 0xc9, 0xd3, 0x6a, 0xb4, 0x0b, 0x66, 0x40, 0x43, 0xcc, 0xac, 0x85,
-	0x56, 0xb9, 0xb3, 0xb4, 0xbd, 0x5e, 0x0c, 0x7b, 0x45, 0x43, 0xec, 0x6e, 0x88, 0xa0, 0xdf, 0xd3,
-	0xe6, 0xb2, 0x14, 0x3f, 0xa6, 0x31, 0xe1, 0x38, 0x1e, 0xf2, 0x89, 0xa7, 0xa2, 0xd1, 0x7b, 0xa8,
-	0x07, 0x34, 0xe1, 0x23, 0x3f, 0xe0, 0xcc, 0x2a, 0x4b, 0x94, 0x3d, 0x0f, 0xa5, 0x24, 0xee, 0x5d,
-	0x8d, 0x5b, 0xcd, 0x82, 0x72, 0xc8, 0x1b, 0x92, 0xc0, 0x32, 0xfc, 0x69, 0x8c, 0x93, 0x00, 0x33,
-	0xab, 0x72, 0x1b, 0xf6, 0x50, 0x4b, 0x6e, 0xb0, 0x59, 0x50, 0x1e, 0x9b, 0x19, 0xd1, 0x11, 0xd4,
-	0x22, 0x9c, 0x0c, 0x62, 0x16, 0x31, 0xcb, 0x94, 0xd4, 0xcd, 0x22, 0x35, 0x3f, 0x5e, 0x71, 0x38,
-	0x60, 0x11, 0x73, 0x6d, 0x9d, 0x01, 0xa5, 0xf1, 0xb9, 0x04, 0x8b, 0x91, 0x12, 0xd9, 0x5f, 0x16,
-	0x60, 0x51, 0x07, 0xa0, 0xe7, 0x00, 0x8c, 0xd3, 0x11, 0x1e, 0x88, 0x39, 0xe9, 0xff, 0xc6, 0x29,
-	0x26, 0x3b, 0x60, 0xd1, 0xa1, 0x90, 0x89, 0x61, 0xef, 0x95, 0xbc, 0x3a, 0x4b, 0x0f, 0xe8, 0x08,
-	0xd6, 0x48, 0xc2, 0xb8, 0x9f, 0x70, 0xe2, 0x73, 0x81, 0x51, 0xb3, 0xb1, 0x16, 0x24, 0xaa, 0x33,
-	0x17, 0xd5, 0xbf, 0x09, 0x48, 0x47, 0xbe, 0x57, 0xf2, 0x56, 0x49, 0xd1, 0x8c, 0xde, 0xc1, 0x0a,
-	0xbe, 0xc0, 0xc1, 0x38, 0x8f, 0x2e, 0x4b, 0xf4, 0xc3, 0xb9, 0xe8, 0x5d, 0x25, 0xce, 0x61, 0x97,
-	0xf1, 0xdf, 0x26, 0xd7, 0x84, 0x32, 0x1b, 0xc7, 0xed, 0xef, 0x06, 0x54, 0x64, 0x07, 0x0f, 0x60,
-	0x51, 0x34, 0x3f, 0x20, 0xa1, 0xec, 0xbf, 0xe2, 0xc2, 0x6c, 0xda, 0xac, 0x0a, 0x57, 0x7f, 0xc7,
-	0xab, 0x0a, 0x57, 0x3f, 0x44, 0xcf, 0xc4, 0x02, 0x09, 0x51, 0x72, 0x42, 0x75, 0x6f, 0xf6, 0xfc,
-	0x5d, 0xec, 0x27, 0x27, 0x54, 0x2f, 0x71, 0x2d, 0xd0, 0x67, 0x74, 0x1f, 0x40, 0x86, 0x1f, 0x4f,
-	0x38, 0x66, 0xb2, 0x81, 0x86, 0x27, 0x81, 0xae, 0x30, 0xa0, 0x75, 0xa8, 0x0e, 0x49, 0x92, 0xe0,
-	0xd0, 0xaa, 0xb4, 0x8c, 0x4e, 0xcd, 0xd3, 0xa7, 0xf6, 0x0f, 0x03, 0x6a, 0xd9, 0x28, 0x1e, 0xc1,
-	0x4a, 0x3a, 0x82, 0x81, 0x1f, 0x86, 0x23, 0xcc, 0xd4, 0x65, 0xaa, 0x7b, 0xcb, 0xa9, 0xfd, 0xa5,
-	0x32, 0xa3, 0x3e, 0xfc, 0x97, 0x49, 0x73, 0x15, 0x3b, 0xb7, 0xaf, 0x7c, 0xae, 0xea, 0x46, 0x90,
-	0xb3, 0xa1, 0x1d, 0xf8, 0x3f, 0x43, 0x31, 0xb1, 0x6b, 0xfa, 0xfa, 0x6c, 0xcc, 0x19, 0x3f, 0x0d,
-	0xf1, 0x99, 0x86, 0x64, 0xf9, 0xe5, 0x7e, 0xb6, 0x5d, 0xa8, 0xa5, 0xb7, 0x00, 0xb5, 0xa0, 0x4a,
-	0xc2, 0xc1, 0x47, 0x3c, 0x91, 0xd5, 0x37, 0xdc, 0xfa, 0x6c, 0xda, 0x34, 0xfb, 0x3b, 0xfb, 0x78,
-	0xe2, 0x99, 0x24, 0xdc, 0xc7, 0x13, 0xb4, 0x06, 0xe6, 0xb9, 0x7f, 0x36, 0xc6, 0xb2, 0xec, 0x8a,
-	0xa7, 0x0e, 0xee, 0x8b, 0xcb, 0x99, 0x63, 0x5c, 0xcd, 0x1c, 0xe3, 0xd7, 0xcc, 0x31, 0xbe, 0x5e,
-	0x3b, 0xa5, 0xab, 0x6b, 0xa7, 0xf4, 0xf3, 0xda, 0x29, 0x7d, 0xd8, 0x8c, 0x08, 0x3f, 0x1d, 0x1f,
-	0x77, 0x03, 0x1a, 0xf7, 0x5e, 0x93, 0x84, 0x05, 0xa7, 0xc4, 0x97, 0x6f, 0x52, 0xd8, 0xbb, 0x50,
-	0x6f, 0x93, 0x7c, 0xb6, 0x8e, 0xab, 0xf2, 0x71, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x3b,
-	0x92, 0x82, 0x9e, 0x1f, 0x05, 0x00, 0x00,
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x6d, 0x49, 0x96, 0xd7, 0x4e, 0xec, 0xac, 0xed, 0x84, 0x91, 0x63, 0x49, 0x50, 0x7e,
+	0xea, 0x02, 0xae, 0x08, 0xa7, 0x45, 0xd1, 0x4b, 0xd1, 0x86, 0x76, 0x5a, 0x09, 0x69, 0x90, 0x96,
+	0x4e, 0x2f, 0x2d, 0x02, 0x82, 0x22, 0xd7, 0xf4, 0x42, 0x26, 0x57, 0xe1, 0xac, 0x14, 0xeb, 0x9c,
+	0x17, 0xe8, 0x2b, 0x14, 0xe8, 0xa9, 0x4f, 0xd1, 0x63, 0x8e, 0x39, 0xf6, 0xa4, 0x16, 0xf2, 0x2d,
+	0x97, 0xde, 0x7b, 0x2a, 0x76, 0xb9, 0xa4, 0x18, 0x93, 0x4a, 0x2f, 0x82, 0x76, 0xe7, 0x9b, 0xef,
+	0x9b, 0x9d, 0x99, 0xdd, 0x21, 0x6a, 0xb8, 0x0c, 0x82, 0x57, 0x0e, 0x04, 0x86, 0xfc, 0x19, 0x1f,
+	0x1a, 0x3e, 0x09, 0x09, 0x50, 0xe8, 0x0c, 0x23, 0xc6, 0x19, 0xde, 0x4c, 0xec, 0x1d, 0xf9, 0x33,
+	0x3e, 0xac, 0x6f, 0xfb, 0xcc, 0x67, 0xd2, 0x68, 0x88, 0x7f, 0x31, 0xae, 0x7e, 0x27, 0xc7, 0xc3,
+	0x27, 0x43, 0xa2, 0x58, 0xea, 0xb7, 0xf3, 0xd6, 0x0b, 0x65, 0x92, 0x01, 0x30, 0x30, 0xfa, 0x0e,
+	0x10, 0x63, 0x7c, 0xd8, 0x27, 0xdc, 0x39, 0x34, 0x5c, 0x46, 0xc3, 0xd8, 0xde, 0xfe, 0x77, 0x0d,
+	0xad, 0x7f, 0x1b, 0x87, 0x74, 0xc2, 0x1d, 0x4e, 0xf0, 0xe7, 0xa8, 0x3a, 0x74, 0x22, 0x27, 0x00,
+	0x5d, 0x6b, 0x69, 0xfb, 0x6b, 0x0f, 0xf5, 0xce, 0xd5, 0x10, 0x3b, 0xdf, 0x4b, 0xbb, 0x59, 0x7e,
+	0x33, 0x6d, 0x96, 0x2c, 0x85, 0xc6, 0x8f, 0x51, 0xc5, 0x65, 0x1e, 0x01, 0x7d, 0xa9, 0xb5, 0xbc,
+	0xbf, 0xf6, 0xf0, 0x66, 0xde, 0xed, 0x88, 0x79, 0xc4, 0xbc, 0x25, 0x9c, 0xde, 0x4d, 0x9b, 0x1b,
+	0x12, 0x7c, 0xc0, 0x02, 0xca, 0x49, 0x30, 0xe4, 0x13, 0x2b, 0xf6, 0xc6, 0x3f, 0xa2, 0x55, 0x97,
+	0x85, 0x3c, 0x72, 0x5c, 0x0e, 0xfa, 0xb2, 0xa4, 0xaa, 0x17, 0x51, 0xc5, 0x10, 0x73, 0x57, 0xd1,
+	0x6d, 0xa5, 0x4e, 0x19, 0xca, 0x39, 0x93, 0xa0, 0x05, 0xf2, 0x72, 0x44, 0x42, 0x97, 0x80, 0x5e,
+	0x5e, 0x44, 0x7b, 0xa2, 0x20, 0x73, 0xda, 0xd4, 0x29, 0x4b, 0x9b, 0x6e, 0xe2, 0x17, 0xa8, 0xe6,
+	0x93, 0xd0, 0x0e, 0xc0, 0x07, 0xbd, 0x22, 0x59, 0x1f, 0xe4, 0x59, 0xb3, 0xe9, 0x15, 0x8b, 0xa7,
+	0xe0, 0x83, 0x59, 0x57, 0x0a, 0x38, 0xf1, 0xcf, 0x08, 0xac, 0xf8, 0x31, 0x08, 0xff, 0x8c, 0xd6,
+	0xfa, 0xe7, 0xcc, 0x1d, 0xd8, 0x67, 0x8c, 0x0d, 0x40, 0xaf, 0x4a, 0x85, 0xdd, 0xbc, 0x82, 0x29,
+	0x40, 0x5d, 0xc6, 0x06, 0xe6, 0x9e, 0xa2, 0xdd, 0xc9, 0xf8, 0x65, 0x98, 0x51, 0x3f, 0x41, 0x02,
+	0x7e, 0x89, 0x6e, 0x88, 0x94, 0xdb, 0x20, 0xe2, 0xb2, 0xcf, 0x69, 0x40, 0x39, 0xe8, 0x2b, 0x52,
+	0xa2, 0x55, 0x5c, 0x3c, 0x79, 0x82, 0xef, 0x04, 0xd0, 0xbc, 0xab, 0x74, 0x76, 0x73, 0x14, 0x19,
+	0x35, 0x59, 0xe3, 0xb9, 0x13, 0x60, 0x1f, 0x5d, 0x1f, 0x92, 0xd0, 0xa3, 0xa1, 0x6f, 0x3b, 0x5e,
+	0x40, 0x43, 0xd0, 0x6b, 0x52, 0xaf, 0x51, 0xd0, 0x63, 0x31, 0xee, 0x91, 0x80, 0x99, 0x2d, 0xa5,
+	0xa6, 0xbf, 0xef, 0x9d, 0x91, 0xba, 0x36, 0xcc, 0xe0, 0x01, 0xbf, 0x42, 0x38, 0xa9, 0xbd, 0xcd,
+	0x69, 0x40, 0xc4, 0xa9, 0x41, 0x5f, 0x95, 0x62, 0xed, 0xc5, 0xed, 0xf4, 0x5c, 0x41, 0xcd, 0x7b,
+	0x4a, 0xf0, 0x4e, 0x9e, 0x25, 0x23, 0x7a, 0xc3, 0xbd, 0xe2, 0x07, 0xf8, 0xb5, 0x86, 0x76, 0x12,
+	0x28, 0xf1, 0x6c, 0x36, 0x24, 0x91, 0xc3, 0x29, 0x0b, 0x41, 0x47, 0x52, 0xfc, 0x7e, 0x5e, 0xfc,
+	0x79, 0x0a, 0x7f, 0x96, 0xa0, 0xcd, 0x8f, 0x94, 0x7e, 0xb3, 0x90, 0x2b, 0x13, 0xc2, 0x36, 0xcf,
+	0x7b, 0x03, 0xfe, 0x4d, 0x43, 0x3a, 0xed, 0xbb, 0x76, 0x94, 0x96, 0xc5, 0x66, 0x63, 0x12, 0x45,
+	0x54, 0xdc, 0xcf, 0xb5, 0x45, 0x7d, 0xda, 0x33, 0x8f, 0xac, 0xa4, 0x56, 0xcf, 0x14, 0xdc, 0x3c,
+	0x12, 0x91, 0xcc, 0xa6, 0xcd, 0x9d, 0x22, 0x2b, 0xbc, 0x9b, 0x36, 0xdb, 0x8b, 0x84, 0x32, 0x51,
+	0xee, 0xd0, 0xbe, 0x9b, 0x77, 0xc6, 0x13, 0x94, 0x5e, 0x5b, 0xdb, 0x09, 0x39, 0x51, 0x6d, 0xbe,
+	0xfe, 0x7f, 0x65, 0x7a, 0x14, 0x72, 0x22, 0xbb, 0xfd, 0xbe, 0x4a, 0xd3, 0x5e, 0x01, 0x4d, 0x51,
+	0x9d, 0x12, 0x47, 0xa8, 0xbf, 0x5e, 0x42, 0x2b, 0xea, 0x2a, 0xe2, 0xaf, 0x10, 0x02, 0xce, 0x22,
+	0x62, 0x8b, 0x76, 0x55, 0xaf, 0x5e, 0x41, 0x47, 0x3e, 0x05, 0xff, 0x44, 0xc0, 0xc4, 0x4d, 0xe8,
+	0x96, 0xac, 0x55, 0x48, 0x16, 0xf8, 0x05, 0xda, 0xa6, 0x21, 0x70, 0x27, 0xe4, 0x54, 0xe4, 0x21,
+	0x51, 0xd3, 0x97, 0x24, 0xd5, 0x7e, 0x21, 0x55, 0x6f, 0xee, 0x90, 0x1c, 0xab, 0x5b, 0xb2, 0xb6,
+	0x68, 0x7e, 0x1b, 0xff, 0x80, 0x36, 0xc9, 0x05, 0x71, 0x47, 0x59, 0xea, 0x65, 0x49, 0x7d, 0xaf,
+	0x90, 0xfa, 0x71, 0x0c, 0xce, 0xd0, 0x6e, 0x90, 0xf7, 0xb7, 0xcc, 0x0a, 0x5a, 0x86, 0x51, 0xd0,
+	0xfe, 0x55, 0x43, 0x65, 0x79, 0x82, 0xbb, 0x68, 0x45, 0x5e, 0x64, 0xea, 0xc9, 0xf3, 0x97, 0x4d,
+	0x34, 0x9b, 0x36, 0xab, 0xc2, 0xd4, 0x3b, 0xb6, 0xaa, 0xc2, 0xd4, 0xf3, 0xf0, 0x97, 0xe2, 0x69,
+	0x16, 0xa0, 0xf0, 0x94, 0xa9, 0xb3, 0xd5, 0x8b, 0x1f, 0x8a, 0x5e, 0x78, 0xca, 0xd4, 0x78, 0xa8,
+	0xb9, 0x6a, 0x8d, 0xf7, 0x10, 0x92, 0xee, 0xfd, 0x09, 0x27, 0x20, 0x0f, 0xb0, 0x6e, 0x49, 0x42,
+	0x53, 0x6c, 0xe0, 0x9b, 0xa8, 0x3a, 0xa4, 0x61, 0x48, 0x3c, 0xbd, 0xdc, 0xd2, 0xf6, 0x6b, 0x96,
+	0x5a, 0xb5, 0xff, 0x58, 0x42, 0xb5, 0x34, 0x15, 0x1f, 0xa3, 0xcd, 0x79, 0xa9, 0x3d, 0x2f, 0x22,
+	0x10, 0x8f, 0xa9, 0x55, 0xf1, 0xd6, 0xa8, 0x1a, 0xc7, 0xdb, 0xb8, 0x87, 0xae, 0xa5, 0xd0, 0x4c,
+	0xc4, 0x8d, 0xc5, 0x6d, 0x95, 0x89, 0x7a, 0xdd, 0xcd, 0xec, 0xe1, 0x63, 0x74, 0x3d, 0xa5, 0x92,
+	0x4f, 0x9d, 0x1a, 0x4c, 0xb7, 0x0a, 0xd2, 0xcf, 0x3c, 0x72, 0xae, 0x48, 0x52, 0xfd, 0x78, 0xb0,
+	0x5e, 0xa0, 0x0d, 0xd1, 0x32, 0x8e, 0x4f, 0x6c, 0x8f, 0x0c, 0x19, 0x50, 0xae, 0x06, 0xd1, 0xed,
+	0x4e, 0x3c, 0xa3, 0x3b, 0x62, 0x46, 0x77, 0xd4, 0x8c, 0xee, 0x1c, 0x31, 0x1a, 0x9a, 0x9f, 0x09,
+	0xa2, 0xdf, 0xff, 0x6a, 0x1e, 0xf8, 0x94, 0x9f, 0x8d, 0xfa, 0x1d, 0x97, 0x05, 0xc6, 0x37, 0x34,
+	0x04, 0xf7, 0x8c, 0x3a, 0xc6, 0xa9, 0xfa, 0xf3, 0x09, 0x78, 0x03, 0xf5, 0x39, 0x20, 0x9c, 0xc0,
+	0xba, 0xae, 0x74, 0x8e, 0x63, 0x99, 0xb6, 0x89, 0x6a, 0xc9, 0x64, 0xc3, 0x2d, 0x54, 0xa5, 0x9e,
+	0x3d, 0x20, 0x13, 0x99, 0xb7, 0x75, 0x73, 0x75, 0x36, 0x6d, 0x56, 0x7a, 0xc7, 0x4f, 0xc8, 0xc4,
+	0xaa, 0x50, 0xef, 0x09, 0x99, 0xe0, 0x6d, 0x54, 0x19, 0x3b, 0xe7, 0x23, 0x22, 0x13, 0x56, 0xb6,
+	0xe2, 0x45, 0xfb, 0x1f, 0x0d, 0x6d, 0xa5, 0x83, 0x2c, 0x22, 0x4e, 0x60, 0x11, 0x97, 0x45, 0x1e,
+	0x3e, 0x40, 0xe5, 0xcc, 0xb5, 0x59, 0x30, 0xf5, 0xbb, 0x25, 0x4b, 0xa2, 0xf0, 0x17, 0xa8, 0x76,
+	0xe5, 0x76, 0x7c, 0x60, 0xb8, 0x77, 0x65, 0xf7, 0xa8, 0xca, 0x1b, 0xa8, 0x12, 0x88, 0xdc, 0xaa,
+	0xce, 0x5f, 0x94, 0xfa, 0x6e, 0xc9, 0x8a, 0x71, 0x42, 0x2a, 0x99, 0xd3, 0xb2, 0xa3, 0x3e, 0x38,
+	0xf0, 0x85, 0x54, 0x82, 0x56, 0x97, 0xc3, 0xfc, 0xfa, 0xcd, 0xac, 0xa1, 0xbd, 0x9d, 0x35, 0xb4,
+	0xbf, 0x67, 0x0d, 0xed, 0x97, 0xcb, 0x46, 0xe9, 0xed, 0x65, 0xa3, 0xf4, 0xe7, 0x65, 0xa3, 0xf4,
+	0xd3, 0x83, 0xa2, 0x6a, 0x08, 0x4a, 0xcf, 0xb8, 0x88, 0xbf, 0xc0, 0x64, 0x35, 0xfa, 0x55, 0xf9,
+	0x89, 0xf5, 0xe9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x1f, 0xb4, 0x29, 0x05, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -490,6 +705,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractAnteHooks) > 0 {
+		for iNdEx := len(m.ContractAnteHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractAnteHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.IBCRateLimitOverrides) > 0 {
+		for iNdEx := len(m.IBCRateLimitOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IBCRateLimitOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TimelockedOperations) > 0 {
+		for iNdEx := len(m.TimelockedOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockedOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ContractTimelocks) > 0 {
+		for iNdEx := len(m.ContractTimelocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractTimelocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingAdmins) > 0 {
+		for iNdEx := len(m.PendingAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdmins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CodeStateLimits) > 0 {
+		for iNdEx := len(m.CodeStateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeStateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlockHooks) > 0 {
+		for iNdEx := len(m.BlockHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenMsgs) > 0 {
 		for iNdEx := len(m.GenMsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -732,6 +1045,20 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageDeposit) > 0 {
+		for iNdEx := len(m.StorageDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ContractState) > 0 {
 		for iNdEx := len(m.ContractState) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -801,70 +1128,232 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisStreamRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Codes) > 0 {
-		for _, e := range m.Codes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Contracts) > 0 {
-		for _, e := range m.Contracts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Sequences) > 0 {
-		for _, e := range m.Sequences {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.GenMsgs) > 0 {
-		for _, e := range m.GenMsgs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
+func (m *GenesisStreamRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState_GenMsgs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GenesisStreamRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Sum != nil {
-		n += m.Sum.Size()
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState_GenMsgs_StoreCode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *GenesisStreamRecord_Code) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisStreamRecord_Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Code != nil {
+		{
+			size, err := m.Code.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisStreamRecord_Contract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisStreamRecord_Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisStreamRecord_Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisStreamRecord_Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Model != nil {
+		{
+			size, err := m.Model.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisStreamRecord_Sequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisStreamRecord_Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Sequence != nil {
+		{
+			size, err := m.Sequence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sequences) > 0 {
+		for _, e := range m.Sequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GenMsgs) > 0 {
+		for _, e := range m.GenMsgs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockHooks) > 0 {
+		for _, e := range m.BlockHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeStateLimits) > 0 {
+		for _, e := range m.CodeStateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAdmins) > 0 {
+		for _, e := range m.PendingAdmins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractTimelocks) > 0 {
+		for _, e := range m.ContractTimelocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockedOperations) > 0 {
+		for _, e := range m.TimelockedOperations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IBCRateLimitOverrides) > 0 {
+		for _, e := range m.IBCRateLimitOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractAnteHooks) > 0 {
+		for _, e := range m.ContractAnteHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState_GenMsgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *GenesisState_GenMsgs_StoreCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.StoreCode != nil {
 		l = m.StoreCode.Size()
@@ -938,6 +1427,12 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StorageDeposit) > 0 {
+		for _, e := range m.StorageDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -957,6 +1452,70 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *GenesisStreamRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *GenesisStreamRecord_Code) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != nil {
+		l = m.Code.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisStreamRecord_Contract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisStreamRecord_Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Model != nil {
+		l = m.Model.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisStreamRecord_Sequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != nil {
+		l = m.Sequence.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -975,28 +1534,265 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codes = append(m.Codes, Code{})
+			if err := m.Codes[len(m.Codes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, Contract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequences = append(m.Sequences, Sequence{})
+			if err := m.Sequences[len(m.Sequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenMsgs = append(m.GenMsgs, GenesisState_GenMsgs{})
+			if err := m.GenMsgs[len(m.GenMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHooks = append(m.BlockHooks, BlockHook{})
+			if err := m.BlockHooks[len(m.BlockHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeStateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CodeStateLimits = append(m.CodeStateLimits, CodeStateLimit{})
+			if err := m.CodeStateLimits[len(m.CodeStateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1023,13 +1819,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingAdmins = append(m.PendingAdmins, PendingAdmin{})
+			if err := m.PendingAdmins[len(m.PendingAdmins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTimelocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1056,14 +1853,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codes = append(m.Codes, Code{})
-			if err := m.Codes[len(m.Codes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractTimelocks = append(m.ContractTimelocks, ContractTimelock{})
+			if err := m.ContractTimelocks[len(m.ContractTimelocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedOperations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1090,14 +1887,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, Contract{})
-			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimelockedOperations = append(m.TimelockedOperations, TimelockedOperation{})
+			if err := m.TimelockedOperations[len(m.TimelockedOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCRateLimitOverrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1124,14 +1921,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sequences = append(m.Sequences, Sequence{})
-			if err := m.Sequences[len(m.Sequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IBCRateLimitOverrides = append(m.IBCRateLimitOverrides, IBCRateLimitOverride{})
+			if err := m.IBCRateLimitOverrides[len(m.IBCRateLimitOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenMsgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAnteHooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1158,8 +1955,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenMsgs = append(m.GenMsgs, GenesisState_GenMsgs{})
-			if err := m.GenMsgs[len(m.GenMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractAnteHooks = append(m.ContractAnteHooks, ContractAnteHook{})
+			if err := m.ContractAnteHooks[len(m.ContractAnteHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1626,6 +2423,40 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposit = append(m.StorageDeposit, types.Coin{})
+			if err := m.StorageDeposit[len(m.StorageDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1752,6 +2583,197 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *GenesisStreamRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisStreamRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisStreamRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Code{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &GenesisStreamRecord_Code{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Contract{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &GenesisStreamRecord_Contract{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Model{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &GenesisStreamRecord_Model{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Sequence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &GenesisStreamRecord_Sequence{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
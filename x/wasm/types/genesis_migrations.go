@@ -0,0 +1,213 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+const (
+	// GenesisVersionLegacy is the amino JSON genesis format of the wasmd releases before the protobuf migration
+	GenesisVersionLegacy uint64 = 0
+	// LatestGenesisVersion is the genesis format of the current module consensus version
+	LatestGenesisVersion uint64 = 2
+)
+
+// GenesisMigration transforms the decoded JSON of an exported wasm genesis state from one
+// version to the next one in place.
+type GenesisMigration func(state map[string]interface{}) error
+
+// genesisMigrations contains the migration from version n to n+1 for each version n.
+// The genesis versions match the module consensus versions.
+var genesisMigrations = map[uint64]GenesisMigration{
+	GenesisVersionLegacy: migrateGenesisLegacyTo1,
+	1:                    migrateGenesis1to2,
+}
+
+// MigrateGenesisJSON converts the JSON of an exported wasm genesis state from the source to the target version
+// by applying all migrations in between in order. Downgrades are not supported.
+func MigrateGenesisJSON(bz []byte, source, target uint64) ([]byte, error) {
+	if target > LatestGenesisVersion {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "unknown target version: %d", target)
+	}
+	if source > target {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "can not migrate from version %d down to %d", source, target)
+	}
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber() // keep large integers
+	var state map[string]interface{}
+	if err := dec.Decode(&state); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if state == nil {
+		return nil, sdkerrors.Wrap(ErrEmpty, "genesis state")
+	}
+	for v := source; v < target; v++ {
+		if err := genesisMigrations[v](state); err != nil {
+			return nil, sdkerrors.Wrapf(err, "version %d to %d", v, v+1)
+		}
+	}
+	return json.Marshal(state)
+}
+
+// migrateGenesisLegacyTo1 converts the amino JSON genesis into the protobuf JSON genesis:
+//   - access configs use the permission name instead of the numeric `type`
+//   - the upload access param is renamed to `code_upload_access`
+//   - the removed code info fields `source` and `builder` and contract info fields
+//     `init_msg`, `last_updated` and `previous_code_id` are dropped
+//   - the contract `created` position is dropped as it is set on import
+//   - null lists are replaced by empty ones
+func migrateGenesisLegacyTo1(state map[string]interface{}) error {
+	params, ok := state["params"].(map[string]interface{})
+	if !ok {
+		return sdkerrors.Wrap(ErrEmpty, "params")
+	}
+	if uploadAccess, ok := params["upload_access"]; ok {
+		params["code_upload_access"] = uploadAccess
+		delete(params, "upload_access")
+	}
+	uploadAccess, ok := params["code_upload_access"].(map[string]interface{})
+	if !ok {
+		return sdkerrors.Wrap(ErrEmpty, "code upload access")
+	}
+	if err := migrateLegacyAccessConfig(uploadAccess); err != nil {
+		return sdkerrors.Wrap(err, "code upload access")
+	}
+	permission, err := legacyAccessTypeName(params["instantiate_default_permission"])
+	if err != nil {
+		return sdkerrors.Wrap(err, "instantiate default permission")
+	}
+	params["instantiate_default_permission"] = permission
+
+	for _, key := range []string{"codes", "contracts", "sequences"} {
+		if state[key] == nil {
+			state[key] = []interface{}{}
+		}
+	}
+	codes, ok := state["codes"].([]interface{})
+	if !ok {
+		return sdkerrors.Wrap(ErrInvalid, "codes")
+	}
+	for i, c := range codes {
+		code, ok := c.(map[string]interface{})
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "code: %d", i)
+		}
+		info, ok := code["code_info"].(map[string]interface{})
+		if !ok {
+			return sdkerrors.Wrapf(ErrEmpty, "code info: %d", i)
+		}
+		delete(info, "source")
+		delete(info, "builder")
+		if config, ok := info["instantiate_config"].(map[string]interface{}); ok {
+			if err := migrateLegacyAccessConfig(config); err != nil {
+				return sdkerrors.Wrapf(err, "code info: %d", i)
+			}
+		}
+	}
+	contracts, ok := state["contracts"].([]interface{})
+	if !ok {
+		return sdkerrors.Wrap(ErrInvalid, "contracts")
+	}
+	for i, c := range contracts {
+		contract, ok := c.(map[string]interface{})
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "contract: %d", i)
+		}
+		if info, ok := contract["contract_info"].(map[string]interface{}); ok {
+			delete(info, "created")
+			delete(info, "init_msg")
+			delete(info, "last_updated")
+			delete(info, "previous_code_id")
+		}
+		if contract["contract_state"] == nil {
+			contract["contract_state"] = []interface{}{}
+		}
+	}
+	return nil
+}
+
+// migrateLegacyAccessConfig replaces the numeric `type` of an amino JSON access config by the permission name
+func migrateLegacyAccessConfig(config map[string]interface{}) error {
+	v, ok := config["type"]
+	if !ok {
+		v = config["permission"]
+	}
+	permission, err := legacyAccessTypeName(v)
+	if err != nil {
+		return err
+	}
+	delete(config, "type")
+	config["permission"] = permission
+	return nil
+}
+
+// legacyAccessTypeName returns the name for a numeric access type. Names are returned unchanged.
+func legacyAccessTypeName(v interface{}) (string, error) {
+	var s string
+	switch x := v.(type) {
+	case json.Number:
+		s = x.String()
+	case string:
+		s = x
+	default:
+		return "", sdkerrors.Wrapf(ErrInvalid, "access type: %v", v)
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		var a AccessType
+		if err := a.UnmarshalText([]byte(s)); err != nil || a == AccessTypeUnspecified {
+			return "", sdkerrors.Wrapf(ErrInvalid, "access type: %s", s)
+		}
+		return s, nil
+	}
+	if _, ok := AccessType_name[int32(n)]; !ok || AccessType(n) == AccessTypeUnspecified {
+		return "", sdkerrors.Wrapf(ErrInvalid, "access type: %s", s)
+	}
+	return AccessType(n).String(), nil
+}
+
+// migrateGenesis1to2 sets the params added in version 2 to their defaults:
+//   - the IBC rate limit is disabled
+//   - the code verifiers are an empty list
+//   - the immutable override is disallowed
+//   - the max contract state bytes are unlimited
+//   - the storage deposit is disabled
+//   - the block hook max failures are set to the default
+//   - the block hook max gas limit is set to the default
+//
+// The contract state sizes added in version 2 are not exported but computed on import.
+func migrateGenesis1to2(state map[string]interface{}) error {
+	params, ok := state["params"].(map[string]interface{})
+	if !ok {
+		return sdkerrors.Wrap(ErrEmpty, "params")
+	}
+	if _, ok := params["ibc_rate_limit"]; !ok {
+		params["ibc_rate_limit"] = map[string]interface{}{
+			"max_packets_per_block": "0",
+			"max_bytes_per_window":  "0",
+			"window_blocks":         "0",
+		}
+	}
+	if _, ok := params["code_verifiers"]; !ok {
+		params["code_verifiers"] = []interface{}{}
+	}
+	if _, ok := params["allow_immutable_override"]; !ok {
+		params["allow_immutable_override"] = false
+	}
+	if _, ok := params["max_contract_state_bytes"]; !ok {
+		params["max_contract_state_bytes"] = "0"
+	}
+	if _, ok := params["storage_deposit_per_byte"]; !ok {
+		params["storage_deposit_per_byte"] = []interface{}{}
+	}
+	if _, ok := params["block_hook_max_failures"]; !ok {
+		params["block_hook_max_failures"] = DefaultBlockHookMaxFailures
+	}
+	if _, ok := params["block_hook_max_gas_limit"]; !ok {
+		params["block_hook_max_gas_limit"] = strconv.FormatUint(DefaultBlockHookMaxGasLimit, 10)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/codec/types"
+)
+
+func TestMigrateGenesisJSON(t *testing.T) {
+	bz, err := os.ReadFile("../keeper/testdata/genesis.json")
+	require.NoError(t, err)
+	var genDoc struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &genDoc))
+	sampleGenesis := genDoc.AppState[ModuleName]
+
+	const (
+		myCreator  = "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsh9tp23"
+		myContract = "link14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sgf2vn8"
+	)
+	legacyGenesis := `{
+  "params": {"upload_access": {"type": 2, "address": "` + myCreator + `"}, "instantiate_default_permission": 1},
+  "codes": [{
+    "code_id": "1",
+    "code_info": {
+      "code_hash": "AQID",
+      "creator": "` + myCreator + `",
+      "source": "https://example.com",
+      "builder": "cosmwasm/rust-optimizer:0.10.4",
+      "instantiate_config": {"type": 3, "address": ""}
+    },
+    "code_bytes": "AGFzbQ=="
+  }],
+  "contracts": [{
+    "contract_address": "` + myContract + `",
+    "contract_info": {
+      "code_id": "1",
+      "creator": "` + myCreator + `",
+      "label": "my contract",
+      "init_msg": {"foo": "bar"},
+      "created": {"block_height": "1", "tx_index": "2"},
+      "last_updated": {"block_height": "1", "tx_index": "2"},
+      "previous_code_id": "0"
+    },
+    "contract_state": [{"key": "0102", "value": "AQI="}]
+  }],
+  "sequences": [{"id_key": "BGxhc3RDb2RlSWQ=", "value": "2"}]
+}`
+
+	interfaceRegistry := types.NewInterfaceRegistry()
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	v1Genesis := GenesisFixture()
+	v1GenesisBz := marshaler.MustMarshalJSON(&v1Genesis)
+	var v1GenesisJSON map[string]interface{}
+	require.NoError(t, json.Unmarshal(v1GenesisBz, &v1GenesisJSON))
+	delete(v1GenesisJSON["params"].(map[string]interface{}), "ibc_rate_limit")
+	delete(v1GenesisJSON["params"].(map[string]interface{}), "block_hook_max_failures")
+	delete(v1GenesisJSON["params"].(map[string]interface{}), "block_hook_max_gas_limit")
+	v1GenesisBz, err = json.Marshal(v1GenesisJSON)
+	require.NoError(t, err)
+	// the block hook params were added in version 2
+	v1Params := DefaultParams()
+	v1Params.BlockHookMaxFailures = 0
+	v1Params.BlockHookMaxGasLimit = 0
+
+	specs := map[string]struct {
+		src      []byte
+		source   uint64
+		target   uint64
+		expState *GenesisState
+		expErr   bool
+	}{
+		"legacy sample genesis to latest": {
+			src:      sampleGenesis,
+			source:   GenesisVersionLegacy,
+			target:   LatestGenesisVersion,
+			expState: &GenesisState{Params: DefaultParams()},
+		},
+		"legacy sample genesis to version 1": {
+			src:      sampleGenesis,
+			source:   GenesisVersionLegacy,
+			target:   1,
+			expState: &GenesisState{Params: v1Params},
+		},
+		"legacy genesis with codes and contracts": {
+			src:    []byte(legacyGenesis),
+			source: GenesisVersionLegacy,
+			target: LatestGenesisVersion,
+			expState: &GenesisState{
+				Params: Params{
+					CodeUploadAccess:             AccessConfig{Permission: AccessTypeOnlyAddress, Address: myCreator},
+					InstantiateDefaultPermission: AccessTypeNobody,
+					BlockHookMaxFailures:         DefaultBlockHookMaxFailures,
+					BlockHookMaxGasLimit:         DefaultBlockHookMaxGasLimit,
+				},
+				Codes: []Code{{
+					CodeID: 1,
+					CodeInfo: CodeInfo{
+						CodeHash:          []byte{1, 2, 3},
+						Creator:           myCreator,
+						InstantiateConfig: AllowEverybody,
+					},
+					CodeBytes: []byte("\x00asm"),
+				}},
+				Contracts: []Contract{{
+					ContractAddress: myContract,
+					ContractInfo: ContractInfo{
+						CodeID:  1,
+						Creator: myCreator,
+						Label:   "my contract",
+					},
+					ContractState: []Model{{Key: []byte{1, 2}, Value: []byte{1, 2}}},
+				}},
+				Sequences: []Sequence{{IDKey: KeyLastCodeID, Value: 2}},
+			},
+		},
+		"version 1 to latest": {
+			src:      v1GenesisBz,
+			source:   1,
+			target:   LatestGenesisVersion,
+			expState: &v1Genesis,
+		},
+		"latest unchanged": {
+			src:      marshaler.MustMarshalJSON(&v1Genesis),
+			source:   LatestGenesisVersion,
+			target:   LatestGenesisVersion,
+			expState: &v1Genesis,
+		},
+		"unknown target version": {
+			src:    sampleGenesis,
+			source: GenesisVersionLegacy,
+			target: LatestGenesisVersion + 1,
+			expErr: true,
+		},
+		"downgrade": {
+			src:    v1GenesisBz,
+			source: LatestGenesisVersion,
+			target: 1,
+			expErr: true,
+		},
+		"legacy genesis with invalid access type": {
+			src:    []byte(`{"params": {"upload_access": {"type": 9, "address": ""}, "instantiate_default_permission": 1}}`),
+			source: GenesisVersionLegacy,
+			target: LatestGenesisVersion,
+			expErr: true,
+		},
+		"legacy genesis without params": {
+			src:    []byte(`{"codes": null}`),
+			source: GenesisVersionLegacy,
+			target: LatestGenesisVersion,
+			expErr: true,
+		},
+		"not json": {
+			src:    []byte("not json"),
+			source: 1,
+			target: LatestGenesisVersion,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotBz, gotErr := MigrateGenesisJSON(spec.src, spec.source, spec.target)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var got GenesisState
+			require.NoError(t, marshaler.UnmarshalJSON(gotBz, &got))
+			require.NoError(t, got.ValidateBasic())
+			assert.Equal(t, marshaler.MustMarshalJSON(spec.expState), marshaler.MustMarshalJSON(&got))
+		})
+	}
+}
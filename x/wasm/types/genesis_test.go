@@ -16,6 +16,9 @@ import (
 )
 
 func TestValidateGenesisState(t *testing.T) {
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{0x1}, ContractAddrLen)).String()
+	otherContractAddr := sdk.AccAddress(bytes.Repeat([]byte{0x2}, ContractAddrLen)).String()
+	adminAddr := sdk.AccAddress(bytes.Repeat([]byte{0x3}, SDKAddrLen)).String()
 	specs := map[string]struct {
 		srcMutator func(*GenesisState)
 		expError   bool
@@ -47,6 +50,197 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"block hooks valid": {
+			srcMutator: func(s *GenesisState) {
+				s.BlockHooks = []BlockHook{
+					{ContractAddress: s.Contracts[0].ContractAddress, Phase: BlockHookPhaseBeginBlock, GasLimit: 1},
+					{ContractAddress: s.Contracts[0].ContractAddress, Phase: BlockHookPhaseEndBlock, GasLimit: 1},
+				}
+			},
+		},
+		"block hook invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.BlockHooks = []BlockHook{{ContractAddress: s.Contracts[0].ContractAddress, Phase: BlockHookPhaseBeginBlock}}
+			},
+			expError: true,
+		},
+		"block hook gas limit above max": {
+			srcMutator: func(s *GenesisState) {
+				s.BlockHooks = []BlockHook{{ContractAddress: s.Contracts[0].ContractAddress, Phase: BlockHookPhaseBeginBlock, GasLimit: s.Params.BlockHookMaxGasLimit + 1}}
+			},
+			expError: true,
+		},
+		"block hook duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.BlockHooks = []BlockHook{
+					{ContractAddress: s.Contracts[0].ContractAddress, Phase: BlockHookPhaseBeginBlock, GasLimit: 1},
+					{ContractAddress: s.Contracts[0].ContractAddress, Phase: BlockHookPhaseBeginBlock, GasLimit: 2},
+				}
+			},
+			expError: true,
+		},
+		"code state limits valid": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeStateLimits = []CodeStateLimit{{CodeID: 1, MaxStateBytes: 1}, {CodeID: 2, MaxStateBytes: 2}}
+			},
+		},
+		"code state limit without max": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeStateLimits = []CodeStateLimit{{CodeID: 1}}
+			},
+			expError: true,
+		},
+		"code state limit duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeStateLimits = []CodeStateLimit{{CodeID: 1, MaxStateBytes: 1}, {CodeID: 1, MaxStateBytes: 2}}
+			},
+			expError: true,
+		},
+		"ibc rate limit overrides valid": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCRateLimitOverrides = []IBCRateLimitOverride{
+					{ContractAddress: contractAddr, Limit: IBCRateLimit{MaxPacketsPerBlock: 1}},
+					{ContractAddress: contractAddr, ChannelID: "channel-0"},
+				}
+			},
+		},
+		"ibc rate limit override invalid address": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCRateLimitOverrides = []IBCRateLimitOverride{{ContractAddress: "invalid"}}
+			},
+			expError: true,
+		},
+		"ibc rate limit override invalid channel": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCRateLimitOverrides = []IBCRateLimitOverride{{ContractAddress: contractAddr, ChannelID: "x"}}
+			},
+			expError: true,
+		},
+		"ibc rate limit override invalid limit": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCRateLimitOverrides = []IBCRateLimitOverride{{ContractAddress: contractAddr, Limit: IBCRateLimit{MaxBytesPerWindow: 1}}}
+			},
+			expError: true,
+		},
+		"ibc rate limit override duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCRateLimitOverrides = []IBCRateLimitOverride{
+					{ContractAddress: contractAddr, ChannelID: "channel-0"},
+					{ContractAddress: contractAddr, ChannelID: "channel-0", Limit: IBCRateLimit{MaxPacketsPerBlock: 1}},
+				}
+			},
+			expError: true,
+		},
+		"contract ante hooks valid": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractAnteHooks = []ContractAnteHook{
+					{ContractAddress: contractAddr, Hook: AnteHookAuthentication},
+					{ContractAddress: otherContractAddr, Hook: AnteHookAuthentication},
+				}
+			},
+		},
+		"contract ante hook invalid address": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractAnteHooks = []ContractAnteHook{{ContractAddress: "invalid", Hook: AnteHookAuthentication}}
+			},
+			expError: true,
+		},
+		"contract ante hook unspecified": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractAnteHooks = []ContractAnteHook{{ContractAddress: contractAddr}}
+			},
+			expError: true,
+		},
+		"contract ante hook duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractAnteHooks = []ContractAnteHook{
+					{ContractAddress: contractAddr, Hook: AnteHookAuthentication},
+					{ContractAddress: contractAddr, Hook: AnteHookAuthentication},
+				}
+			},
+			expError: true,
+		},
+		"pending admins valid": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdmins = []PendingAdmin{
+					{ContractAddress: contractAddr, NewAdmin: adminAddr},
+					{ContractAddress: otherContractAddr, NewAdmin: adminAddr, ExpiresAtHeight: 100},
+				}
+			},
+		},
+		"pending admin invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdmins = []PendingAdmin{{ContractAddress: contractAddr, NewAdmin: "invalid"}}
+			},
+			expError: true,
+		},
+		"pending admin duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdmins = []PendingAdmin{
+					{ContractAddress: contractAddr, NewAdmin: adminAddr},
+					{ContractAddress: contractAddr, NewAdmin: otherContractAddr},
+				}
+			},
+			expError: true,
+		},
+		"contract timelocks valid": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractTimelocks = []ContractTimelock{
+					{ContractAddress: contractAddr, DelayBlocks: 1},
+					{ContractAddress: otherContractAddr, DelayBlocks: 100},
+				}
+			},
+		},
+		"contract timelock without delay": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractTimelocks = []ContractTimelock{{ContractAddress: contractAddr}}
+			},
+			expError: true,
+		},
+		"contract timelock duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractTimelocks = []ContractTimelock{
+					{ContractAddress: contractAddr, DelayBlocks: 1},
+					{ContractAddress: contractAddr, DelayBlocks: 2},
+				}
+			},
+			expError: true,
+		},
+		"timelocked operations valid": {
+			srcMutator: func(s *GenesisState) {
+				s.TimelockedOperations = []TimelockedOperation{
+					{ID: 1, ContractAddress: contractAddr, Sender: adminAddr, ExecuteAtHeight: 10, Type: TimelockedOperationTypeMigrate, Migration: &TimelockedMigration{CodeID: 1, Msg: []byte(`{}`)}},
+					{ID: 2, ContractAddress: contractAddr, Sender: adminAddr, ExecuteAtHeight: 10, Type: TimelockedOperationTypeUpdateAdmin, NewAdmin: otherContractAddr},
+					{ID: 3, ContractAddress: contractAddr, Sender: adminAddr, ExecuteAtHeight: 10, Type: TimelockedOperationTypeClearAdmin},
+					{ID: 4, ContractAddress: contractAddr, Sender: adminAddr, ExecuteAtHeight: 10, Type: TimelockedOperationTypeSetTimelock},
+				}
+			},
+		},
+		"timelocked migration without msg": {
+			srcMutator: func(s *GenesisState) {
+				s.TimelockedOperations = []TimelockedOperation{
+					{ID: 1, ContractAddress: contractAddr, Sender: adminAddr, ExecuteAtHeight: 10, Type: TimelockedOperationTypeMigrate, Migration: &TimelockedMigration{CodeID: 1}},
+				}
+			},
+			expError: true,
+		},
+		"timelocked operation without type": {
+			srcMutator: func(s *GenesisState) {
+				s.TimelockedOperations = []TimelockedOperation{
+					{ID: 1, ContractAddress: contractAddr, Sender: adminAddr, ExecuteAtHeight: 10},
+				}
+			},
+			expError: true,
+		},
+		"timelocked operation duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.TimelockedOperations = []TimelockedOperation{
+					{ID: 1, ContractAddress: contractAddr, Sender: adminAddr, ExecuteAtHeight: 10, Type: TimelockedOperationTypeClearAdmin},
+					{ID: 1, ContractAddress: otherContractAddr, Sender: adminAddr, ExecuteAtHeight: 10, Type: TimelockedOperationTypeClearAdmin},
+				}
+			},
+			expError: true,
+		},
 		"genesis store code message invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.GenMsgs[0].GetStoreCode().WASMByteCode = nil
@@ -103,15 +297,20 @@ func TestCodeValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
-		"codeBytes empty": {
+		"codeBytes empty with checksum reference": {
 			srcMutator: func(c *Code) {
 				c.CodeBytes = []byte{}
 			},
-			expError: true,
 		},
-		"codeBytes nil": {
+		"codeBytes nil with checksum reference": {
+			srcMutator: func(c *Code) {
+				c.CodeBytes = nil
+			},
+		},
+		"codeBytes empty with invalid checksum reference": {
 			srcMutator: func(c *Code) {
 				c.CodeBytes = nil
+				c.CodeInfo.CodeHash = []byte{0x1}
 			},
 			expError: true,
 		},
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -112,3 +113,20 @@ func TestIsJSONObjectWithTopLevelKey(t *testing.T) {
 		})
 	}
 }
+
+func FuzzIsJSONObjectWithTopLevelKey(f *testing.F) {
+	f.Add([]byte(`{"msg": {"foo":"bar"}}`), "msg")
+	f.Add([]byte(`{"msg": {"foo":"bar"}, "other": 1}`), "msg")
+	f.Add([]byte(`[]`), "msg")
+	f.Add([]byte(`{}`), "")
+	f.Fuzz(func(t *testing.T, src []byte, allowedKey string) {
+		err := IsJSONObjectWithTopLevelKey(src, []string{allowedKey})
+		if err != nil {
+			return
+		}
+		var document map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(src, &document))
+		require.Len(t, document, 1)
+		require.Contains(t, document, allowedKey)
+	})
+}
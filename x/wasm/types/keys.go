@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/address"
 )
 
 const (
@@ -31,9 +32,24 @@ var (
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
 	TXCounterPrefix                                = []byte{0x08}
-
-	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
-	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	IBCPacketUsagePrefix                           = []byte{0x09}
+	BlockHookPrefix                                = []byte{0x0a}
+	ContractStateSizePrefix                        = []byte{0x0c}
+	StorageDepositPrefix                           = []byte{0x0d}
+	CodeStateLimitPrefix                           = []byte{0x0e}
+	PendingAdminPrefix                             = []byte{0x0f}
+	ContractTimelockPrefix                         = []byte{0x10}
+	TimelockedOperationPrefix                      = []byte{0x11}
+	TimelockedOperationQueuePrefix                 = []byte{0x12}
+	IBCRateLimitOverridePrefix                     = []byte{0x13}
+	IBCPacketUsagePruneQueuePrefix                 = []byte{0x14}
+	ContractAnteHookPrefix                         = []byte{0x15}
+	CodeReferenceSecondaryIndexPrefix              = []byte{0x16}
+	TimelockedMigrationSecondaryIndexPrefix        = []byte{0x17}
+
+	KeyLastCodeID                = append(SequenceKeyPrefix, []byte("lastCodeId")...)
+	KeyLastInstanceID            = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastTimelockedOperationID = append(SequenceKeyPrefix, []byte("lastTimelockedOperationId")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -105,3 +121,117 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetIBCPacketUsageKey returns the key for the raw IBC packets sent by a contract on a channel:
+// `<prefix><len(contractAddr)><contractAddr><channelID>`
+func GetIBCPacketUsageKey(contractAddr sdk.AccAddress, channelID string) []byte {
+	r := sdk.CopyBytes(IBCPacketUsagePrefix)
+	r = append(r, address.MustLengthPrefix(contractAddr)...)
+	return append(r, channelID...)
+}
+
+// GetIBCPacketUsagePruneQueueKey returns the key of the secondary index that orders the IBC packet usages by the
+// height from which they are outdated: `<prefix><pruneHeight><len(contractAddr)><contractAddr><channelID>`
+func GetIBCPacketUsagePruneQueueKey(pruneHeight int64, contractAddr sdk.AccAddress, channelID string) []byte {
+	r := GetIBCPacketUsagePruneQueuePrefix(pruneHeight)
+	r = append(r, address.MustLengthPrefix(contractAddr)...)
+	return append(r, channelID...)
+}
+
+// GetIBCPacketUsagePruneQueuePrefix returns the prefix of the IBC packet usages that are outdated from the height
+func GetIBCPacketUsagePruneQueuePrefix(pruneHeight int64) []byte {
+	return append(sdk.CopyBytes(IBCPacketUsagePruneQueuePrefix), sdk.Uint64ToBigEndian(uint64(pruneHeight))...)
+}
+
+// GetIBCRateLimitOverrideKey returns the key for the IBC rate limit override of a contract on a channel:
+// `<prefix><len(contractAddr)><contractAddr><channelID>`
+func GetIBCRateLimitOverrideKey(contractAddr sdk.AccAddress, channelID string) []byte {
+	r := sdk.CopyBytes(IBCRateLimitOverridePrefix)
+	r = append(r, address.MustLengthPrefix(contractAddr)...)
+	return append(r, channelID...)
+}
+
+// GetContractAnteHookKey returns the key for an ante hook that is enabled for a contract:
+// `<prefix><hook><contractAddr>`
+func GetContractAnteHookKey(hook AnteHook, contractAddr sdk.AccAddress) []byte {
+	return append(append(sdk.CopyBytes(ContractAnteHookPrefix), byte(hook)), contractAddr...)
+}
+
+// GetBlockHookPhasePrefix returns the store prefix for the block hooks of a phase
+func GetBlockHookPhasePrefix(phase BlockHookPhase) []byte {
+	return append(sdk.CopyBytes(BlockHookPrefix), byte(phase))
+}
+
+// GetBlockHookKey returns the key for the block hook of a contract in a phase:
+// `<prefix><phase><contractAddr>`
+func GetBlockHookKey(phase BlockHookPhase, contractAddr sdk.AccAddress) []byte {
+	return append(GetBlockHookPhasePrefix(phase), contractAddr...)
+}
+
+// GetContractStateSizeKey returns the key for the state size of a contract
+func GetContractStateSizeKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractStateSizePrefix, contractAddr...)
+}
+
+// GetStorageDepositKey returns the key for the storage deposit of a contract
+func GetStorageDepositKey(contractAddr sdk.AccAddress) []byte {
+	return append(StorageDepositPrefix, contractAddr...)
+}
+
+// GetCodeStateLimitKey returns the key for the max contract state bytes override of a code
+func GetCodeStateLimitKey(codeID uint64) []byte {
+	return append(CodeStateLimitPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetPendingAdminKey returns the key of the proposed admin of a contract
+func GetPendingAdminKey(contractAddr sdk.AccAddress) []byte {
+	return append(PendingAdminPrefix, contractAddr...)
+}
+
+// GetContractTimelockKey returns the key of the timelock of a contract
+func GetContractTimelockKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractTimelockPrefix, contractAddr...)
+}
+
+// GetTimelockedOperationPrefix returns the prefix of the queued operations of a contract
+func GetTimelockedOperationPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(TimelockedOperationPrefix, contractAddr...)
+}
+
+// GetTimelockedOperationKey returns the key of a queued operation of a contract
+func GetTimelockedOperationKey(contractAddr sdk.AccAddress, operationID uint64) []byte {
+	return append(GetTimelockedOperationPrefix(contractAddr), sdk.Uint64ToBigEndian(operationID)...)
+}
+
+// GetTimelockedOperationQueueKey returns the key of the secondary index that orders the queued operations by
+// their execution height
+func GetTimelockedOperationQueueKey(executeAtHeight, operationID uint64) []byte {
+	prefixLen := len(TimelockedOperationQueuePrefix)
+	r := make([]byte, prefixLen+8+8)
+	copy(r, TimelockedOperationQueuePrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(executeAtHeight))
+	copy(r[prefixLen+8:], sdk.Uint64ToBigEndian(operationID))
+	return r
+}
+
+// GetCodeReferenceSecondaryIndexPrefix returns the prefix of the secondary index of the codes that reference a
+// code as superseding code or in their migration policy: `<prefix><codeID>`
+func GetCodeReferenceSecondaryIndexPrefix(codeID uint64) []byte {
+	return append(sdk.CopyBytes(CodeReferenceSecondaryIndexPrefix), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeReferenceSecondaryIndexKey returns the key of the secondary index: `<prefix><codeID><referencingCodeID>`
+func GetCodeReferenceSecondaryIndexKey(codeID, referencingCodeID uint64) []byte {
+	return append(GetCodeReferenceSecondaryIndexPrefix(codeID), sdk.Uint64ToBigEndian(referencingCodeID)...)
+}
+
+// GetTimelockedMigrationSecondaryIndexPrefix returns the prefix of the secondary index of the queued migrations to
+// a code: `<prefix><codeID>`
+func GetTimelockedMigrationSecondaryIndexPrefix(codeID uint64) []byte {
+	return append(sdk.CopyBytes(TimelockedMigrationSecondaryIndexPrefix), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetTimelockedMigrationSecondaryIndexKey returns the key of the secondary index: `<prefix><codeID><operationID>`
+func GetTimelockedMigrationSecondaryIndexKey(codeID, operationID uint64) []byte {
+	return append(GetTimelockedMigrationSecondaryIndexPrefix(codeID), sdk.Uint64ToBigEndian(operationID)...)
+}
@@ -71,3 +71,28 @@ func TestGetContractByCreatedSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetIBCPacketUsageKey(t *testing.T) {
+	addr := bytes.Repeat([]byte{4}, 20)
+	got := GetIBCPacketUsageKey(addr, "channel-0")
+	exp := []byte{
+		9,                            // prefix
+		20,                           // address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		'c', 'h', 'a', 'n', 'n', 'e', 'l', '-', '0', // channel id
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetBlockHookKey(t *testing.T) {
+	addr := bytes.Repeat([]byte{4}, 20)
+	prefixBefore := bytes.Clone(BlockHookPrefix)
+	beginBlockKey := GetBlockHookKey(BlockHookPhaseBeginBlock, addr)
+	endBlockKey := GetBlockHookKey(BlockHookPhaseEndBlock, addr)
+
+	assert.Equal(t, append(append(bytes.Clone(prefixBefore), byte(BlockHookPhaseBeginBlock)), addr...), beginBlockKey)
+	assert.Equal(t, append(append(bytes.Clone(prefixBefore), byte(BlockHookPhaseEndBlock)), addr...), endBlockKey)
+	// the shared prefix is not modified
+	assert.Equal(t, prefixBefore, BlockHookPrefix)
+}
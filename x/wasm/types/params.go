@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
@@ -16,6 +17,13 @@ import (
 var (
 	ParamStoreKeyUploadAccess      = []byte("uploadAccess")
 	ParamStoreKeyInstantiateAccess = []byte("instantiateAccess")
+	ParamStoreKeyIBCRateLimit      = []byte("ibcRateLimit")
+	ParamStoreKeyBlockHookFailures = []byte("blockHookMaxFailures")
+	ParamStoreKeyBlockHookGasLimit = []byte("blockHookMaxGasLimit")
+	ParamStoreKeyStorageDeposit    = []byte("storageDepositPerByte")
+	ParamStoreKeyMaxStateBytes     = []byte("maxContractStateBytes")
+	ParamStoreKeyImmutableOverride = []byte("allowImmutableOverride")
+	ParamStoreKeyCodeVerifiers     = []byte("codeVerifiers")
 )
 
 var AllAccessTypes = []AccessType{
@@ -93,6 +101,13 @@ func (a AccessConfig) Equals(o AccessConfig) bool {
 	return a.Permission == o.Permission && a.Address == o.Address
 }
 
+// DefaultBlockHookMaxFailures is the default number of consecutive failed block hook
+// calls after which a contract is deregistered
+const DefaultBlockHookMaxFailures uint32 = 3
+
+// DefaultBlockHookMaxGasLimit is the default max gas limit of a block hook
+const DefaultBlockHookMaxGasLimit uint64 = 1_000_000
+
 var (
 	DefaultUploadAccess = AllowEverybody
 	AllowEverybody      = AccessConfig{Permission: AccessTypeEverybody}
@@ -109,6 +124,8 @@ func DefaultParams() Params {
 	return Params{
 		CodeUploadAccess:             AllowEverybody,
 		InstantiateDefaultPermission: AccessTypeEverybody,
+		BlockHookMaxFailures:         DefaultBlockHookMaxFailures,
+		BlockHookMaxGasLimit:         DefaultBlockHookMaxGasLimit,
 	}
 }
 
@@ -125,6 +142,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyUploadAccess, &p.CodeUploadAccess, validateAccessConfig),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstantiateAccess, &p.InstantiateDefaultPermission, validateAccessType),
+		paramtypes.NewParamSetPair(ParamStoreKeyIBCRateLimit, &p.IBCRateLimit, validateIBCRateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyBlockHookFailures, &p.BlockHookMaxFailures, validateBlockHookMaxFailures),
+		paramtypes.NewParamSetPair(ParamStoreKeyBlockHookGasLimit, &p.BlockHookMaxGasLimit, validateBlockHookMaxGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDeposit, &p.StorageDepositPerByte, validateStorageDepositPerByte),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxStateBytes, &p.MaxContractStateBytes, validateMaxContractStateBytes),
+		paramtypes.NewParamSetPair(ParamStoreKeyImmutableOverride, &p.AllowImmutableOverride, validateAllowImmutableOverride),
+		paramtypes.NewParamSetPair(ParamStoreKeyCodeVerifiers, &p.CodeVerifiers, validateCodeVerifiers),
 	}
 }
 
@@ -136,6 +160,15 @@ func (p Params) ValidateBasic() error {
 	if err := validateAccessConfig(p.CodeUploadAccess); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := p.IBCRateLimit.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "ibc rate limit")
+	}
+	if err := validateStorageDepositPerByte(p.StorageDepositPerByte); err != nil {
+		return errors.Wrap(err, "storage deposit per byte")
+	}
+	if err := validateCodeVerifiers(p.CodeVerifiers); err != nil {
+		return errors.Wrap(err, "code verifiers")
+	}
 	return nil
 }
 
@@ -147,6 +180,61 @@ func validateAccessConfig(i interface{}) error {
 	return v.ValidateBasic()
 }
 
+func validateIBCRateLimit(i interface{}) error {
+	v, ok := i.(IBCRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.ValidateBasic()
+}
+
+func validateBlockHookMaxFailures(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBlockHookMaxGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateStorageDepositPerByte(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateMaxContractStateBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAllowImmutableOverride(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateCodeVerifiers(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return nil
+	}
+	return assertValidAddresses(v)
+}
+
 func validateAccessType(i interface{}) error {
 	a, ok := i.(AccessType)
 	if !ok {
@@ -226,3 +314,24 @@ func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
 		panic("unknown type")
 	}
 }
+
+// ValidateBasic performs basic validation
+func (l IBCRateLimit) ValidateBasic() error {
+	if l.MaxBytesPerWindow != 0 && l.WindowBlocks == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "window blocks")
+	}
+	return nil
+}
+
+// IsDisabled returns true when no limit is set
+func (l IBCRateLimit) IsDisabled() bool {
+	return l.MaxPacketsPerBlock == 0 && l.MaxBytesPerWindow == 0
+}
+
+// ValidateIBCChannelID validates the channel of an IBC rate limit override. Empty is valid and stands for all channels.
+func ValidateIBCChannelID(channelID string) error {
+	if channelID == "" {
+		return nil
+	}
+	return sdkerrors.Wrap(host.ChannelIdentifierValidator(channelID), "channel")
+}
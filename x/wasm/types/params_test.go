@@ -146,6 +146,80 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with ibc rate limit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				IBCRateLimit:                 IBCRateLimit{MaxPacketsPerBlock: 1, MaxBytesPerWindow: 1024, WindowBlocks: 10},
+			},
+		},
+		"all good with ibc packets per block limit only": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				IBCRateLimit:                 IBCRateLimit{MaxPacketsPerBlock: 1},
+			},
+		},
+		"reject ibc bytes limit without window": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				IBCRateLimit:                 IBCRateLimit{MaxBytesPerWindow: 1024},
+			},
+			expErr: true,
+		},
+		"all good with storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			},
+		},
+		"reject invalid storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}},
+			},
+			expErr: true,
+		},
+		"all good with max contract state bytes": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				MaxContractStateBytes:        1024,
+			},
+		},
+		"all good with immutable override": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AllowImmutableOverride:       true,
+			},
+		},
+		"all good with code verifiers": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CodeVerifiers:                []string{anyAddress.String(), otherAddress.String()},
+			},
+		},
+		"reject invalid code verifier": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CodeVerifiers:                []string{invalidAddress},
+			},
+			expErr: true,
+		},
+		"reject duplicate code verifiers": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CodeVerifiers:                []string{anyAddress.String(), anyAddress.String()},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -209,7 +283,9 @@ func TestParamsUnmarshalJson(t *testing.T) {
 	}{
 		"defaults": {
 			src: `{"code_upload_access": {"permission": "Everybody"},
-				"instantiate_default_permission": "Everybody"}`,
+				"instantiate_default_permission": "Everybody",
+				"block_hook_max_failures": 3,
+				"block_hook_max_gas_limit": "1000000"}`,
 			exp: DefaultParams(),
 		},
 	}
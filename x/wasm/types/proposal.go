@@ -23,6 +23,14 @@ const (
 	ProposalTypePinCodes                ProposalType = "PinCodes"
 	ProposalTypeUnpinCodes              ProposalType = "UnpinCodes"
 	ProposalTypeUpdateInstantiateConfig ProposalType = "UpdateInstantiateConfig"
+	ProposalTypeRegisterBlockHook       ProposalType = "RegisterBlockHook"
+	ProposalTypeDeregisterBlockHook     ProposalType = "DeregisterBlockHook"
+	ProposalTypeSetCodeStatus           ProposalType = "SetCodeStatus"
+	ProposalTypePruneCodes              ProposalType = "PruneCodes"
+	ProposalTypeSetCodeStateLimit       ProposalType = "SetCodeStateLimit"
+	ProposalTypeSetMigrationPolicy      ProposalType = "SetMigrationPolicy"
+	ProposalTypeSetIBCRateLimit         ProposalType = "SetIBCRateLimit"
+	ProposalTypeSetContractAnteHook     ProposalType = "SetContractAnteHook"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -40,6 +48,14 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypePinCodes,
 	ProposalTypeUnpinCodes,
 	ProposalTypeUpdateInstantiateConfig,
+	ProposalTypeRegisterBlockHook,
+	ProposalTypeDeregisterBlockHook,
+	ProposalTypeSetCodeStatus,
+	ProposalTypePruneCodes,
+	ProposalTypeSetCodeStateLimit,
+	ProposalTypeSetMigrationPolicy,
+	ProposalTypeSetIBCRateLimit,
+	ProposalTypeSetContractAnteHook,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -71,6 +87,14 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypePinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUpdateInstantiateConfig))
+	govtypes.RegisterProposalType(string(ProposalTypeRegisterBlockHook))
+	govtypes.RegisterProposalType(string(ProposalTypeDeregisterBlockHook))
+	govtypes.RegisterProposalType(string(ProposalTypeSetCodeStatus))
+	govtypes.RegisterProposalType(string(ProposalTypePruneCodes))
+	govtypes.RegisterProposalType(string(ProposalTypeSetCodeStateLimit))
+	govtypes.RegisterProposalType(string(ProposalTypeSetMigrationPolicy))
+	govtypes.RegisterProposalType(string(ProposalTypeSetIBCRateLimit))
+	govtypes.RegisterProposalType(string(ProposalTypeSetContractAnteHook))
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -593,3 +617,308 @@ func (c AccessConfigUpdate) String() string {
   AccessConfig: %v
 `, c.CodeID, c.InstantiatePermission)
 }
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p RegisterBlockHookProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *RegisterBlockHookProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p RegisterBlockHookProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p RegisterBlockHookProposal) ProposalType() string {
+	return string(ProposalTypeRegisterBlockHook)
+}
+
+// ValidateBasic validates the proposal
+func (p RegisterBlockHookProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	return BlockHook{ContractAddress: p.Contract, Phase: p.Phase, GasLimit: p.GasLimit}.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p RegisterBlockHookProposal) String() string {
+	return fmt.Sprintf(`Register Block Hook Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Phase:       %s
+  Gas Limit:   %d
+`, p.Title, p.Description, p.Contract, p.Phase, p.GasLimit)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p DeregisterBlockHookProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *DeregisterBlockHookProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p DeregisterBlockHookProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p DeregisterBlockHookProposal) ProposalType() string {
+	return string(ProposalTypeDeregisterBlockHook)
+}
+
+// ValidateBasic validates the proposal
+func (p DeregisterBlockHookProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return p.Phase.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p DeregisterBlockHookProposal) String() string {
+	return fmt.Sprintf(`Deregister Block Hook Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Phase:       %s
+`, p.Title, p.Description, p.Contract, p.Phase)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetCodeStatusProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetCodeStatusProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetCodeStatusProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetCodeStatusProposal) ProposalType() string {
+	return string(ProposalTypeSetCodeStatus)
+}
+
+// ValidateBasic validates the proposal
+func (p SetCodeStatusProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id is required")
+	}
+	if p.SupersededByCodeID == p.CodeID {
+		return sdkerrors.Wrap(ErrInvalid, "code can not supersede itself")
+	}
+	return ValidateCodeStatus(p.Status, p.SupersededByCodeID)
+}
+
+// String implements the Stringer interface.
+func (p SetCodeStatusProposal) String() string {
+	return fmt.Sprintf(`Set Code Status Proposal:
+  Title:         %s
+  Description:   %s
+  Code ID:       %d
+  Status:        %s
+  Reason:        %s
+  Superseded By: %d
+`, p.Title, p.Description, p.CodeID, p.Status, p.Reason, p.SupersededByCodeID)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p PruneCodesProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *PruneCodesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p PruneCodesProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p PruneCodesProposal) ProposalType() string { return string(ProposalTypePruneCodes) }
+
+// ValidateBasic validates the proposal
+func (p PruneCodesProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	return validateCodeIDs(p.CodeIDs)
+}
+
+// String implements the Stringer interface.
+func (p PruneCodesProposal) String() string {
+	return fmt.Sprintf(`Prune Wasm Codes Proposal:
+  Title:       %s
+  Description: %s
+  Codes:       %v
+`, p.Title, p.Description, p.CodeIDs)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetCodeStateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetCodeStateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetCodeStateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetCodeStateLimitProposal) ProposalType() string {
+	return string(ProposalTypeSetCodeStateLimit)
+}
+
+// ValidateBasic validates the proposal
+func (p SetCodeStateLimitProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id is required")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SetCodeStateLimitProposal) String() string {
+	return fmt.Sprintf(`Set Code State Limit Proposal:
+  Title:           %s
+  Description:     %s
+  Code ID:         %d
+  Max State Bytes: %d
+`, p.Title, p.Description, p.CodeID, p.MaxStateBytes)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetMigrationPolicyProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetMigrationPolicyProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetMigrationPolicyProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetMigrationPolicyProposal) ProposalType() string {
+	return string(ProposalTypeSetMigrationPolicy)
+}
+
+// ValidateBasic validates the proposal
+func (p SetMigrationPolicyProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id is required")
+	}
+	return sdkerrors.Wrap(p.MigrationPolicy.ValidateBasic(), "migration policy")
+}
+
+// String implements the Stringer interface.
+func (p SetMigrationPolicyProposal) String() string {
+	return fmt.Sprintf(`Set Migration Policy Proposal:
+  Title:             %s
+  Description:       %s
+  Code ID:           %d
+  Allowed Code IDs:  %v
+  Allowed Checksums: %v
+  Deny All:          %t
+`, p.Title, p.Description, p.CodeID, p.MigrationPolicy.CodeIDs, p.MigrationPolicy.Checksums, p.MigrationPolicy.DenyAll)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetIBCRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetIBCRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetIBCRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetIBCRateLimitProposal) ProposalType() string {
+	return string(ProposalTypeSetIBCRateLimit)
+}
+
+// ValidateBasic validates the proposal
+func (p SetIBCRateLimitProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := ValidateIBCChannelID(p.ChannelID); err != nil {
+		return err
+	}
+	if p.Limit == nil {
+		return nil
+	}
+	return sdkerrors.Wrap(p.Limit.ValidateBasic(), "limit")
+}
+
+// String implements the Stringer interface.
+func (p SetIBCRateLimitProposal) String() string {
+	return fmt.Sprintf(`Set IBC Rate Limit Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Channel:     %s
+  Limit:       %v
+`, p.Title, p.Description, p.Contract, p.ChannelID, p.Limit)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetContractAnteHookProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetContractAnteHookProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetContractAnteHookProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetContractAnteHookProposal) ProposalType() string {
+	return string(ProposalTypeSetContractAnteHook)
+}
+
+// ValidateBasic validates the proposal
+func (p SetContractAnteHookProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return p.Hook.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p SetContractAnteHookProposal) String() string {
+	return fmt.Sprintf(`Set Contract Ante Hook Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Hook:        %s
+  Enabled:     %t
+`, p.Title, p.Description, p.Contract, p.Hook, p.Enabled)
+}
+
+// validateCodeIDs requires a non empty set of unique and non zero code ids
+func validateCodeIDs(codeIDs []uint64) error {
+	if len(codeIDs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code ids")
+	}
+	seen := make(map[uint64]struct{}, len(codeIDs))
+	for _, id := range codeIDs {
+		if id == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "code id must not be 0")
+		}
+		if _, ok := seen[id]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "code id: %d", id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
@@ -520,6 +520,393 @@ func TestValidateClearAdminProposal(t *testing.T) {
 	}
 }
 
+func TestValidateRegisterBlockHookProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *RegisterBlockHookProposal
+		expErr bool
+	}{
+		"all good": {
+			src: RegisterBlockHookProposalFixture(),
+		},
+		"begin block": {
+			src: RegisterBlockHookProposalFixture(func(p *RegisterBlockHookProposal) {
+				p.Phase = BlockHookPhaseBeginBlock
+			}),
+		},
+		"base data missing": {
+			src: RegisterBlockHookProposalFixture(func(p *RegisterBlockHookProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: RegisterBlockHookProposalFixture(func(p *RegisterBlockHookProposal) {
+				p.Contract = "invalid address"
+			}),
+			expErr: true,
+		},
+		"phase unspecified": {
+			src: RegisterBlockHookProposalFixture(func(p *RegisterBlockHookProposal) {
+				p.Phase = BlockHookPhaseUnspecified
+			}),
+			expErr: true,
+		},
+		"phase unknown": {
+			src: RegisterBlockHookProposalFixture(func(p *RegisterBlockHookProposal) {
+				p.Phase = 3
+			}),
+			expErr: true,
+		},
+		"gas limit missing": {
+			src: RegisterBlockHookProposalFixture(func(p *RegisterBlockHookProposal) {
+				p.GasLimit = 0
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDeregisterBlockHookProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *DeregisterBlockHookProposal
+		expErr bool
+	}{
+		"all good": {
+			src: DeregisterBlockHookProposalFixture(),
+		},
+		"base data missing": {
+			src: DeregisterBlockHookProposalFixture(func(p *DeregisterBlockHookProposal) {
+				p.Description = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: DeregisterBlockHookProposalFixture(func(p *DeregisterBlockHookProposal) {
+				p.Contract = "invalid address"
+			}),
+			expErr: true,
+		},
+		"phase unspecified": {
+			src: DeregisterBlockHookProposalFixture(func(p *DeregisterBlockHookProposal) {
+				p.Phase = BlockHookPhaseUnspecified
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetCodeStatusProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *SetCodeStatusProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetCodeStatusProposalFixture(),
+		},
+		"with superseding code": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.Status = CodeStatusRevoked
+				p.SupersededByCodeID = 2
+			}),
+		},
+		"active": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.Status = CodeStatusActive
+			}),
+		},
+		"base data missing": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"code id missing": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.CodeID = 0
+			}),
+			expErr: true,
+		},
+		"status unknown": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.Status = 3
+			}),
+			expErr: true,
+		},
+		"active and superseded": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.Status = CodeStatusActive
+				p.SupersededByCodeID = 2
+			}),
+			expErr: true,
+		},
+		"superseded by itself": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.SupersededByCodeID = p.CodeID
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetCodeStateLimitProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *SetCodeStateLimitProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetCodeStateLimitProposalFixture(),
+		},
+		"remove override": {
+			src: SetCodeStateLimitProposalFixture(func(p *SetCodeStateLimitProposal) {
+				p.MaxStateBytes = 0
+			}),
+		},
+		"base data missing": {
+			src: SetCodeStateLimitProposalFixture(func(p *SetCodeStateLimitProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"code id missing": {
+			src: SetCodeStateLimitProposalFixture(func(p *SetCodeStateLimitProposal) {
+				p.CodeID = 0
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetIBCRateLimitProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *SetIBCRateLimitProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetIBCRateLimitProposalFixture(),
+		},
+		"all channels": {
+			src: SetIBCRateLimitProposalFixture(func(p *SetIBCRateLimitProposal) {
+				p.ChannelID = ""
+			}),
+		},
+		"remove override": {
+			src: SetIBCRateLimitProposalFixture(func(p *SetIBCRateLimitProposal) {
+				p.Limit = nil
+			}),
+		},
+		"base data missing": {
+			src: SetIBCRateLimitProposalFixture(func(p *SetIBCRateLimitProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: SetIBCRateLimitProposalFixture(func(p *SetIBCRateLimitProposal) {
+				p.Contract = "invalid"
+			}),
+			expErr: true,
+		},
+		"channel invalid": {
+			src: SetIBCRateLimitProposalFixture(func(p *SetIBCRateLimitProposal) {
+				p.ChannelID = "x"
+			}),
+			expErr: true,
+		},
+		"limit invalid": {
+			src: SetIBCRateLimitProposalFixture(func(p *SetIBCRateLimitProposal) {
+				p.Limit = &IBCRateLimit{MaxBytesPerWindow: 1}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetContractAnteHookProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *SetContractAnteHookProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetContractAnteHookProposalFixture(),
+		},
+		"disable": {
+			src: SetContractAnteHookProposalFixture(func(p *SetContractAnteHookProposal) {
+				p.Enabled = false
+			}),
+		},
+		"fee sponsorship": {
+			src: SetContractAnteHookProposalFixture(func(p *SetContractAnteHookProposal) {
+				p.Hook = AnteHookFeeSponsorship
+			}),
+		},
+		"base data missing": {
+			src: SetContractAnteHookProposalFixture(func(p *SetContractAnteHookProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: SetContractAnteHookProposalFixture(func(p *SetContractAnteHookProposal) {
+				p.Contract = "invalid"
+			}),
+			expErr: true,
+		},
+		"hook unspecified": {
+			src: SetContractAnteHookProposalFixture(func(p *SetContractAnteHookProposal) {
+				p.Hook = AnteHookUnspecified
+			}),
+			expErr: true,
+		},
+		"hook unknown": {
+			src: SetContractAnteHookProposalFixture(func(p *SetContractAnteHookProposal) {
+				p.Hook = 99
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetMigrationPolicyProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *SetMigrationPolicyProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetMigrationPolicyProposalFixture(),
+		},
+		"remove policy": {
+			src: SetMigrationPolicyProposalFixture(func(p *SetMigrationPolicyProposal) {
+				p.MigrationPolicy = MigrationPolicy{}
+			}),
+		},
+		"base data missing": {
+			src: SetMigrationPolicyProposalFixture(func(p *SetMigrationPolicyProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"code id missing": {
+			src: SetMigrationPolicyProposalFixture(func(p *SetMigrationPolicyProposal) {
+				p.CodeID = 0
+			}),
+			expErr: true,
+		},
+		"invalid policy": {
+			src: SetMigrationPolicyProposalFixture(func(p *SetMigrationPolicyProposal) {
+				p.MigrationPolicy.CodeIDs = []uint64{0}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePruneCodesProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    PruneCodesProposal
+		expErr bool
+	}{
+		"all good": {
+			src: PruneCodesProposal{Title: "Foo", Description: "Bar", CodeIDs: []uint64{1, 2}},
+		},
+		"base data missing": {
+			src:    PruneCodesProposal{Description: "Bar", CodeIDs: []uint64{1}},
+			expErr: true,
+		},
+		"code ids empty": {
+			src:    PruneCodesProposal{Title: "Foo", Description: "Bar"},
+			expErr: true,
+		},
+		"code id zero": {
+			src:    PruneCodesProposal{Title: "Foo", Description: "Bar", CodeIDs: []uint64{0}},
+			expErr: true,
+		},
+		"duplicate code ids": {
+			src:    PruneCodesProposal{Title: "Foo", Description: "Bar", CodeIDs: []uint64{1, 1}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
@@ -602,6 +989,38 @@ func TestProposalStrings(t *testing.T) {
   Title:       Foo
   Description: Bar
   Contract:    link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+`,
+		},
+		"register block hook": {
+			src: RegisterBlockHookProposalFixture(),
+			exp: `Register Block Hook Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+  Phase:       BLOCK_HOOK_PHASE_END_BLOCK
+  Gas Limit:   100000
+`,
+		},
+		"deregister block hook": {
+			src: DeregisterBlockHookProposalFixture(),
+			exp: `Deregister Block Hook Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+  Phase:       BLOCK_HOOK_PHASE_END_BLOCK
+`,
+		},
+		"set code status": {
+			src: SetCodeStatusProposalFixture(func(p *SetCodeStatusProposal) {
+				p.SupersededByCodeID = 2
+			}),
+			exp: `Set Code Status Proposal:
+  Title:         Foo
+  Description:   Bar
+  Code ID:       1
+  Status:        CODE_STATUS_DEPRECATED
+  Reason:        security issue
+  Superseded By: 2
 `,
 		},
 		"pin codes": {
@@ -614,6 +1033,18 @@ func TestProposalStrings(t *testing.T) {
   Title:       Foo
   Description: Bar
   Codes:       [1 2 3]
+`,
+		},
+		"prune codes": {
+			src: &PruneCodesProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				CodeIDs:     []uint64{1, 2, 3},
+			},
+			exp: `Prune Wasm Codes Proposal:
+  Title:       Foo
+  Description: Bar
+  Codes:       [1 2 3]
 `,
 		},
 		"unpin codes": {
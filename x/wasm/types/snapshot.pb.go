@@ -0,0 +1,1101 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmwasm/wasm/v1/snapshot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SnapshotPayload is the envelope of a single wasm extension snapshot item in
+// format 2. A snapshot contains one code item per unique wasm checksum followed
+// by exactly one manifest item.
+type SnapshotPayload struct {
+	// sum is a single snapshot item
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*SnapshotPayload_Code
+	//	*SnapshotPayload_Manifest
+	Sum isSnapshotPayload_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *SnapshotPayload) Reset()         { *m = SnapshotPayload{} }
+func (m *SnapshotPayload) String() string { return proto.CompactTextString(m) }
+func (*SnapshotPayload) ProtoMessage()    {}
+func (*SnapshotPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30e16e05eef410e7, []int{0}
+}
+
+func (m *SnapshotPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SnapshotPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SnapshotPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotPayload.Merge(m, src)
+}
+
+func (m *SnapshotPayload) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SnapshotPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotPayload proto.InternalMessageInfo
+
+type isSnapshotPayload_Sum interface {
+	isSnapshotPayload_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SnapshotPayload_Code struct {
+	Code *SnapshotCode `protobuf:"bytes,1,opt,name=code,proto3,oneof" json:"code,omitempty"`
+}
+type SnapshotPayload_Manifest struct {
+	Manifest *SnapshotManifest `protobuf:"bytes,2,opt,name=manifest,proto3,oneof" json:"manifest,omitempty"`
+}
+
+func (*SnapshotPayload_Code) isSnapshotPayload_Sum()     {}
+func (*SnapshotPayload_Manifest) isSnapshotPayload_Sum() {}
+
+func (m *SnapshotPayload) GetSum() isSnapshotPayload_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *SnapshotPayload) GetCode() *SnapshotCode {
+	if x, ok := m.GetSum().(*SnapshotPayload_Code); ok {
+		return x.Code
+	}
+	return nil
+}
+
+func (m *SnapshotPayload) GetManifest() *SnapshotManifest {
+	if x, ok := m.GetSum().(*SnapshotPayload_Manifest); ok {
+		return x.Manifest
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SnapshotPayload) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SnapshotPayload_Code)(nil),
+		(*SnapshotPayload_Manifest)(nil),
+	}
+}
+
+// SnapshotCode contains the wasm byte code for a checksum with its metadata
+type SnapshotCode struct {
+	// Checksum is the sha256 hash of the uncompressed wasm byte code
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// CodeIDs are all code ids that reference the checksum
+	CodeIDs []uint64 `protobuf:"varint,2,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// Pinned is true when any of the code ids is pinned to the wasmvm cache
+	Pinned bool `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// WASMByteCode is the gzipped wasm byte code
+	WASMByteCode []byte `protobuf:"bytes,4,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+}
+
+func (m *SnapshotCode) Reset()         { *m = SnapshotCode{} }
+func (m *SnapshotCode) String() string { return proto.CompactTextString(m) }
+func (*SnapshotCode) ProtoMessage()    {}
+func (*SnapshotCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30e16e05eef410e7, []int{1}
+}
+
+func (m *SnapshotCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SnapshotCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SnapshotCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotCode.Merge(m, src)
+}
+
+func (m *SnapshotCode) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SnapshotCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotCode proto.InternalMessageInfo
+
+func (m *SnapshotCode) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *SnapshotCode) GetCodeIDs() []uint64 {
+	if m != nil {
+		return m.CodeIDs
+	}
+	return nil
+}
+
+func (m *SnapshotCode) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func (m *SnapshotCode) GetWASMByteCode() []byte {
+	if m != nil {
+		return m.WASMByteCode
+	}
+	return nil
+}
+
+// SnapshotManifest is the trailing snapshot item that is verified on restore
+type SnapshotManifest struct {
+	// CodeCount is the total number of code ids in the snapshot
+	CodeCount uint64 `protobuf:"varint,1,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
+	// Checksums of all code items in snapshot order
+	Checksums [][]byte `protobuf:"bytes,2,rep,name=checksums,proto3" json:"checksums,omitempty"`
+}
+
+func (m *SnapshotManifest) Reset()         { *m = SnapshotManifest{} }
+func (m *SnapshotManifest) String() string { return proto.CompactTextString(m) }
+func (*SnapshotManifest) ProtoMessage()    {}
+func (*SnapshotManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30e16e05eef410e7, []int{2}
+}
+
+func (m *SnapshotManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SnapshotManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SnapshotManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotManifest.Merge(m, src)
+}
+
+func (m *SnapshotManifest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SnapshotManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotManifest proto.InternalMessageInfo
+
+func (m *SnapshotManifest) GetCodeCount() uint64 {
+	if m != nil {
+		return m.CodeCount
+	}
+	return 0
+}
+
+func (m *SnapshotManifest) GetChecksums() [][]byte {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SnapshotPayload)(nil), "cosmwasm.wasm.v1.SnapshotPayload")
+	proto.RegisterType((*SnapshotCode)(nil), "cosmwasm.wasm.v1.SnapshotCode")
+	proto.RegisterType((*SnapshotManifest)(nil), "cosmwasm.wasm.v1.SnapshotManifest")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1/snapshot.proto", fileDescriptor_30e16e05eef410e7) }
+
+var fileDescriptor_30e16e05eef410e7 = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x6b, 0xe2, 0x40,
+	0x14, 0xc7, 0x33, 0x9a, 0xd5, 0x38, 0x86, 0x5d, 0x19, 0x96, 0x25, 0xc8, 0x6e, 0x94, 0x1c, 0xc4,
+	0x53, 0x82, 0xbb, 0xcb, 0x9e, 0xdd, 0x58, 0x8a, 0x1e, 0xa4, 0x65, 0x3c, 0x14, 0x7a, 0x91, 0x98,
+	0x4c, 0x4d, 0x68, 0x93, 0x09, 0x9d, 0x89, 0x6d, 0xbe, 0x85, 0x9f, 0xa1, 0x9f, 0xa6, 0x47, 0x8f,
+	0x3d, 0x49, 0x89, 0x5f, 0xa4, 0xcc, 0x68, 0x6c, 0x11, 0x7a, 0x19, 0xe6, 0xbd, 0xf7, 0xff, 0xbf,
+	0xf7, 0x7b, 0x3c, 0xd8, 0xf1, 0x29, 0x8b, 0x1f, 0x3c, 0x16, 0x3b, 0xf2, 0x59, 0x0d, 0x1c, 0x96,
+	0x78, 0x29, 0x0b, 0x29, 0xb7, 0xd3, 0x7b, 0xca, 0x29, 0x6a, 0x95, 0x02, 0x5b, 0x3e, 0xab, 0x41,
+	0xfb, 0xfb, 0x92, 0x2e, 0xa9, 0x2c, 0x3a, 0xe2, 0xb7, 0xd7, 0x59, 0x6b, 0x00, 0xbf, 0xcd, 0x0e,
+	0xd6, 0x4b, 0x2f, 0xbf, 0xa3, 0x5e, 0x80, 0xfe, 0x42, 0xd5, 0xa7, 0x01, 0x31, 0x40, 0x17, 0xf4,
+	0x9b, 0xbf, 0x4d, 0xfb, 0xb4, 0x95, 0x5d, 0x1a, 0x46, 0x34, 0x20, 0x63, 0x05, 0x4b, 0x35, 0x1a,
+	0x42, 0x2d, 0xf6, 0x92, 0xe8, 0x86, 0x30, 0x6e, 0x54, 0xa4, 0xd3, 0xfa, 0xdc, 0x39, 0x3d, 0x28,
+	0xc7, 0x0a, 0x3e, 0xba, 0xdc, 0x2f, 0xb0, 0xca, 0xb2, 0xd8, 0x7a, 0x02, 0x50, 0xff, 0x38, 0x01,
+	0xb5, 0xa1, 0xe6, 0x87, 0xc4, 0xbf, 0x65, 0x59, 0x2c, 0x99, 0x74, 0x7c, 0x8c, 0x51, 0x0f, 0x6a,
+	0x62, 0xfa, 0x3c, 0x0a, 0x98, 0x51, 0xe9, 0x56, 0xfb, 0xaa, 0xdb, 0x2c, 0xb6, 0x9d, 0xba, 0xf0,
+	0x4d, 0xce, 0x18, 0xae, 0x8b, 0xe2, 0x24, 0x60, 0xe8, 0x07, 0xac, 0xa5, 0x51, 0x92, 0x90, 0xc0,
+	0xa8, 0x76, 0x41, 0x5f, 0xc3, 0x87, 0x08, 0xfd, 0x83, 0x5f, 0x05, 0xdb, 0x7c, 0x91, 0x73, 0x32,
+	0x97, 0x5b, 0xab, 0x62, 0x82, 0xdb, 0x2a, 0xb6, 0x1d, 0xfd, 0xea, 0xff, 0x6c, 0xea, 0xe6, 0x9c,
+	0x88, 0x6e, 0x58, 0x17, 0xba, 0x32, 0xb2, 0x2e, 0x60, 0xeb, 0x74, 0x17, 0xf4, 0x0b, 0x42, 0xc9,
+	0xe2, 0xd3, 0x2c, 0xe1, 0x92, 0x54, 0xc5, 0x0d, 0x91, 0x19, 0x89, 0x04, 0xfa, 0x09, 0x1b, 0x25,
+	0xf6, 0x9e, 0x55, 0xc7, 0xef, 0x09, 0x77, 0xf8, 0x5c, 0x98, 0x60, 0x53, 0x98, 0xe0, 0xb5, 0x30,
+	0xc1, 0x7a, 0x67, 0x2a, 0x9b, 0x9d, 0xa9, 0xbc, 0xec, 0x4c, 0xe5, 0xba, 0xb7, 0x8c, 0x78, 0x98,
+	0x2d, 0x6c, 0x9f, 0xc6, 0xce, 0x79, 0x94, 0x30, 0x3f, 0x8c, 0x3c, 0x79, 0xf6, 0xc0, 0x79, 0xdc,
+	0x9f, 0x9f, 0xe7, 0x29, 0x61, 0x8b, 0x9a, 0xbc, 0xe8, 0x9f, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xc5, 0x09, 0x7f, 0x7b, 0x1c, 0x02, 0x00, 0x00,
+}
+
+func (m *SnapshotPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotPayload_Code) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPayload_Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Code != nil {
+		{
+			size, err := m.Code.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotPayload_Manifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPayload_Manifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Manifest != nil {
+		{
+			size, err := m.Manifest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WASMByteCode) > 0 {
+		i -= len(m.WASMByteCode)
+		copy(dAtA[i:], m.WASMByteCode)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.WASMByteCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA4 := make([]byte, len(m.CodeIDs)*10)
+		var j3 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSnapshot(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CodeCount != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.CodeCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *SnapshotPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *SnapshotPayload_Code) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != nil {
+		l = m.Code.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotPayload_Manifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Manifest != nil {
+		l = m.Manifest.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovSnapshot(uint64(e))
+		}
+		n += 1 + sovSnapshot(uint64(l)) + l
+	}
+	if m.Pinned {
+		n += 2
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeCount != 0 {
+		n += 1 + sovSnapshot(uint64(m.CodeCount))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *SnapshotPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotCode{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &SnapshotPayload_Code{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotManifest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &SnapshotPayload_Manifest{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SnapshotCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSnapshot
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SnapshotManifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeCount", wireType)
+			}
+			m.CodeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
@@ -329,3 +329,102 @@ func ClearAdminProposalFixture(mutators ...func(p *ClearAdminProposal)) *ClearAd
 	}
 	return p
 }
+
+func RegisterBlockHookProposalFixture(mutators ...func(p *RegisterBlockHookProposal)) *RegisterBlockHookProposal {
+	const contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+	p := &RegisterBlockHookProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		Phase:       BlockHookPhaseEndBlock,
+		GasLimit:    100_000,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func DeregisterBlockHookProposalFixture(mutators ...func(p *DeregisterBlockHookProposal)) *DeregisterBlockHookProposal {
+	const contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+	p := &DeregisterBlockHookProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		Phase:       BlockHookPhaseEndBlock,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func SetCodeStatusProposalFixture(mutators ...func(p *SetCodeStatusProposal)) *SetCodeStatusProposal {
+	p := &SetCodeStatusProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		CodeID:      1,
+		Status:      CodeStatusDeprecated,
+		Reason:      "security issue",
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func SetCodeStateLimitProposalFixture(mutators ...func(p *SetCodeStateLimitProposal)) *SetCodeStateLimitProposal {
+	p := &SetCodeStateLimitProposal{
+		Title:         "Foo",
+		Description:   "Bar",
+		CodeID:        1,
+		MaxStateBytes: 1024,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func SetIBCRateLimitProposalFixture(mutators ...func(p *SetIBCRateLimitProposal)) *SetIBCRateLimitProposal {
+	const anyAddress = "link14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sgf2vn8"
+	p := &SetIBCRateLimitProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    anyAddress,
+		ChannelID:   "channel-0",
+		Limit:       &IBCRateLimit{MaxPacketsPerBlock: 1},
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func SetContractAnteHookProposalFixture(mutators ...func(p *SetContractAnteHookProposal)) *SetContractAnteHookProposal {
+	const anyAddress = "link14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sgf2vn8"
+	p := &SetContractAnteHookProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    anyAddress,
+		Hook:        AnteHookAuthentication,
+		Enabled:     true,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func SetMigrationPolicyProposalFixture(mutators ...func(p *SetMigrationPolicyProposal)) *SetMigrationPolicyProposal {
+	p := &SetMigrationPolicyProposal{
+		Title:           "Foo",
+		Description:     "Bar",
+		CodeID:          1,
+		MigrationPolicy: MigrationPolicy{CodeIDs: []uint64{2}},
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"strings"
@@ -63,6 +64,11 @@ func (msg MsgStoreCode) ValidateBasic() error {
 			return sdkerrors.Wrap(err, "instantiate permission")
 		}
 	}
+	if msg.BuildMetadata != nil {
+		if err := msg.BuildMetadata.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "build metadata")
+		}
+	}
 	return nil
 }
 
@@ -107,6 +113,9 @@ func (msg MsgInstantiateContract) ValidateBasic() error {
 		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
 			return sdkerrors.Wrap(err, "admin")
 		}
+		if msg.Immutable {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "immutable contract can not have an admin")
+		}
 	}
 	if err := msg.Msg.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "payload msg")
@@ -267,6 +276,318 @@ func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+func (msg MsgProposeAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgProposeAdmin) Type() string {
+	return "propose-contract-admin"
+}
+
+func (msg MsgProposeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	if strings.EqualFold(msg.Sender, msg.NewAdmin) {
+		return sdkerrors.Wrap(ErrInvalidMsg, "new admin is the same as the old")
+	}
+	return nil
+}
+
+func (msg MsgProposeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgProposeAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgAcceptAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAcceptAdmin) Type() string {
+	return "accept-contract-admin"
+}
+
+func (msg MsgAcceptAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelAdminProposal) Type() string {
+	return "cancel-contract-admin-proposal"
+}
+
+func (msg MsgCancelAdminProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelAdminProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelAdminProposal) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgSetContractTimelock) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractTimelock) Type() string {
+	return "set-contract-timelock"
+}
+
+func (msg MsgSetContractTimelock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return ValidateTimelockDelay(msg.DelayBlocks)
+}
+
+func (msg MsgSetContractTimelock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetContractTimelock) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelTimelockedOperation) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelTimelockedOperation) Type() string {
+	return "cancel-timelocked-operation"
+}
+
+func (msg MsgCancelTimelockedOperation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if msg.OperationID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "operation id")
+	}
+	return nil
+}
+
+func (msg MsgCancelTimelockedOperation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelTimelockedOperation) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgVerifyCode) Route() string {
+	return RouterKey
+}
+
+func (msg MsgVerifyCode) Type() string {
+	return "verify-code"
+}
+
+func (msg MsgVerifyCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if len(msg.Checksum) != sha256.Size {
+		return sdkerrors.Wrap(ErrInvalid, "checksum length")
+	}
+	return nil
+}
+
+func (msg MsgVerifyCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgVerifyCode) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgMakeContractImmutable) Route() string {
+	return RouterKey
+}
+
+func (msg MsgMakeContractImmutable) Type() string {
+	return "make-contract-immutable"
+}
+
+func (msg MsgMakeContractImmutable) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgMakeContractImmutable) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMakeContractImmutable) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgSetCodeStatus) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetCodeStatus) Type() string {
+	return "set-code-status"
+}
+
+func (msg MsgSetCodeStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id is required")
+	}
+	if msg.SupersededByCodeID == msg.CodeID {
+		return sdkerrors.Wrap(ErrInvalid, "code can not supersede itself")
+	}
+	return ValidateCodeStatus(msg.Status, msg.SupersededByCodeID)
+}
+
+func (msg MsgSetCodeStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetCodeStatus) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgPruneCodes) Route() string {
+	return RouterKey
+}
+
+func (msg MsgPruneCodes) Type() string {
+	return "prune-codes"
+}
+
+func (msg MsgPruneCodes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	return validateCodeIDs(msg.CodeIDs)
+}
+
+func (msg MsgPruneCodes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPruneCodes) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgSetMigrationPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetMigrationPolicy) Type() string {
+	return "set-migration-policy"
+}
+
+func (msg MsgSetMigrationPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id is required")
+	}
+	return sdkerrors.Wrap(msg.MigrationPolicy.ValidateBasic(), "migration policy")
+}
+
+func (msg MsgSetMigrationPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetMigrationPolicy) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgIBCSend) Route() string {
 	return RouterKey
 }
@@ -338,6 +659,9 @@ func (msg MsgInstantiateContract2) ValidateBasic() error {
 		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
 			return sdkerrors.Wrap(err, "admin")
 		}
+		if msg.Immutable {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "immutable contract can not have an admin")
+		}
 	}
 	if err := msg.Msg.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "payload msg")
@@ -345,6 +669,11 @@ func (msg MsgInstantiateContract2) ValidateBasic() error {
 	if err := ValidateSalt(msg.Salt); err != nil {
 		return sdkerrors.Wrap(err, "salt")
 	}
+	if len(msg.AddressGenerator) != 0 {
+		if err := ValidateAddressGeneratorName(msg.AddressGenerator); err != nil {
+			return sdkerrors.Wrap(err, "address generator")
+		}
+	}
 	return nil
 }
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"math"
 	"strings"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/auth/legacy/legacytx"
+	tmBytes "github.com/Finschia/ostracon/libs/bytes"
 )
 
 const firstCodeID = 1
@@ -64,6 +66,22 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"with build metadata": {
+			msg: MsgStoreCode{
+				Sender:        goodAddress,
+				WASMByteCode:  []byte("foo"),
+				BuildMetadata: &CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"},
+			},
+			valid: true,
+		},
+		"invalid build metadata": {
+			msg: MsgStoreCode{
+				Sender:        goodAddress,
+				WASMByteCode:  []byte("foo"),
+				BuildMetadata: &CodeBuildMetadata{Commit: "1234abcd"},
+			},
+			valid: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -144,6 +162,27 @@ func TestInstantiateContractValidation(t *testing.T) {
 			},
 			valid: true,
 		},
+		"immutable": {
+			msg: MsgInstantiateContract{
+				Sender:    goodAddress,
+				CodeID:    firstCodeID,
+				Label:     "foo",
+				Msg:       []byte("{}"),
+				Immutable: true,
+			},
+			valid: true,
+		},
+		"immutable with admin": {
+			msg: MsgInstantiateContract{
+				Sender:    goodAddress,
+				Admin:     goodAddress,
+				CodeID:    firstCodeID,
+				Label:     "foo",
+				Msg:       []byte("{}"),
+				Immutable: true,
+			},
+			valid: false,
+		},
 		"negative funds": {
 			msg: MsgInstantiateContract{
 				Sender: goodAddress,
@@ -212,6 +251,29 @@ func TestInstantiateContract2Validation(t *testing.T) {
 			},
 			valid: true,
 		},
+		"immutable": {
+			msg: MsgInstantiateContract2{
+				Sender:    goodAddress,
+				CodeID:    firstCodeID,
+				Label:     "foo",
+				Msg:       []byte("{}"),
+				Salt:      []byte{0},
+				Immutable: true,
+			},
+			valid: true,
+		},
+		"immutable with admin": {
+			msg: MsgInstantiateContract2{
+				Sender:    goodAddress,
+				Admin:     goodAddress,
+				CodeID:    firstCodeID,
+				Label:     "foo",
+				Msg:       []byte("{}"),
+				Salt:      []byte{0},
+				Immutable: true,
+			},
+			valid: false,
+		},
 		"missing code": {
 			msg: MsgInstantiateContract2{
 				Sender: goodAddress,
@@ -308,6 +370,38 @@ func TestInstantiateContract2Validation(t *testing.T) {
 				Salt:   bytes.Repeat([]byte{0}, 65),
 			},
 			valid: false,
+		}, "with address generator": {
+			msg: MsgInstantiateContract2{
+				Sender:           goodAddress,
+				CodeID:           firstCodeID,
+				Label:            "foo",
+				Msg:              []byte(`{"some": "data"}`),
+				Salt:             []byte{0},
+				AddressGenerator: "my-generator_1",
+			},
+			valid: true,
+		},
+		"invalid address generator": {
+			msg: MsgInstantiateContract2{
+				Sender:           goodAddress,
+				CodeID:           firstCodeID,
+				Label:            "foo",
+				Msg:              []byte(`{"some": "data"}`),
+				Salt:             []byte{0},
+				AddressGenerator: "My Generator",
+			},
+			valid: false,
+		},
+		"address generator too long": {
+			msg: MsgInstantiateContract2{
+				Sender:           goodAddress,
+				CodeID:           firstCodeID,
+				Label:            "foo",
+				Msg:              []byte(`{"some": "data"}`),
+				Salt:             []byte{0},
+				AddressGenerator: strings.Repeat("a", 65),
+			},
+			valid: false,
 		},
 	}
 	for name, tc := range cases {
@@ -554,6 +648,536 @@ func TestMsgClearAdministrator(t *testing.T) {
 	}
 }
 
+func TestMsgProposeAdmin(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgProposeAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"with expiry": {
+			src: MsgProposeAdmin{
+				Sender:          goodAddress,
+				NewAdmin:        otherGoodAddress,
+				Contract:        anotherGoodAddress,
+				ExpiresAtHeight: 100,
+			},
+		},
+		"bad sender": {
+			src: MsgProposeAdmin{
+				Sender:   badAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad new admin": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"new admin same as old": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptAdmin(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgAcceptAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgAcceptAdmin{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgAcceptAdmin{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgAcceptAdmin{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelAdminProposal(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCancelAdminProposal
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelAdminProposal{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelAdminProposal{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgCancelAdminProposal{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetContractTimelock(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSetContractTimelock
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetContractTimelock{
+				Sender:      goodAddress,
+				Contract:    anotherGoodAddress,
+				DelayBlocks: 100,
+			},
+		},
+		"zero delay": {
+			src: MsgSetContractTimelock{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"max delay": {
+			src: MsgSetContractTimelock{
+				Sender:      goodAddress,
+				Contract:    anotherGoodAddress,
+				DelayBlocks: math.MaxInt64,
+			},
+		},
+		"delay exceeds max": {
+			src: MsgSetContractTimelock{
+				Sender:      goodAddress,
+				Contract:    anotherGoodAddress,
+				DelayBlocks: math.MaxInt64 + 1,
+			},
+			expErr: true,
+		},
+		"bad sender": {
+			src: MsgSetContractTimelock{
+				Sender:      badAddress,
+				Contract:    anotherGoodAddress,
+				DelayBlocks: 100,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgSetContractTimelock{
+				Sender:      goodAddress,
+				Contract:    badAddress,
+				DelayBlocks: 100,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelTimelockedOperation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCancelTimelockedOperation
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelTimelockedOperation{
+				Sender:      goodAddress,
+				Contract:    anotherGoodAddress,
+				OperationID: 1,
+			},
+		},
+		"operation id missing": {
+			src: MsgCancelTimelockedOperation{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad sender": {
+			src: MsgCancelTimelockedOperation{
+				Sender:      badAddress,
+				Contract:    anotherGoodAddress,
+				OperationID: 1,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgCancelTimelockedOperation{
+				Sender:      goodAddress,
+				Contract:    badAddress,
+				OperationID: 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgMakeContractImmutable(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgMakeContractImmutable
+		expErr bool
+	}{
+		"all good": {
+			src: MsgMakeContractImmutable{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgMakeContractImmutable{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgMakeContractImmutable{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetCodeStatus(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSetCodeStatus
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetCodeStatus{
+				Sender: goodAddress,
+				CodeID: 1,
+				Status: CodeStatusDeprecated,
+				Reason: "any reason",
+			},
+		},
+		"with superseding code": {
+			src: MsgSetCodeStatus{
+				Sender:             goodAddress,
+				CodeID:             1,
+				Status:             CodeStatusRevoked,
+				SupersededByCodeID: 2,
+			},
+		},
+		"bad sender": {
+			src: MsgSetCodeStatus{
+				Sender: badAddress,
+				CodeID: 1,
+				Status: CodeStatusDeprecated,
+			},
+			expErr: true,
+		},
+		"code id missing": {
+			src: MsgSetCodeStatus{
+				Sender: goodAddress,
+				Status: CodeStatusDeprecated,
+			},
+			expErr: true,
+		},
+		"status unknown": {
+			src: MsgSetCodeStatus{
+				Sender: goodAddress,
+				CodeID: 1,
+				Status: 3,
+			},
+			expErr: true,
+		},
+		"active and superseded": {
+			src: MsgSetCodeStatus{
+				Sender:             goodAddress,
+				CodeID:             1,
+				Status:             CodeStatusActive,
+				SupersededByCodeID: 2,
+			},
+			expErr: true,
+		},
+		"superseded by itself": {
+			src: MsgSetCodeStatus{
+				Sender:             goodAddress,
+				CodeID:             1,
+				Status:             CodeStatusDeprecated,
+				SupersededByCodeID: 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgPruneCodes(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgPruneCodes
+		expErr bool
+	}{
+		"all good": {
+			src: MsgPruneCodes{Sender: goodAddress, CodeIDs: []uint64{1, 2}},
+		},
+		"bad sender": {
+			src:    MsgPruneCodes{Sender: "invalid", CodeIDs: []uint64{1}},
+			expErr: true,
+		},
+		"code ids empty": {
+			src:    MsgPruneCodes{Sender: goodAddress},
+			expErr: true,
+		},
+		"code id zero": {
+			src:    MsgPruneCodes{Sender: goodAddress, CodeIDs: []uint64{0}},
+			expErr: true,
+		},
+		"duplicate code ids": {
+			src:    MsgPruneCodes{Sender: goodAddress, CodeIDs: []uint64{1, 1}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetMigrationPolicy(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSetMigrationPolicy
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetMigrationPolicy{Sender: goodAddress, CodeID: 1, MigrationPolicy: MigrationPolicy{CodeIDs: []uint64{2}}},
+		},
+		"empty policy": {
+			src: MsgSetMigrationPolicy{Sender: goodAddress, CodeID: 1},
+		},
+		"bad sender": {
+			src:    MsgSetMigrationPolicy{Sender: "invalid", CodeID: 1},
+			expErr: true,
+		},
+		"code id missing": {
+			src:    MsgSetMigrationPolicy{Sender: goodAddress},
+			expErr: true,
+		},
+		"invalid policy": {
+			src:    MsgSetMigrationPolicy{Sender: goodAddress, CodeID: 1, MigrationPolicy: MigrationPolicy{CodeIDs: []uint64{0}}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgVerifyCode(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	checksum := bytes.Repeat([]byte{1}, 32)
+
+	specs := map[string]struct {
+		src    MsgVerifyCode
+		expErr bool
+	}{
+		"all good": {
+			src: MsgVerifyCode{Sender: goodAddress, CodeID: 1, Checksum: checksum},
+		},
+		"bad sender": {
+			src:    MsgVerifyCode{Sender: "invalid", CodeID: 1, Checksum: checksum},
+			expErr: true,
+		},
+		"code id missing": {
+			src:    MsgVerifyCode{Sender: goodAddress, Checksum: checksum},
+			expErr: true,
+		},
+		"checksum missing": {
+			src:    MsgVerifyCode{Sender: goodAddress, CodeID: 1},
+			expErr: true,
+		},
+		"checksum invalid length": {
+			src:    MsgVerifyCode{Sender: goodAddress, CodeID: 1, Checksum: checksum[1:]},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgMigrateContract(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -692,6 +1316,136 @@ func TestMsgJsonSignBytes(t *testing.T) {
 {
 	"type":"wasm/MsgClearAdmin",
 	"value":{"contract":"contract_address","sender":"sender"}
+}`,
+		},
+		"MsgProposeAdmin": {
+			src: &MsgProposeAdmin{
+				Sender:          "sender",
+				NewAdmin:        "newAdmin",
+				Contract:        "contract_address",
+				ExpiresAtHeight: 100,
+			},
+			exp: `
+{
+	"type":"wasm/MsgProposeAdmin",
+	"value":{"contract":"contract_address","expires_at_height":"100","new_admin":"newAdmin","sender":"sender"}
+}`,
+		},
+		"MsgAcceptAdmin": {
+			src: &MsgAcceptAdmin{
+				Sender:   "sender",
+				Contract: "contract_address",
+			},
+			exp: `
+{
+	"type":"wasm/MsgAcceptAdmin",
+	"value":{"contract":"contract_address","sender":"sender"}
+}`,
+		},
+		"MsgCancelAdminProposal": {
+			src: &MsgCancelAdminProposal{
+				Sender:   "sender",
+				Contract: "contract_address",
+			},
+			exp: `
+{
+	"type":"wasm/MsgCancelAdminProposal",
+	"value":{"contract":"contract_address","sender":"sender"}
+}`,
+		},
+		"MsgSetContractTimelock": {
+			src: &MsgSetContractTimelock{
+				Sender:      "sender",
+				Contract:    "contract_address",
+				DelayBlocks: 100,
+			},
+			exp: `
+{
+	"type":"wasm/MsgSetContractTimelock",
+	"value":{"contract":"contract_address","delay_blocks":"100","sender":"sender"}
+}`,
+		},
+		"MsgCancelTimelockedOperation": {
+			src: &MsgCancelTimelockedOperation{
+				Sender:      "sender",
+				Contract:    "contract_address",
+				OperationID: 1,
+			},
+			exp: `
+{
+	"type":"wasm/MsgCancelTimelockedOperation",
+	"value":{"contract":"contract_address","operation_id":"1","sender":"sender"}
+}`,
+		},
+		"MsgMakeContractImmutable": {
+			src: &MsgMakeContractImmutable{
+				Sender:   "sender",
+				Contract: "contract_address",
+			},
+			exp: `
+{
+	"type":"wasm/MsgMakeContractImmutable",
+	"value":{"contract":"contract_address","sender":"sender"}
+}`,
+		},
+		"MsgSetCodeStatus": {
+			src: &MsgSetCodeStatus{
+				Sender: "sender",
+				CodeID: 1,
+				Status: CodeStatusDeprecated,
+				Reason: "any reason",
+			},
+			exp: `
+{
+	"type":"wasm/MsgSetCodeStatus",
+	"value":{"code_id":"1","reason":"any reason","sender":"sender","status":1}
+}`,
+		},
+		"MsgPruneCodes": {
+			src: &MsgPruneCodes{
+				Sender:  "sender",
+				CodeIDs: []uint64{1, 2},
+			},
+			exp: `
+{
+	"type":"wasm/MsgPruneCodes",
+	"value":{"code_ids":["1","2"],"sender":"sender"}
+}`,
+		},
+		"MsgSetMigrationPolicy": {
+			src: &MsgSetMigrationPolicy{
+				Sender:          "sender",
+				CodeID:          1,
+				MigrationPolicy: MigrationPolicy{CodeIDs: []uint64{2}, Checksums: []tmBytes.HexBytes{bytes.Repeat([]byte{1}, 32)}},
+			},
+			exp: `
+{
+	"type":"wasm/MsgSetMigrationPolicy",
+	"value":{"code_id":"1","migration_policy":{"checksums":["0101010101010101010101010101010101010101010101010101010101010101"],"code_ids":["2"]},"sender":"sender"}
+}`,
+		},
+		"MsgVerifyCode": {
+			src: &MsgVerifyCode{
+				Sender:   "sender",
+				CodeID:   1,
+				Checksum: bytes.Repeat([]byte{1}, 32),
+			},
+			exp: `
+{
+	"type":"wasm/MsgVerifyCode",
+	"value":{"checksum":"0101010101010101010101010101010101010101010101010101010101010101","code_id":"1","sender":"sender"}
+}`,
+		},
+		"MsgStoreCode with build metadata": {
+			src: &MsgStoreCode{
+				Sender:        "sender",
+				WASMByteCode:  []byte{89, 69, 76, 76, 79, 87, 32, 83, 85, 66, 77, 65, 82, 73, 78, 69},
+				BuildMetadata: &CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"},
+			},
+			exp: `
+{
+	"type":"wasm/MsgStoreCode",
+	"value":{"build_metadata":{"builder":"cosmwasm/rust-optimizer:0.12.13","commit":"1234abcd","source":"https://github.com/Finschia/wasmd"},"sender":"sender","wasm_byte_code":"WUVMTE9XIFNVQk1BUklORQ=="}
 }`,
 		},
 		"MsgIBCSend": {
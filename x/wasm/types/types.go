@@ -1,7 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"net/url"
 	"reflect"
 
 	"github.com/gogo/protobuf/proto"
@@ -16,6 +19,8 @@ const (
 	defaultMemoryCacheSize    uint32 = 100 // in MiB
 	defaultSmartQueryGasLimit uint64 = 3_000_000
 	defaultContractDebugMode         = false
+	// defaultSnapshotRestoreConcurrency is the number of workers compiling wasm code during state-sync restore
+	defaultSnapshotRestoreConcurrency uint32 = 4
 
 	// ContractAddrLen defines a valid address length for contracts
 	ContractAddrLen = 32
@@ -40,9 +45,56 @@ func (c CodeInfo) ValidateBasic() error {
 	if err := c.InstantiateConfig.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "instantiate config")
 	}
+	if err := ValidateCodeStatus(c.Status, c.SupersededByCodeID); err != nil {
+		return sdkerrors.Wrap(err, "status")
+	}
+	if c.MigrationPolicy != nil {
+		if err := c.MigrationPolicy.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "migration policy")
+		}
+	}
+	if c.BuildMetadata != nil {
+		if err := c.BuildMetadata.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "build metadata")
+		}
+	}
+	if len(c.Verifications) != 0 && c.BuildMetadata == nil {
+		return sdkerrors.Wrap(ErrInvalid, "verifications without build metadata")
+	}
+	verifiers := make(map[string]struct{}, len(c.Verifications))
+	for _, v := range c.Verifications {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "verification")
+		}
+		if _, exists := verifiers[v.Verifier]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "verifier %s", v.Verifier)
+		}
+		verifiers[v.Verifier] = struct{}{}
+	}
 	return nil
 }
 
+// IsVerifiedBy returns true when the verifier has attested the code
+func (c CodeInfo) IsVerifiedBy(verifier sdk.AccAddress) bool {
+	for _, v := range c.Verifications {
+		if v.Verifier == verifier.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive returns true when the code can be used to instantiate or migrate a contract
+func (c CodeInfo) IsActive() bool {
+	return c.Status == CodeStatusActive
+}
+
+// AllowsMigrationTo returns true when the migration policy allows contracts of the code to be migrated to
+// the code with the given id and checksum. Without a policy all codes are allowed.
+func (c CodeInfo) AllowsMigrationTo(codeID uint64, checksum []byte) bool {
+	return c.MigrationPolicy == nil || c.MigrationPolicy.Allows(codeID, checksum)
+}
+
 // NewCodeInfo fills a new CodeInfo struct
 func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, instantiatePermission AccessConfig) CodeInfo {
 	return CodeInfo{
@@ -312,6 +364,12 @@ type WasmConfig struct {
 	MemoryCacheSize uint32
 	// ContractDebugMode log what contract print
 	ContractDebugMode bool
+	// SnapshotRestoreConcurrency is the max number of wasm codes that are decompressed and compiled
+	// in parallel when a snapshot is restored
+	SnapshotRestoreConcurrency uint32
+	// GenesisStreamFile is the file the codes, contracts and sequences are streamed to on genesis export
+	// and read from on genesis import instead of the genesis JSON. Not used when empty
+	GenesisStreamFile string
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
@@ -320,9 +378,42 @@ func DefaultWasmConfig() WasmConfig {
 		SmartQueryGasLimit: defaultSmartQueryGasLimit,
 		MemoryCacheSize:    defaultMemoryCacheSize,
 		ContractDebugMode:  defaultContractDebugMode,
+
+		SnapshotRestoreConcurrency: defaultSnapshotRestoreConcurrency,
 	}
 }
 
+// DefaultConfigTemplate toml snippet with default values for app.toml
+func DefaultConfigTemplate() string {
+	return ConfigTemplate(DefaultWasmConfig())
+}
+
+// ConfigTemplate toml snippet for app.toml
+func ConfigTemplate(c WasmConfig) string {
+	simGasLimit := `# simulation_gas_limit =`
+	if c.SimulationGasLimit != nil {
+		simGasLimit = fmt.Sprintf(`simulation_gas_limit = %d`, *c.SimulationGasLimit)
+	}
+
+	return fmt.Sprintf(`
+[wasm]
+# Smart query gas limit is the max gas to be used in a smart query contract call
+query_gas_limit = %d
+
+# in-memory cache for Wasm contracts. Set to 0 to disable.
+# The value is in MiB not bytes
+memory_cache_size = %d
+
+# Simulation gas limit is the max gas to be used in a tx simulation call.
+# When not set the consensus max block gas is used instead
+%s
+
+# Snapshot restore concurrency is the max number of Wasm codes that are decompressed and compiled
+# in parallel when a state-sync snapshot is restored. The default is %d
+snapshot_restore_concurrency = %d
+`, c.SmartQueryGasLimit, c.MemoryCacheSize, simGasLimit, defaultSnapshotRestoreConcurrency, c.SnapshotRestoreConcurrency)
+}
+
 // VerifyAddressLen ensures that the address matches the expected length
 func VerifyAddressLen() func(addr []byte) error {
 	return func(addr []byte) error {
@@ -397,3 +488,168 @@ func (a AccessConfig) AllAuthorizedAddresses() []string {
 	}
 	return []string{}
 }
+
+// ValidateBasic performs basic validation
+func (h AnteHook) ValidateBasic() error {
+	switch h {
+	case AnteHookUnspecified:
+		return sdkerrors.Wrap(ErrEmpty, "ante hook")
+	case AnteHookAuthentication, AnteHookFeeSponsorship:
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrInvalid, "unknown ante hook: %d", h)
+}
+
+// ValidateBasic performs basic validation
+func (h ContractAnteHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(h.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return h.Hook.ValidateBasic()
+}
+
+// AllBlockHookPhases lists the phases a block hook can be registered for
+var AllBlockHookPhases = []BlockHookPhase{BlockHookPhaseBeginBlock, BlockHookPhaseEndBlock}
+
+// ValidateBasic performs basic validation
+func (p BlockHookPhase) ValidateBasic() error {
+	switch p {
+	case BlockHookPhaseUnspecified:
+		return sdkerrors.Wrap(ErrEmpty, "phase")
+	case BlockHookPhaseBeginBlock, BlockHookPhaseEndBlock:
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrInvalid, "unknown phase: %d", p)
+}
+
+// ValidateBasic performs basic validation
+func (h BlockHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(h.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := h.Phase.ValidateBasic(); err != nil {
+		return err
+	}
+	if h.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	return nil
+}
+
+// AllCodeStatuses lists all lifecycle statuses of a code
+var AllCodeStatuses = []CodeStatus{CodeStatusActive, CodeStatusDeprecated, CodeStatusRevoked}
+
+// ValidateBasic performs basic validation
+func (s CodeStatus) ValidateBasic() error {
+	switch s {
+	case CodeStatusActive, CodeStatusDeprecated, CodeStatusRevoked:
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrInvalid, "unknown code status: %d", s)
+}
+
+// ValidateCodeStatus validates the status and the optional superseding code id. An active code can not
+// be superseded.
+func ValidateCodeStatus(status CodeStatus, supersededBy uint64) error {
+	if err := status.ValidateBasic(); err != nil {
+		return err
+	}
+	if status == CodeStatusActive && supersededBy != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "active code can not be superseded")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation. Code ids must not be zero and checksums must be sha256 hashes.
+// Deny all must not list codes.
+func (p MigrationPolicy) ValidateBasic() error {
+	if p.DenyAll && (len(p.CodeIDs) != 0 || len(p.Checksums) != 0) {
+		return sdkerrors.Wrap(ErrInvalid, "deny all with allowed codes")
+	}
+	ids := make(map[uint64]struct{}, len(p.CodeIDs))
+	for _, id := range p.CodeIDs {
+		if id == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "code id is required")
+		}
+		if _, exists := ids[id]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "code id %d", id)
+		}
+		ids[id] = struct{}{}
+	}
+	checksums := make(map[string]struct{}, len(p.Checksums))
+	for _, c := range p.Checksums {
+		if len(c) != sha256.Size {
+			return sdkerrors.Wrap(ErrInvalid, "checksum length")
+		}
+		if _, exists := checksums[string(c)]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "checksum %s", c)
+		}
+		checksums[string(c)] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation. The source, commit and builder are required so that the build can be
+// reproduced. The source must be an absolute URL.
+func (m CodeBuildMetadata) ValidateBasic() error {
+	if m.Source == "" {
+		return sdkerrors.Wrap(ErrEmpty, "source")
+	}
+	if u, err := url.ParseRequestURI(m.Source); err != nil || u.Scheme == "" || u.Host == "" {
+		return sdkerrors.Wrap(ErrInvalid, "source must be an absolute url")
+	}
+	if m.Commit == "" {
+		return sdkerrors.Wrap(ErrEmpty, "commit")
+	}
+	if m.Builder == "" {
+		return sdkerrors.Wrap(ErrEmpty, "builder")
+	}
+	for _, f := range []struct{ name, value string }{
+		{"source", m.Source},
+		{"commit", m.Commit},
+		{"builder", m.Builder},
+		{"optimizer version", m.OptimizerVersion},
+	} {
+		if len(f.value) > MaxBuildMetadataFieldSize {
+			return ErrLimit.Wrapf("%s cannot be longer than %d characters", f.name, MaxBuildMetadataFieldSize)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation
+func (v CodeVerification) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Verifier); err != nil {
+		return sdkerrors.Wrap(err, "verifier")
+	}
+	if v.Height == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "height")
+	}
+	return nil
+}
+
+// IsEmpty returns true when the policy does not restrict migrations
+func (p MigrationPolicy) IsEmpty() bool {
+	return !p.DenyAll && len(p.CodeIDs) == 0 && len(p.Checksums) == 0
+}
+
+// Allows returns true when a contract can be migrated to the code with the given id and checksum
+func (p MigrationPolicy) Allows(codeID uint64, checksum []byte) bool {
+	if p.DenyAll {
+		return false
+	}
+	if p.IsEmpty() {
+		return true
+	}
+	for _, id := range p.CodeIDs {
+		if id == codeID {
+			return true
+		}
+	}
+	for _, c := range p.Checksums {
+		if bytes.Equal(c, checksum) {
+			return true
+		}
+	}
+	return false
+}
@@ -10,7 +10,9 @@ import (
 	math "math"
 	math_bits "math/bits"
 
-	types "github.com/Finschia/finschia-sdk/codec/types"
+	types1 "github.com/Finschia/finschia-sdk/codec/types"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	github_com_Finschia_ostracon_libs_bytes "github.com/Finschia/ostracon/libs/bytes"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -68,6 +70,108 @@ func (AccessType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{0}
 }
 
+// AnteHook is a check of the ante handler that a contract can take over when
+// it is enabled for the contract by governance
+type AnteHook int32
+
+const (
+	// AnteHookUnspecified placeholder for empty value
+	AnteHookUnspecified AnteHook = 0
+	// AnteHookAuthentication lets the contract authenticate the txs that are
+	// signed on behalf of the contract account
+	AnteHookAuthentication AnteHook = 1
+	// AnteHookFeeSponsorship lets the contract sponsor the fees of the txs that
+	// set the contract as fee granter
+	AnteHookFeeSponsorship AnteHook = 2
+)
+
+var AnteHook_name = map[int32]string{
+	0: "ANTE_HOOK_UNSPECIFIED",
+	1: "ANTE_HOOK_AUTHENTICATION",
+	2: "ANTE_HOOK_FEE_SPONSORSHIP",
+}
+
+var AnteHook_value = map[string]int32{
+	"ANTE_HOOK_UNSPECIFIED":     0,
+	"ANTE_HOOK_AUTHENTICATION":  1,
+	"ANTE_HOOK_FEE_SPONSORSHIP": 2,
+}
+
+func (x AnteHook) String() string {
+	return proto.EnumName(AnteHook_name, int32(x))
+}
+
+func (AnteHook) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{1}
+}
+
+// BlockHookPhase is the phase of a block in which a block hook is called
+type BlockHookPhase int32
+
+const (
+	// BlockHookPhaseUnspecified placeholder for empty value
+	BlockHookPhaseUnspecified BlockHookPhase = 0
+	// BlockHookPhaseBeginBlock calls the contract in BeginBlock
+	BlockHookPhaseBeginBlock BlockHookPhase = 1
+	// BlockHookPhaseEndBlock calls the contract in EndBlock
+	BlockHookPhaseEndBlock BlockHookPhase = 2
+)
+
+var BlockHookPhase_name = map[int32]string{
+	0: "BLOCK_HOOK_PHASE_UNSPECIFIED",
+	1: "BLOCK_HOOK_PHASE_BEGIN_BLOCK",
+	2: "BLOCK_HOOK_PHASE_END_BLOCK",
+}
+
+var BlockHookPhase_value = map[string]int32{
+	"BLOCK_HOOK_PHASE_UNSPECIFIED": 0,
+	"BLOCK_HOOK_PHASE_BEGIN_BLOCK": 1,
+	"BLOCK_HOOK_PHASE_END_BLOCK":   2,
+}
+
+func (x BlockHookPhase) String() string {
+	return proto.EnumName(BlockHookPhase_name, int32(x))
+}
+
+func (BlockHookPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{2}
+}
+
+// CodeStatus is the lifecycle status of a code
+type CodeStatus int32
+
+const (
+	// CodeStatusActive code can be instantiated and migrated to
+	CodeStatusActive CodeStatus = 0
+	// CodeStatusDeprecated code can not be instantiated or migrated to anymore.
+	// Existing contracts are not affected.
+	CodeStatusDeprecated CodeStatus = 1
+	// CodeStatusRevoked code can not be instantiated or migrated to anymore and
+	// the status can only be changed by governance. Existing contracts are not
+	// affected.
+	CodeStatusRevoked CodeStatus = 2
+)
+
+var CodeStatus_name = map[int32]string{
+	0: "CODE_STATUS_ACTIVE",
+	1: "CODE_STATUS_DEPRECATED",
+	2: "CODE_STATUS_REVOKED",
+}
+
+var CodeStatus_value = map[string]int32{
+	"CODE_STATUS_ACTIVE":     0,
+	"CODE_STATUS_DEPRECATED": 1,
+	"CODE_STATUS_REVOKED":    2,
+}
+
+func (x CodeStatus) String() string {
+	return proto.EnumName(CodeStatus_name, int32(x))
+}
+
+func (CodeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{3}
+}
+
 // ContractCodeHistoryOperationType actions that caused a code change
 type ContractCodeHistoryOperationType int32
 
@@ -101,7 +205,48 @@ func (x ContractCodeHistoryOperationType) String() string {
 }
 
 func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{1}
+	return fileDescriptor_e6155d98fa173e02, []int{4}
+}
+
+// TimelockedOperationType is the admin operation that was queued for a
+// timelocked contract
+type TimelockedOperationType int32
+
+const (
+	// TimelockedOperationTypeUnspecified placeholder for empty value
+	TimelockedOperationTypeUnspecified TimelockedOperationType = 0
+	// TimelockedOperationTypeMigrate migrates the contract to a new code
+	TimelockedOperationTypeMigrate TimelockedOperationType = 1
+	// TimelockedOperationTypeUpdateAdmin sets a new contract admin
+	TimelockedOperationTypeUpdateAdmin TimelockedOperationType = 2
+	// TimelockedOperationTypeClearAdmin removes the contract admin
+	TimelockedOperationTypeClearAdmin TimelockedOperationType = 3
+	// TimelockedOperationTypeSetTimelock lowers the timelock of the contract
+	TimelockedOperationTypeSetTimelock TimelockedOperationType = 4
+)
+
+var TimelockedOperationType_name = map[int32]string{
+	0: "TIMELOCKED_OPERATION_TYPE_UNSPECIFIED",
+	1: "TIMELOCKED_OPERATION_TYPE_MIGRATE",
+	2: "TIMELOCKED_OPERATION_TYPE_UPDATE_ADMIN",
+	3: "TIMELOCKED_OPERATION_TYPE_CLEAR_ADMIN",
+	4: "TIMELOCKED_OPERATION_TYPE_SET_TIMELOCK",
+}
+
+var TimelockedOperationType_value = map[string]int32{
+	"TIMELOCKED_OPERATION_TYPE_UNSPECIFIED":  0,
+	"TIMELOCKED_OPERATION_TYPE_MIGRATE":      1,
+	"TIMELOCKED_OPERATION_TYPE_UPDATE_ADMIN": 2,
+	"TIMELOCKED_OPERATION_TYPE_CLEAR_ADMIN":  3,
+	"TIMELOCKED_OPERATION_TYPE_SET_TIMELOCK": 4,
+}
+
+func (x TimelockedOperationType) String() string {
+	return proto.EnumName(TimelockedOperationType_name, int32(x))
+}
+
+func (TimelockedOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{5}
 }
 
 // AccessTypeParam
@@ -198,6 +343,28 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// IBCRateLimit restricts the raw IBC packets a contract can send on a single
+	// channel unless governance overrides it for the contract or channel. A zero
+	// limit is disabled.
+	IBCRateLimit IBCRateLimit `protobuf:"bytes,3,opt,name=ibc_rate_limit,json=ibcRateLimit,proto3" json:"ibc_rate_limit" yaml:"ibc_rate_limit"`
+	// BlockHookMaxFailures is the number of consecutive failed calls after which
+	// a block hook is deregistered. Zero never deregisters a block hook.
+	BlockHookMaxFailures uint32 `protobuf:"varint,4,opt,name=block_hook_max_failures,json=blockHookMaxFailures,proto3" json:"block_hook_max_failures,omitempty" yaml:"block_hook_max_failures"`
+	// StorageDepositPerByte is the refundable deposit that a contract pays for
+	// every byte its state grows. An empty price disables storage deposits.
+	StorageDepositPerByte github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,5,rep,name=storage_deposit_per_byte,json=storageDepositPerByte,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"storage_deposit_per_byte" yaml:"storage_deposit_per_byte"`
+	// MaxContractStateBytes is the default max total bytes of the keys and
+	// values in the state of a contract. Zero is unlimited.
+	MaxContractStateBytes uint64 `protobuf:"varint,6,opt,name=max_contract_state_bytes,json=maxContractStateBytes,proto3" json:"max_contract_state_bytes,omitempty" yaml:"max_contract_state_bytes"`
+	// AllowImmutableOverride is an emergency switch that allows governance to
+	// migrate and change the admin of immutable contracts.
+	AllowImmutableOverride bool `protobuf:"varint,7,opt,name=allow_immutable_override,json=allowImmutableOverride,proto3" json:"allow_immutable_override,omitempty" yaml:"allow_immutable_override"`
+	// CodeVerifiers are the addresses that can attest that a code matches a
+	// reproducible build of its build metadata
+	CodeVerifiers []string `protobuf:"bytes,8,rep,name=code_verifiers,json=codeVerifiers,proto3" json:"code_verifiers,omitempty" yaml:"code_verifiers"`
+	// BlockHookMaxGasLimit is the max gas limit that a block hook can be
+	// registered with. Zero disallows new block hooks.
+	BlockHookMaxGasLimit uint64 `protobuf:"varint,9,opt,name=block_hook_max_gas_limit,json=blockHookMaxGasLimit,proto3" json:"block_hook_max_gas_limit,omitempty" yaml:"block_hook_max_gas_limit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -237,30 +404,35 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-// CodeInfo is data for the uploaded contract WASM code
-type CodeInfo struct {
-	// CodeHash is the unique identifier created by wasmvm
-	CodeHash []byte `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
-	// Creator address who initially stored the code
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	// InstantiateConfig access control to apply on contract creation, optional
-	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+// IBCRateLimit defines the max throughput of raw IBC packets that a contract
+// can send on a channel. Each contract and channel pair is accounted
+// separately.
+type IBCRateLimit struct {
+	// MaxPacketsPerBlock is the max number of packets within a block.
+	// 0 means unlimited
+	MaxPacketsPerBlock uint64 `protobuf:"varint,1,opt,name=max_packets_per_block,json=maxPacketsPerBlock,proto3" json:"max_packets_per_block,omitempty" yaml:"max_packets_per_block"`
+	// MaxBytesPerWindow is the max sum of packet data bytes within a window.
+	// 0 means unlimited
+	MaxBytesPerWindow uint64 `protobuf:"varint,2,opt,name=max_bytes_per_window,json=maxBytesPerWindow,proto3" json:"max_bytes_per_window,omitempty" yaml:"max_bytes_per_window"`
+	// WindowBlocks is the length of a window in blocks. Required when
+	// MaxBytesPerWindow is set
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
 }
 
-func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
-func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
-func (*CodeInfo) ProtoMessage()    {}
-func (*CodeInfo) Descriptor() ([]byte, []int) {
+func (m *IBCRateLimit) Reset()         { *m = IBCRateLimit{} }
+func (m *IBCRateLimit) String() string { return proto.CompactTextString(m) }
+func (*IBCRateLimit) ProtoMessage()    {}
+func (*IBCRateLimit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{3}
 }
 
-func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
+func (m *IBCRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *CodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IBCRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CodeInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IBCRateLimit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -271,54 +443,49 @@ func (m *CodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *CodeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CodeInfo.Merge(m, src)
+func (m *IBCRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCRateLimit.Merge(m, src)
 }
 
-func (m *CodeInfo) XXX_Size() int {
+func (m *IBCRateLimit) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *CodeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CodeInfo.DiscardUnknown(m)
+func (m *IBCRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCRateLimit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CodeInfo proto.InternalMessageInfo
-
-// ContractInfo stores a WASM contract instance
-type ContractInfo struct {
-	// CodeID is the reference to the stored Wasm code
-	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	// Creator address who initially instantiated the contract
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	// Admin is an optional address that can execute migrations
-	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
-	// Label is optional metadata to be stored with a contract instance.
-	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
-	// Created Tx position when the contract was instantiated.
-	// This data should kept internal and not be exposed via query results. Just
-	// use for sorting
-	Created   *AbsoluteTxPosition `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
-	IBCPortID string              `protobuf:"bytes,6,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
-	// Extension is an extension point to store custom metadata within the
-	// persistence model.
-	Extension *types.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+var xxx_messageInfo_IBCRateLimit proto.InternalMessageInfo
+
+// IBCPacketUsage is the raw IBC packet throughput that a contract has consumed
+// on a channel
+type IBCPacketUsage struct {
+	// BlockHeight is the block the packets were counted in
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// PacketsInBlock is the number of packets sent within the block
+	PacketsInBlock uint64 `protobuf:"varint,2,opt,name=packets_in_block,json=packetsInBlock,proto3" json:"packets_in_block,omitempty"`
+	// WindowStartHeight is the block the current window started at
+	WindowStartHeight int64 `protobuf:"varint,3,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// BytesInWindow is the sum of packet data bytes sent within the window
+	BytesInWindow uint64 `protobuf:"varint,4,opt,name=bytes_in_window,json=bytesInWindow,proto3" json:"bytes_in_window,omitempty"`
+	// PruneHeight is the block from which the usage is outdated and removed
+	PruneHeight int64 `protobuf:"varint,5,opt,name=prune_height,json=pruneHeight,proto3" json:"prune_height,omitempty"`
 }
 
-func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
-func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
-func (*ContractInfo) ProtoMessage()    {}
-func (*ContractInfo) Descriptor() ([]byte, []int) {
+func (m *IBCPacketUsage) Reset()         { *m = IBCPacketUsage{} }
+func (m *IBCPacketUsage) String() string { return proto.CompactTextString(m) }
+func (*IBCPacketUsage) ProtoMessage()    {}
+func (*IBCPacketUsage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{4}
 }
 
-func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
+func (m *IBCPacketUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *ContractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IBCPacketUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IBCPacketUsage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -329,44 +496,46 @@ func (m *ContractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 }
 
-func (m *ContractInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractInfo.Merge(m, src)
+func (m *IBCPacketUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCPacketUsage.Merge(m, src)
 }
 
-func (m *ContractInfo) XXX_Size() int {
+func (m *IBCPacketUsage) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *ContractInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractInfo.DiscardUnknown(m)
+func (m *IBCPacketUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCPacketUsage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractInfo proto.InternalMessageInfo
-
-// ContractCodeHistoryEntry metadata to a contract.
-type ContractCodeHistoryEntry struct {
-	Operation ContractCodeHistoryOperationType `protobuf:"varint,1,opt,name=operation,proto3,enum=cosmwasm.wasm.v1.ContractCodeHistoryOperationType" json:"operation,omitempty"`
-	// CodeID is the reference to the stored WASM code
-	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	// Updated Tx position when the operation was executed.
-	Updated *AbsoluteTxPosition `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
-	Msg     RawContractMessage  `protobuf:"bytes,4,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+var xxx_messageInfo_IBCPacketUsage proto.InternalMessageInfo
+
+// IBCRateLimitOverride overrides the IBC rate limit param for the raw IBC
+// packets that a contract sends on a channel
+type IBCRateLimitOverride struct {
+	// ContractAddress is the address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// ChannelID is the IBC channel of the contract. Empty applies to all
+	// channels of the contract without an own override.
+	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Limit replaces the IBC rate limit param. A zero limit is disabled.
+	Limit IBCRateLimit `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit"`
 }
 
-func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEntry{} }
-func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
-func (*ContractCodeHistoryEntry) ProtoMessage()    {}
-func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
+func (m *IBCRateLimitOverride) Reset()         { *m = IBCRateLimitOverride{} }
+func (m *IBCRateLimitOverride) String() string { return proto.CompactTextString(m) }
+func (*IBCRateLimitOverride) ProtoMessage()    {}
+func (*IBCRateLimitOverride) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{5}
 }
 
-func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
+func (m *IBCRateLimitOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *ContractCodeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IBCRateLimitOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCodeHistoryEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IBCRateLimitOverride.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -377,44 +546,42 @@ func (m *ContractCodeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]
 	}
 }
 
-func (m *ContractCodeHistoryEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCodeHistoryEntry.Merge(m, src)
+func (m *IBCRateLimitOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCRateLimitOverride.Merge(m, src)
 }
 
-func (m *ContractCodeHistoryEntry) XXX_Size() int {
+func (m *IBCRateLimitOverride) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *ContractCodeHistoryEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCodeHistoryEntry.DiscardUnknown(m)
+func (m *IBCRateLimitOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCRateLimitOverride.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCodeHistoryEntry proto.InternalMessageInfo
+var xxx_messageInfo_IBCRateLimitOverride proto.InternalMessageInfo
 
-// AbsoluteTxPosition is a unique transaction position that allows for global
-// ordering of transactions.
-type AbsoluteTxPosition struct {
-	// BlockHeight is the block the contract was created at
-	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// TxIndex is a monotonic counter within the block (actual transaction index,
-	// or gas consumed)
-	TxIndex uint64 `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+// ContractAnteHook is an ante hook that is enabled for a contract
+type ContractAnteHook struct {
+	// ContractAddress is the address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Hook is the check of the ante handler that the contract takes over
+	Hook AnteHook `protobuf:"varint,2,opt,name=hook,proto3,enum=cosmwasm.wasm.v1.AnteHook" json:"hook,omitempty"`
 }
 
-func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
-func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
-func (*AbsoluteTxPosition) ProtoMessage()    {}
-func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
+func (m *ContractAnteHook) Reset()         { *m = ContractAnteHook{} }
+func (m *ContractAnteHook) String() string { return proto.CompactTextString(m) }
+func (*ContractAnteHook) ProtoMessage()    {}
+func (*ContractAnteHook) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{6}
 }
 
-func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
+func (m *ContractAnteHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *AbsoluteTxPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractAnteHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AbsoluteTxPosition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractAnteHook.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -425,42 +592,43 @@ func (m *AbsoluteTxPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 }
 
-func (m *AbsoluteTxPosition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbsoluteTxPosition.Merge(m, src)
+func (m *ContractAnteHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAnteHook.Merge(m, src)
 }
 
-func (m *AbsoluteTxPosition) XXX_Size() int {
+func (m *ContractAnteHook) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *AbsoluteTxPosition) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbsoluteTxPosition.DiscardUnknown(m)
+func (m *ContractAnteHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAnteHook.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbsoluteTxPosition proto.InternalMessageInfo
+var xxx_messageInfo_ContractAnteHook proto.InternalMessageInfo
 
-// Model is a struct that holds a KV pair
-type Model struct {
-	// hex-encode key to read it better (this is often ascii)
-	Key github_com_Finschia_ostracon_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=key,proto3,casttype=github.com/Finschia/ostracon/libs/bytes.HexBytes" json:"key,omitempty"`
-	// base64-encode raw value
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+// ContractStateSize is the size of the key value store of a contract
+type ContractStateSize struct {
+	// KeyCount is the number of keys in the contract state
+	KeyCount uint64 `protobuf:"varint,1,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	// TotalBytes is the sum of the lengths of all keys and values in the
+	// contract state
+	TotalBytes uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
 }
 
-func (m *Model) Reset()         { *m = Model{} }
-func (m *Model) String() string { return proto.CompactTextString(m) }
-func (*Model) ProtoMessage()    {}
-func (*Model) Descriptor() ([]byte, []int) {
+func (m *ContractStateSize) Reset()         { *m = ContractStateSize{} }
+func (m *ContractStateSize) String() string { return proto.CompactTextString(m) }
+func (*ContractStateSize) ProtoMessage()    {}
+func (*ContractStateSize) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{7}
 }
 
-func (m *Model) XXX_Unmarshal(b []byte) error {
+func (m *ContractStateSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *Model) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractStateSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Model.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractStateSize.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -471,904 +639,5455 @@ func (m *Model) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *Model) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Model.Merge(m, src)
+func (m *ContractStateSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStateSize.Merge(m, src)
 }
 
-func (m *Model) XXX_Size() int {
+func (m *ContractStateSize) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *Model) XXX_DiscardUnknown() {
-	xxx_messageInfo_Model.DiscardUnknown(m)
+func (m *ContractStateSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStateSize.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Model proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
-	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
-	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
-	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
-	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
-	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
-	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
-	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
-	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
-	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+var xxx_messageInfo_ContractStateSize proto.InternalMessageInfo
+
+// BlockHook is a contract that is called with sudo in every block
+type BlockHook struct {
+	// ContractAddress is the address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Phase is the phase of the block in which the contract is called
+	Phase BlockHookPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=cosmwasm.wasm.v1.BlockHookPhase" json:"phase,omitempty"`
+	// GasLimit is the max gas the contract can consume in a call
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// ConsecutiveFailures is the number of failed calls since the last
+	// successful one
+	ConsecutiveFailures uint32 `protobuf:"varint,4,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
-
-var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x93, 0xec, 0x47, 0xa6, 0x4b, 0x71, 0x87, 0x5d, 0x9a, 0x0d, 0xab, 0x24, 0x35, 0x05,
-	0xb6, 0x5f, 0x49, 0xbb, 0x20, 0x90, 0x7a, 0xa8, 0x94, 0x0f, 0x6f, 0xd7, 0x15, 0x1b, 0x47, 0x93,
-	0x14, 0xb4, 0x48, 0x95, 0xe5, 0xd8, 0xb3, 0x89, 0x55, 0xc7, 0x13, 0x79, 0x26, 0xdb, 0xf8, 0x3f,
-	0x40, 0x91, 0x10, 0x1c, 0xb9, 0x44, 0x42, 0x80, 0x50, 0xb9, 0x73, 0xe5, 0x5e, 0xc1, 0xa5, 0x47,
-	0x4e, 0x11, 0x6c, 0x2f, 0x9c, 0x73, 0x2c, 0x17, 0xe4, 0x99, 0xa4, 0x31, 0xdd, 0x6d, 0x37, 0x5c,
-	0xac, 0x99, 0xf7, 0xde, 0xef, 0xf7, 0xde, 0xfb, 0xcd, 0xbc, 0x91, 0xc1, 0x96, 0x45, 0x68, 0xf7,
-	0x91, 0x49, 0xbb, 0x45, 0xfe, 0x39, 0xba, 0x55, 0x64, 0x41, 0x0f, 0xd3, 0x42, 0xcf, 0x27, 0x8c,
-	0x40, 0x79, 0xe6, 0x2d, 0xf0, 0xcf, 0xd1, 0xad, 0xcc, 0x66, 0x68, 0x21, 0xd4, 0xe0, 0xfe, 0xa2,
-	0xd8, 0x88, 0xe0, 0xcc, 0x7a, 0x9b, 0xb4, 0x89, 0xb0, 0x87, 0xab, 0xa9, 0x75, 0xb3, 0x4d, 0x48,
-	0xdb, 0xc5, 0x45, 0xbe, 0x6b, 0xf5, 0x0f, 0x8b, 0xa6, 0x17, 0x08, 0x97, 0xf2, 0x00, 0xbc, 0x59,
-	0xb2, 0x2c, 0x4c, 0x69, 0x33, 0xe8, 0xe1, 0xba, 0xe9, 0x9b, 0x5d, 0x58, 0x05, 0x4b, 0x47, 0xa6,
-	0xdb, 0xc7, 0x69, 0x29, 0x2f, 0x6d, 0x9f, 0xdf, 0xd9, 0x2a, 0xbc, 0x5c, 0x40, 0x61, 0x8e, 0x28,
-	0xcb, 0x93, 0x71, 0x6e, 0x2d, 0x30, 0xbb, 0xee, 0x6d, 0x85, 0x83, 0x14, 0x24, 0xc0, 0xb7, 0x93,
-	0xdf, 0x7e, 0x97, 0x93, 0x94, 0xdf, 0x25, 0xb0, 0x26, 0xa2, 0x2b, 0xc4, 0x3b, 0x74, 0xda, 0xb0,
-	0x01, 0x40, 0x0f, 0xfb, 0x5d, 0x87, 0x52, 0x87, 0x78, 0x0b, 0x65, 0xd8, 0x98, 0x8c, 0x73, 0x17,
-	0x44, 0x86, 0x39, 0x52, 0x41, 0x11, 0x1a, 0x78, 0x1d, 0xac, 0x98, 0xb6, 0xed, 0x63, 0x4a, 0xd3,
-	0xf1, 0xbc, 0xb4, 0x9d, 0x2a, 0xc3, 0xc9, 0x38, 0x77, 0x5e, 0x60, 0xa6, 0x0e, 0x05, 0xcd, 0x42,
-	0xe0, 0x0e, 0x48, 0x4d, 0x97, 0x98, 0xa6, 0x13, 0xf9, 0xc4, 0x76, 0xaa, 0xbc, 0x3e, 0x19, 0xe7,
-	0xe4, 0xff, 0xc4, 0x63, 0xaa, 0xa0, 0x79, 0xd8, 0xb4, 0x9b, 0xaf, 0xe3, 0x60, 0x99, 0x6b, 0x44,
-	0x21, 0x01, 0xd0, 0x22, 0x36, 0x36, 0xfa, 0x3d, 0x97, 0x98, 0xb6, 0x61, 0xf2, 0x7a, 0x79, 0x3f,
-	0xe7, 0x76, 0xb2, 0xaf, 0xea, 0x47, 0x68, 0x50, 0xbe, 0xf4, 0x64, 0x9c, 0x8b, 0x4d, 0xc6, 0xb9,
-	0x4d, 0x91, 0xf1, 0x24, 0x8f, 0x82, 0xe4, 0xd0, 0x78, 0x9f, 0xdb, 0x04, 0x14, 0x7e, 0x25, 0x81,
-	0xac, 0xe3, 0x51, 0x66, 0x7a, 0xcc, 0x31, 0x19, 0x36, 0x6c, 0x7c, 0x68, 0xf6, 0x5d, 0x66, 0x44,
-	0xd4, 0x8c, 0x2f, 0xa0, 0xe6, 0x95, 0xc9, 0x38, 0xf7, 0x9e, 0xc8, 0xfb, 0x7a, 0x36, 0x05, 0x6d,
-	0x45, 0x02, 0xaa, 0xc2, 0x5f, 0x7f, 0xe1, 0xe6, 0x8a, 0xc4, 0x94, 0xef, 0x25, 0xb0, 0x5a, 0x21,
-	0x36, 0xd6, 0xbc, 0x43, 0x02, 0xdf, 0x01, 0x29, 0xde, 0x4b, 0xc7, 0xa4, 0x1d, 0x2e, 0xc5, 0x1a,
-	0x5a, 0x0d, 0x0d, 0x7b, 0x26, 0xed, 0xc0, 0x34, 0x58, 0xb1, 0x7c, 0x6c, 0x32, 0xe2, 0x8b, 0x33,
-	0x42, 0xb3, 0x2d, 0x6c, 0x00, 0x18, 0x2d, 0xc5, 0xe2, 0x22, 0xa5, 0x97, 0x16, 0x92, 0x32, 0x19,
-	0x4a, 0x89, 0x2e, 0x44, 0xf0, 0xc2, 0x71, 0x2f, 0xb9, 0x9a, 0x90, 0x93, 0xf7, 0x92, 0xab, 0x49,
-	0x79, 0x49, 0xf9, 0x35, 0x0e, 0xd6, 0x2a, 0xc4, 0x63, 0xbe, 0x69, 0x31, 0x5e, 0xe8, 0xbb, 0x60,
-	0x85, 0x17, 0xea, 0xd8, 0xbc, 0xcc, 0x64, 0x19, 0x1c, 0x8f, 0x73, 0xcb, 0xbc, 0x8f, 0x2a, 0x5a,
-	0x0e, 0x5d, 0x9a, 0xfd, 0x9a, 0x82, 0xd7, 0xc1, 0x92, 0x69, 0x77, 0x1d, 0x2f, 0x9d, 0xe0, 0x76,
-	0xb1, 0x09, 0xad, 0xae, 0xd9, 0xc2, 0x6e, 0x3a, 0x29, 0xac, 0x7c, 0x03, 0xef, 0x4c, 0x59, 0xb0,
-	0x3d, 0xed, 0xe8, 0xf2, 0x29, 0x1d, 0xb5, 0x28, 0x71, 0xfb, 0x0c, 0x37, 0x07, 0x75, 0x42, 0x1d,
-	0xe6, 0x10, 0x0f, 0xcd, 0x40, 0xf0, 0x06, 0x38, 0xe7, 0xb4, 0x2c, 0xa3, 0x47, 0x7c, 0x16, 0x96,
-	0xbb, 0xcc, 0xaf, 0xf7, 0x1b, 0xc7, 0xe3, 0x5c, 0x4a, 0x2b, 0x57, 0xea, 0xc4, 0x67, 0x5a, 0x15,
-	0xa5, 0x9c, 0x96, 0xc5, 0x97, 0x36, 0xdc, 0x07, 0x29, 0x3c, 0x60, 0xd8, 0xe3, 0xf7, 0x61, 0x85,
-	0x27, 0x5c, 0x2f, 0x88, 0xe9, 0x2f, 0xcc, 0xa6, 0xbf, 0x50, 0xf2, 0x82, 0xf2, 0xe6, 0x6f, 0xbf,
-	0xdc, 0xd8, 0x88, 0x8a, 0xa2, 0xce, 0x60, 0x68, 0xce, 0x70, 0x3b, 0xf9, 0x77, 0x78, 0xed, 0xff,
-	0x91, 0x40, 0x7a, 0x16, 0x1a, 0x8a, 0xb4, 0xe7, 0x50, 0x46, 0xfc, 0x40, 0xf5, 0x98, 0x1f, 0xc0,
-	0x3a, 0x48, 0x91, 0x1e, 0xf6, 0x4d, 0x36, 0x9f, 0xe7, 0x9d, 0x93, 0x2d, 0x9e, 0x02, 0xd7, 0x67,
-	0xa8, 0xf0, 0x5e, 0xa2, 0x39, 0x49, 0xf4, 0x74, 0xe2, 0xaf, 0x3c, 0x9d, 0x3b, 0x60, 0xa5, 0xdf,
-	0xb3, 0xb9, 0xae, 0x89, 0xff, 0xa3, 0xeb, 0x14, 0x04, 0xb7, 0x41, 0xa2, 0x4b, 0xdb, 0xfc, 0xac,
-	0xd6, 0xca, 0x6f, 0x3f, 0x1f, 0xe7, 0x20, 0x32, 0x1f, 0xcd, 0xaa, 0xdc, 0xc7, 0x94, 0x9a, 0x6d,
-	0x8c, 0xc2, 0x10, 0x05, 0x01, 0x78, 0x92, 0x08, 0x5e, 0x02, 0x6b, 0x2d, 0x97, 0x58, 0x0f, 0x8d,
-	0x0e, 0x76, 0xda, 0x1d, 0x26, 0xee, 0x11, 0x3a, 0xc7, 0x6d, 0x7b, 0xdc, 0x04, 0x37, 0xc1, 0x2a,
-	0x1b, 0x18, 0x8e, 0x67, 0xe3, 0x81, 0x68, 0x04, 0xad, 0xb0, 0x81, 0x16, 0x6e, 0x15, 0x0c, 0x96,
-	0xf6, 0x89, 0x8d, 0x5d, 0xb8, 0x0b, 0x12, 0x0f, 0x71, 0x20, 0x86, 0xa5, 0xfc, 0xd1, 0xf3, 0x71,
-	0xee, 0x66, 0xdb, 0x61, 0x9d, 0x7e, 0xab, 0x60, 0x91, 0x6e, 0x71, 0xd7, 0xf1, 0xa8, 0xd5, 0x71,
-	0xcc, 0x22, 0xa1, 0x61, 0x59, 0xc4, 0x2b, 0xba, 0x4e, 0x8b, 0x16, 0x5b, 0x01, 0xc3, 0xb4, 0xb0,
-	0x87, 0x07, 0xe5, 0x70, 0x81, 0x42, 0x82, 0xf0, 0xf2, 0x89, 0x37, 0x3b, 0xce, 0xc7, 0x4e, 0x6c,
-	0xae, 0xfe, 0x1c, 0x07, 0x60, 0x3e, 0xfb, 0xf0, 0x63, 0x70, 0xb1, 0x54, 0xa9, 0xa8, 0x8d, 0x86,
-	0xd1, 0x3c, 0xa8, 0xab, 0xc6, 0xfd, 0x5a, 0xa3, 0xae, 0x56, 0xb4, 0x5d, 0x4d, 0xad, 0xca, 0xb1,
-	0xcc, 0xe6, 0x70, 0x94, 0xdf, 0x98, 0x07, 0xdf, 0xf7, 0x68, 0x0f, 0x5b, 0xce, 0xa1, 0x83, 0x6d,
-	0x78, 0x1d, 0xc0, 0x28, 0xae, 0xa6, 0x97, 0xf5, 0xea, 0x81, 0x2c, 0x65, 0xd6, 0x87, 0xa3, 0xbc,
-	0x3c, 0x87, 0xd4, 0x48, 0x8b, 0xd8, 0x01, 0xfc, 0x04, 0xa4, 0xa3, 0xd1, 0x7a, 0xed, 0xd3, 0x03,
-	0xa3, 0x54, 0xad, 0x22, 0xb5, 0xd1, 0x90, 0xe3, 0x2f, 0xa7, 0xd1, 0x3d, 0x37, 0x28, 0xbd, 0x78,
-	0x97, 0x37, 0xa2, 0x40, 0xf5, 0x33, 0x15, 0x1d, 0xf0, 0x4c, 0x89, 0xcc, 0xc5, 0xe1, 0x28, 0xff,
-	0xd6, 0x1c, 0xa5, 0x1e, 0x61, 0x3f, 0xe0, 0xc9, 0xee, 0x80, 0xad, 0x28, 0xa6, 0x54, 0x3b, 0x30,
-	0xf4, 0xdd, 0x59, 0x3a, 0xb5, 0x21, 0x27, 0x33, 0x5b, 0xc3, 0x51, 0x3e, 0x3d, 0x87, 0x96, 0xbc,
-	0x40, 0x3f, 0x2c, 0xcd, 0xde, 0xf5, 0xcc, 0xea, 0x97, 0x3f, 0x64, 0x63, 0x8f, 0x7f, 0xcc, 0xc6,
-	0xae, 0xfe, 0x94, 0x00, 0xf9, 0xb3, 0x6e, 0x29, 0xc4, 0xe0, 0x66, 0x45, 0xaf, 0x35, 0x51, 0xa9,
-	0xd2, 0x34, 0x2a, 0x7a, 0x55, 0x35, 0xf6, 0xb4, 0x46, 0x53, 0x47, 0x07, 0x86, 0x5e, 0x57, 0x51,
-	0xa9, 0xa9, 0xe9, 0xb5, 0xd3, 0xa4, 0x2d, 0x0e, 0x47, 0xf9, 0x6b, 0x67, 0x71, 0x47, 0x05, 0xff,
-	0x1c, 0x5c, 0x59, 0x28, 0x8d, 0x56, 0xd3, 0x9a, 0xb2, 0x94, 0xd9, 0x1e, 0x8e, 0xf2, 0x97, 0xcf,
-	0xe2, 0xd7, 0x3c, 0x87, 0xc1, 0x07, 0xe0, 0xfa, 0x42, 0xc4, 0xfb, 0xda, 0x5d, 0x54, 0x6a, 0xaa,
-	0x72, 0x3c, 0x73, 0x6d, 0x38, 0xca, 0x7f, 0x70, 0x16, 0xf7, 0xbe, 0xd3, 0xf6, 0x4d, 0x86, 0x17,
-	0xa6, 0xbf, 0xab, 0xd6, 0xd4, 0x86, 0xd6, 0x90, 0x13, 0x8b, 0xd1, 0xdf, 0xc5, 0x1e, 0xa6, 0x0e,
-	0xcd, 0x24, 0xc3, 0xc3, 0x2a, 0xef, 0x3d, 0xf9, 0x2b, 0x1b, 0x7b, 0x7c, 0x9c, 0x95, 0x9e, 0x1c,
-	0x67, 0xa5, 0xa7, 0xc7, 0x59, 0xe9, 0xcf, 0xe3, 0xac, 0xf4, 0xcd, 0xb3, 0x6c, 0xec, 0xe9, 0xb3,
-	0x6c, 0xec, 0x8f, 0x67, 0xd9, 0xd8, 0x17, 0xef, 0x9f, 0x36, 0x43, 0xe1, 0xa3, 0x60, 0x17, 0x07,
-	0xe2, 0x17, 0x8b, 0xff, 0x5f, 0xb5, 0x96, 0xf9, 0x8b, 0xf8, 0xe1, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xce, 0x06, 0x16, 0x10, 0x80, 0x09, 0x00, 0x00,
+func (m *BlockHook) Reset()         { *m = BlockHook{} }
+func (m *BlockHook) String() string { return proto.CompactTextString(m) }
+func (*BlockHook) ProtoMessage()    {}
+func (*BlockHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{8}
 }
 
-func (this *AccessTypeParam) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *BlockHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-	that1, ok := that.(*AccessTypeParam)
-	if !ok {
-		that2, ok := that.(AccessTypeParam)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *BlockHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
 
-func (this *AccessConfig) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *BlockHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHook.Merge(m, src)
+}
 
-	that1, ok := that.(*AccessConfig)
-	if !ok {
-		that2, ok := that.(AccessConfig)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Permission != that1.Permission {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if len(this.Addresses) != len(that1.Addresses) {
-		return false
-	}
-	for i := range this.Addresses {
-		if this.Addresses[i] != that1.Addresses[i] {
-			return false
-		}
-	}
-	return true
+func (m *BlockHook) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *BlockHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHook.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
-		return false
-	}
-	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
-		return false
-	}
-	return true
+var xxx_messageInfo_BlockHook proto.InternalMessageInfo
+
+// CodeInfo is data for the uploaded contract WASM code
+type CodeInfo struct {
+	// CodeHash is the unique identifier created by wasmvm
+	CodeHash []byte `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// Creator address who initially stored the code
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// InstantiateConfig access control to apply on contract creation, optional
+	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+	// Status is the lifecycle status of the code
+	Status CodeStatus `protobuf:"varint,6,opt,name=status,proto3,enum=cosmwasm.wasm.v1.CodeStatus" json:"status,omitempty"`
+	// StatusReason is an optional human readable reason for the status
+	StatusReason string `protobuf:"bytes,7,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	// SupersededByCodeID is the optional code id that replaces a deprecated or
+	// revoked code
+	SupersededByCodeID uint64 `protobuf:"varint,8,opt,name=superseded_by_code_id,json=supersededByCodeId,proto3" json:"superseded_by_code_id,omitempty"`
+	// MigrationPolicy restricts the codes that contracts of this code can be
+	// migrated to. Not set allows all codes.
+	MigrationPolicy *MigrationPolicy `protobuf:"bytes,9,opt,name=migration_policy,json=migrationPolicy,proto3" json:"migration_policy,omitempty"`
+	// BuildMetadata optionally describes how the code was built
+	BuildMetadata *CodeBuildMetadata `protobuf:"bytes,10,opt,name=build_metadata,json=buildMetadata,proto3" json:"build_metadata,omitempty"`
+	// Verifications are the attestations of allowlisted verifiers that the
+	// checksum matches a reproducible build of the build metadata
+	Verifications []CodeVerification `protobuf:"bytes,11,rep,name=verifications,proto3" json:"verifications"`
 }
 
-func (this *CodeInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
+func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
+func (*CodeInfo) ProtoMessage()    {}
+func (*CodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{9}
+}
 
-	that1, ok := that.(*CodeInfo)
-	if !ok {
-		that2, ok := that.(CodeInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
-		return false
-	}
-	return true
 }
 
-func (this *ContractInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeInfo.Merge(m, src)
+}
 
-	that1, ok := that.(*ContractInfo)
-	if !ok {
-		that2, ok := that.(ContractInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if this.Admin != that1.Admin {
-		return false
-	}
-	if this.Label != that1.Label {
-		return false
-	}
-	if !this.Created.Equal(that1.Created) {
-		return false
-	}
-	if this.IBCPortID != that1.IBCPortID {
-		return false
-	}
-	if !this.Extension.Equal(that1.Extension) {
-		return false
-	}
-	return true
+func (m *CodeInfo) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeInfo.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ContractCodeHistoryEntry)
-	if !ok {
-		that2, ok := that.(ContractCodeHistoryEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Operation != that1.Operation {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
-		return false
-	}
-	if !this.Updated.Equal(that1.Updated) {
-		return false
-	}
-	if !bytes.Equal(this.Msg, that1.Msg) {
-		return false
-	}
-	return true
+var xxx_messageInfo_CodeInfo proto.InternalMessageInfo
+
+// CodeBuildMetadata describes how the code was built so that the checksum can
+// be reproduced from the source code.
+type CodeBuildMetadata struct {
+	// Source is the URL of the source code repository
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Commit is the revision of the source code that was built
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Builder is the docker image that built the code
+	Builder string `protobuf:"bytes,3,opt,name=builder,proto3" json:"builder,omitempty"`
+	// OptimizerVersion is the optional version of the wasm optimizer
+	OptimizerVersion string `protobuf:"bytes,4,opt,name=optimizer_version,json=optimizerVersion,proto3" json:"optimizer_version,omitempty"`
 }
 
-func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeBuildMetadata) Reset()         { *m = CodeBuildMetadata{} }
+func (m *CodeBuildMetadata) String() string { return proto.CompactTextString(m) }
+func (*CodeBuildMetadata) ProtoMessage()    {}
+func (*CodeBuildMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{10}
+}
 
-	that1, ok := that.(*AbsoluteTxPosition)
-	if !ok {
-		that2, ok := that.(AbsoluteTxPosition)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *CodeBuildMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeBuildMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeBuildMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BlockHeight != that1.BlockHeight {
-		return false
-	}
-	if this.TxIndex != that1.TxIndex {
-		return false
-	}
-	return true
 }
 
-func (this *Model) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeBuildMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeBuildMetadata.Merge(m, src)
+}
 
-	that1, ok := that.(*Model)
-	if !ok {
-		that2, ok := that.(Model)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
-	}
-	if !bytes.Equal(this.Value, that1.Value) {
-		return false
-	}
-	return true
+func (m *CodeBuildMetadata) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CodeBuildMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeBuildMetadata.DiscardUnknown(m)
 }
 
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CodeBuildMetadata proto.InternalMessageInfo
+
+// CodeVerification is the attestation of a verifier that the checksum of a
+// code matches a reproducible build of its build metadata.
+type CodeVerification struct {
+	// Verifier is the address of the allowlisted verifier
+	Verifier string `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// Height is the block height of the verification
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *CodeVerification) Reset()         { *m = CodeVerification{} }
+func (m *CodeVerification) String() string { return proto.CompactTextString(m) }
+func (*CodeVerification) ProtoMessage()    {}
+func (*CodeVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{11}
 }
 
-func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CodeVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CodeVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeVerification.Merge(m, src)
 }
 
-func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Permission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *CodeVerification) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CodeVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeVerification.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CodeVerification proto.InternalMessageInfo
+
+// MigrationPolicy lists the codes that contracts can be migrated to. A code
+// is allowed when either its id or its checksum is listed. DenyAll blocks the
+// migration to any code.
+type MigrationPolicy struct {
+	// CodeIDs are the ids of the allowed codes
+	CodeIDs []uint64 `protobuf:"varint,1,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// Checksums are the sha256 hashes of the allowed codes
+	Checksums []github_com_Finschia_ostracon_libs_bytes.HexBytes `protobuf:"bytes,2,rep,name=checksums,proto3,casttype=github.com/Finschia/ostracon/libs/bytes.HexBytes" json:"checksums,omitempty"`
+	// DenyAll blocks all migrations. No codes must be listed with it.
+	DenyAll bool `protobuf:"varint,3,opt,name=deny_all,json=denyAll,proto3" json:"deny_all,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InstantiateDefaultPermission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+func (m *MigrationPolicy) Reset()         { *m = MigrationPolicy{} }
+func (m *MigrationPolicy) String() string { return proto.CompactTextString(m) }
+func (*MigrationPolicy) ProtoMessage()    {}
+func (*MigrationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{12}
+}
+
+func (m *MigrationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MigrationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MigrationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationPolicy.Merge(m, src)
 }
 
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MigrationPolicy) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+func (m *MigrationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationPolicy proto.InternalMessageInfo
+
+// ContractInfo stores a WASM contract instance
+type ContractInfo struct {
+	// CodeID is the reference to the stored Wasm code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Creator address who initially instantiated the contract
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Admin is an optional address that can execute migrations
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Label is optional metadata to be stored with a contract instance.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// Created Tx position when the contract was instantiated.
+	// This data should kept internal and not be exposed via query results. Just
+	// use for sorting
+	Created   *AbsoluteTxPosition `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	IBCPortID string              `protobuf:"bytes,6,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
+	// Extension is an extension point to store custom metadata within the
+	// persistence model.
+	Extension *types1.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Immutable contracts can not be migrated and have no admin. The flag can
+	// not be removed.
+	Immutable bool `protobuf:"varint,8,opt,name=immutable,proto3" json:"immutable,omitempty"`
+}
+
+func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
+func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
+func (*ContractInfo) ProtoMessage()    {}
+func (*ContractInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{13}
+}
+
+func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContractInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInfo.Merge(m, src)
 }
 
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractInfo) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Extension != nil {
-		{
-			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.IBCPortID) > 0 {
-		i -= len(m.IBCPortID)
-		copy(dAtA[i:], m.IBCPortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *ContractInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInfo.DiscardUnknown(m)
 }
 
-func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_ContractInfo proto.InternalMessageInfo
+
+// ContractCodeHistoryEntry metadata to a contract.
+type ContractCodeHistoryEntry struct {
+	Operation ContractCodeHistoryOperationType `protobuf:"varint,1,opt,name=operation,proto3,enum=cosmwasm.wasm.v1.ContractCodeHistoryOperationType" json:"operation,omitempty"`
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Updated Tx position when the operation was executed.
+	Updated *AbsoluteTxPosition `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Msg     RawContractMessage  `protobuf:"bytes,4,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
 }
 
-func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEntry{} }
+func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ContractCodeHistoryEntry) ProtoMessage()    {}
+func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{14}
 }
 
-func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractCodeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCodeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Operation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContractCodeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCodeHistoryEntry.Merge(m, src)
 }
 
-func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCodeHistoryEntry) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *ContractCodeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCodeHistoryEntry.DiscardUnknown(m)
 }
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_ContractCodeHistoryEntry proto.InternalMessageInfo
+
+// AbsoluteTxPosition is a unique transaction position that allows for global
+// ordering of transactions.
+type AbsoluteTxPosition struct {
+	// BlockHeight is the block the contract was created at
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// TxIndex is a monotonic counter within the block (actual transaction index,
+	// or gas consumed)
+	TxIndex uint64 `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 }
 
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
+func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
+func (*AbsoluteTxPosition) ProtoMessage()    {}
+func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{15}
 }
 
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AbsoluteTxPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbsoluteTxPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
 
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+func (m *AbsoluteTxPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbsoluteTxPosition.Merge(m, src)
 }
 
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	return n
+func (m *AbsoluteTxPosition) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
-	}
-	return n
+func (m *AbsoluteTxPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbsoluteTxPosition.DiscardUnknown(m)
 }
 
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+var xxx_messageInfo_AbsoluteTxPosition proto.InternalMessageInfo
+
+// Model is a struct that holds a KV pair
+type Model struct {
+	// hex-encode key to read it better (this is often ascii)
+	Key github_com_Finschia_ostracon_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=key,proto3,casttype=github.com/Finschia/ostracon/libs/bytes.HexBytes" json:"key,omitempty"`
+	// base64-encode raw value
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Extension != nil {
-		l = m.Extension.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *Model) Reset()         { *m = Model{} }
+func (m *Model) String() string { return proto.CompactTextString(m) }
+func (*Model) ProtoMessage()    {}
+func (*Model) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{16}
 }
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
+func (m *Model) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Model) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Model.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+}
+
+func (m *Model) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Model.Merge(m, src)
+}
+
+func (m *Model) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Model) XXX_DiscardUnknown() {
+	xxx_messageInfo_Model.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Model proto.InternalMessageInfo
+
+// StorageDeposit is the refundable deposit held by the module for the bytes in
+// the state of a contract
+type StorageDeposit struct {
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+}
+
+func (m *StorageDeposit) Reset()         { *m = StorageDeposit{} }
+func (m *StorageDeposit) String() string { return proto.CompactTextString(m) }
+func (*StorageDeposit) ProtoMessage()    {}
+func (*StorageDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{17}
+}
+
+func (m *StorageDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *StorageDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
+}
+
+func (m *StorageDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDeposit.Merge(m, src)
+}
+
+func (m *StorageDeposit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *StorageDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDeposit proto.InternalMessageInfo
+
+// CodeStateLimit overrides the max contract state bytes param for the
+// contracts of a code
+type CodeStateLimit struct {
+	// CodeID references the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// MaxStateBytes is the max total bytes of the keys and values in the state
+	// of a contract
+	MaxStateBytes uint64 `protobuf:"varint,2,opt,name=max_state_bytes,json=maxStateBytes,proto3" json:"max_state_bytes,omitempty"`
+}
+
+func (m *CodeStateLimit) Reset()         { *m = CodeStateLimit{} }
+func (m *CodeStateLimit) String() string { return proto.CompactTextString(m) }
+func (*CodeStateLimit) ProtoMessage()    {}
+func (*CodeStateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{18}
+}
+
+func (m *CodeStateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeStateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeStateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+
+func (m *CodeStateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeStateLimit.Merge(m, src)
+}
+
+func (m *CodeStateLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CodeStateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeStateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeStateLimit proto.InternalMessageInfo
+
+// PendingAdmin is a new admin proposed by the contract admin that was not
+// accepted yet
+type PendingAdmin struct {
+	// ContractAddress is the address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// NewAdmin is the address that can accept the admin role
+	NewAdmin string `protobuf:"bytes,2,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	// ExpiresAtHeight is the block height from which the new admin can not
+	// accept anymore. Zero never expires.
+	ExpiresAtHeight uint64 `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (m *PendingAdmin) Reset()         { *m = PendingAdmin{} }
+func (m *PendingAdmin) String() string { return proto.CompactTextString(m) }
+func (*PendingAdmin) ProtoMessage()    {}
+func (*PendingAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{19}
+}
+
+func (m *PendingAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PendingAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *AbsoluteTxPosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAdmin.Merge(m, src)
+}
+
+func (m *PendingAdmin) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PendingAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAdmin proto.InternalMessageInfo
+
+// ContractTimelock is the number of blocks that admin operations on a contract
+// are delayed
+type ContractTimelock struct {
+	// ContractAddress is the address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// DelayBlocks is the number of blocks after which a queued operation is
+	// executed
+	DelayBlocks uint64 `protobuf:"varint,2,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+}
+
+func (m *ContractTimelock) Reset()         { *m = ContractTimelock{} }
+func (m *ContractTimelock) String() string { return proto.CompactTextString(m) }
+func (*ContractTimelock) ProtoMessage()    {}
+func (*ContractTimelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{20}
+}
+
+func (m *ContractTimelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractTimelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractTimelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.BlockHeight))
+}
+
+func (m *ContractTimelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractTimelock.Merge(m, src)
+}
+
+func (m *ContractTimelock) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractTimelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractTimelock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractTimelock proto.InternalMessageInfo
+
+// TimelockedOperation is an admin operation on a timelocked contract that is
+// executed at the end of the block with the given height unless cancelled
+type TimelockedOperation struct {
+	// ID is the unique identifier of the operation
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ContractAddress is the address of the contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Sender is the address that submitted the operation
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ExecuteAtHeight is the block height at which the operation is executed
+	ExecuteAtHeight uint64 `protobuf:"varint,4,opt,name=execute_at_height,json=executeAtHeight,proto3" json:"execute_at_height,omitempty"`
+	// Type is the kind of the operation
+	Type TimelockedOperationType `protobuf:"varint,5,opt,name=type,proto3,enum=cosmwasm.wasm.v1.TimelockedOperationType" json:"type,omitempty"`
+	// Migration is the new code and the migrate message. Set for migrations
+	// only.
+	Migration *TimelockedMigration `protobuf:"bytes,6,opt,name=migration,proto3" json:"migration,omitempty"`
+	// NewAdmin is the address of the new admin of an admin update
+	NewAdmin string `protobuf:"bytes,7,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	// DelayBlocks is the new timelock of the contract
+	DelayBlocks uint64 `protobuf:"varint,8,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+}
+
+func (m *TimelockedOperation) Reset()         { *m = TimelockedOperation{} }
+func (m *TimelockedOperation) String() string { return proto.CompactTextString(m) }
+func (*TimelockedOperation) ProtoMessage()    {}
+func (*TimelockedOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{21}
+}
+
+func (m *TimelockedOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TimelockedOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
+}
+
+func (m *TimelockedOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedOperation.Merge(m, src)
+}
+
+func (m *TimelockedOperation) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TimelockedOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedOperation proto.InternalMessageInfo
+
+// TimelockedMigration is the migration of a queued operation
+type TimelockedMigration struct {
+	// CodeID is the new code of the contract
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Msg is the json encoded message passed to the contract on migration
+	Msg RawContractMessage `protobuf:"bytes,2,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+}
+
+func (m *TimelockedMigration) Reset()         { *m = TimelockedMigration{} }
+func (m *TimelockedMigration) String() string { return proto.CompactTextString(m) }
+func (*TimelockedMigration) ProtoMessage()    {}
+func (*TimelockedMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{22}
+}
+
+func (m *TimelockedMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TimelockedMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *Model) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimelockedMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedMigration.Merge(m, src)
+}
+
+func (m *TimelockedMigration) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TimelockedMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedMigration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.AnteHook", AnteHook_name, AnteHook_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.BlockHookPhase", BlockHookPhase_name, BlockHookPhase_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.CodeStatus", CodeStatus_name, CodeStatus_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.TimelockedOperationType", TimelockedOperationType_name, TimelockedOperationType_value)
+	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
+	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
+	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*IBCRateLimit)(nil), "cosmwasm.wasm.v1.IBCRateLimit")
+	proto.RegisterType((*IBCPacketUsage)(nil), "cosmwasm.wasm.v1.IBCPacketUsage")
+	proto.RegisterType((*IBCRateLimitOverride)(nil), "cosmwasm.wasm.v1.IBCRateLimitOverride")
+	proto.RegisterType((*ContractAnteHook)(nil), "cosmwasm.wasm.v1.ContractAnteHook")
+	proto.RegisterType((*ContractStateSize)(nil), "cosmwasm.wasm.v1.ContractStateSize")
+	proto.RegisterType((*BlockHook)(nil), "cosmwasm.wasm.v1.BlockHook")
+	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
+	proto.RegisterType((*CodeBuildMetadata)(nil), "cosmwasm.wasm.v1.CodeBuildMetadata")
+	proto.RegisterType((*CodeVerification)(nil), "cosmwasm.wasm.v1.CodeVerification")
+	proto.RegisterType((*MigrationPolicy)(nil), "cosmwasm.wasm.v1.MigrationPolicy")
+	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
+	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
+	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
+	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*StorageDeposit)(nil), "cosmwasm.wasm.v1.StorageDeposit")
+	proto.RegisterType((*CodeStateLimit)(nil), "cosmwasm.wasm.v1.CodeStateLimit")
+	proto.RegisterType((*PendingAdmin)(nil), "cosmwasm.wasm.v1.PendingAdmin")
+	proto.RegisterType((*ContractTimelock)(nil), "cosmwasm.wasm.v1.ContractTimelock")
+	proto.RegisterType((*TimelockedOperation)(nil), "cosmwasm.wasm.v1.TimelockedOperation")
+	proto.RegisterType((*TimelockedMigration)(nil), "cosmwasm.wasm.v1.TimelockedMigration")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
+
+var fileDescriptor_e6155d98fa173e02 = []byte{
+	// 2842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x94, 0x44, 0x8e, 0x28, 0x99, 0x1a, 0x4b, 0x36, 0xc5, 0xe8, 0x4f, 0xd2, 0xeb,
+	0xd8, 0x7f, 0xd9, 0xb1, 0xc9, 0x58, 0x35, 0xd2, 0xd6, 0x40, 0xdc, 0xf2, 0x63, 0x6d, 0x31, 0xb1,
+	0x48, 0x76, 0x48, 0xdb, 0x70, 0x5b, 0x63, 0xbb, 0xdc, 0x1d, 0x51, 0x0b, 0x91, 0xbb, 0xc4, 0xce,
+	0x52, 0x16, 0x73, 0xec, 0xa9, 0x20, 0x50, 0x20, 0xc7, 0x5e, 0x08, 0x14, 0x68, 0x51, 0xa4, 0x0d,
+	0xd0, 0x43, 0x51, 0xa0, 0xe8, 0xa5, 0xe7, 0xa0, 0xbd, 0x04, 0xe8, 0x25, 0x40, 0x01, 0x36, 0x55,
+	0x2e, 0xed, 0x55, 0xe8, 0x29, 0xbd, 0x14, 0xf3, 0xb1, 0x5c, 0x92, 0xa2, 0x64, 0xb9, 0x17, 0x61,
+	0xe7, 0xcd, 0x7b, 0xbf, 0x79, 0xf3, 0xbe, 0x87, 0x02, 0x9b, 0xba, 0x4d, 0xda, 0xaf, 0x34, 0xd2,
+	0xce, 0xb2, 0x3f, 0x87, 0xf7, 0xb2, 0x6e, 0xaf, 0x83, 0x49, 0xa6, 0xe3, 0xd8, 0xae, 0x0d, 0x63,
+	0xde, 0x6e, 0x86, 0xfd, 0x39, 0xbc, 0x97, 0xd8, 0xa0, 0x14, 0x9b, 0xa8, 0x6c, 0x3f, 0xcb, 0x17,
+	0x9c, 0x39, 0xb1, 0xd6, 0xb4, 0x9b, 0x36, 0xa7, 0xd3, 0x2f, 0x41, 0xdd, 0x68, 0xda, 0x76, 0xb3,
+	0x85, 0xb3, 0x6c, 0xd5, 0xe8, 0xee, 0x65, 0x35, 0xab, 0x27, 0xb6, 0x92, 0x5c, 0x3c, 0xdb, 0xd0,
+	0x08, 0xce, 0x1e, 0xde, 0x6b, 0x60, 0x57, 0xbb, 0x97, 0xd5, 0x6d, 0xd3, 0xe2, 0xfb, 0xf2, 0x4b,
+	0x70, 0x29, 0xa7, 0xeb, 0x98, 0x90, 0x7a, 0xaf, 0x83, 0xab, 0x9a, 0xa3, 0xb5, 0x61, 0x11, 0xcc,
+	0x1f, 0x6a, 0xad, 0x2e, 0x8e, 0x4b, 0x69, 0x69, 0x6b, 0x65, 0x7b, 0x33, 0x33, 0xad, 0x60, 0xc6,
+	0x97, 0xc8, 0xc7, 0x4e, 0x86, 0xa9, 0x68, 0x4f, 0x6b, 0xb7, 0x1e, 0xc8, 0x4c, 0x48, 0x46, 0x5c,
+	0xf8, 0x41, 0xe8, 0x67, 0x3f, 0x4f, 0x49, 0xf2, 0x5f, 0x24, 0x10, 0xe5, 0xdc, 0x05, 0xdb, 0xda,
+	0x33, 0x9b, 0xb0, 0x06, 0x40, 0x07, 0x3b, 0x6d, 0x93, 0x10, 0xd3, 0xb6, 0x2e, 0x74, 0xc2, 0xfa,
+	0xc9, 0x30, 0xb5, 0xca, 0x4f, 0xf0, 0x25, 0x65, 0x34, 0x06, 0x03, 0xef, 0x80, 0x45, 0xcd, 0x30,
+	0x1c, 0x4c, 0x48, 0x3c, 0x90, 0x96, 0xb6, 0x22, 0x79, 0x78, 0x32, 0x4c, 0xad, 0x70, 0x19, 0xb1,
+	0x21, 0x23, 0x8f, 0x05, 0x6e, 0x83, 0x88, 0xf8, 0xc4, 0x24, 0x1e, 0x4c, 0x07, 0xb7, 0x22, 0xf9,
+	0xb5, 0x93, 0x61, 0x2a, 0x36, 0xc1, 0x8f, 0x89, 0x8c, 0x7c, 0x36, 0x71, 0x9b, 0x7f, 0x2d, 0x82,
+	0x05, 0x66, 0x23, 0x02, 0x6d, 0x00, 0x75, 0xdb, 0xc0, 0x6a, 0xb7, 0xd3, 0xb2, 0x35, 0x43, 0xd5,
+	0x98, 0xbe, 0xec, 0x3e, 0x4b, 0xdb, 0xc9, 0xb3, 0xee, 0xc3, 0x6d, 0x90, 0xbf, 0xf6, 0xd9, 0x30,
+	0x35, 0x77, 0x32, 0x4c, 0x6d, 0xf0, 0x13, 0x4f, 0xe3, 0xc8, 0x28, 0x46, 0x89, 0x4f, 0x19, 0x8d,
+	0x8b, 0xc2, 0x9f, 0x4a, 0x20, 0x69, 0x5a, 0xc4, 0xd5, 0x2c, 0xd7, 0xd4, 0x5c, 0xac, 0x1a, 0x78,
+	0x4f, 0xeb, 0xb6, 0x5c, 0x75, 0xcc, 0x9a, 0x81, 0x0b, 0x58, 0xf3, 0xd6, 0xc9, 0x30, 0x75, 0x83,
+	0x9f, 0x7b, 0x3e, 0x9a, 0x8c, 0x36, 0xc7, 0x18, 0x8a, 0x7c, 0xbf, 0xea, 0xdb, 0x9c, 0x80, 0x15,
+	0xb3, 0xa1, 0xab, 0x0e, 0x95, 0x6e, 0x99, 0x6d, 0xd3, 0x8d, 0x07, 0xcf, 0xba, 0x7c, 0x29, 0x5f,
+	0x40, 0x9a, 0x8b, 0x9f, 0x50, 0xae, 0xfc, 0x5d, 0x7a, 0xf9, 0xe3, 0x61, 0x2a, 0x3a, 0x4e, 0x3d,
+	0x19, 0xa6, 0xd6, 0x85, 0x52, 0x13, 0x98, 0x32, 0x8a, 0x9a, 0x0d, 0x7d, 0xc4, 0x06, 0x5f, 0x80,
+	0xab, 0x8d, 0x96, 0xad, 0x1f, 0xa8, 0xfb, 0xb6, 0x7d, 0xa0, 0xb6, 0xb5, 0x23, 0x75, 0x4f, 0x33,
+	0x5b, 0x5d, 0x07, 0x93, 0x78, 0x28, 0x2d, 0x6d, 0x2d, 0xe7, 0xe5, 0x93, 0x61, 0x2a, 0xc9, 0x91,
+	0xce, 0x60, 0x94, 0xd1, 0x1a, 0xdb, 0xd9, 0xb1, 0xed, 0x83, 0x5d, 0xed, 0xe8, 0x91, 0x20, 0xc3,
+	0x4f, 0x25, 0x10, 0x27, 0xae, 0xed, 0x68, 0x4d, 0x6a, 0x8d, 0x8e, 0x4d, 0x4c, 0x66, 0x0d, 0xb5,
+	0xd1, 0x73, 0x71, 0x7c, 0x3e, 0x1d, 0xdc, 0x5a, 0xda, 0xde, 0xc8, 0x88, 0x5c, 0xa4, 0xc9, 0x94,
+	0x11, 0xc9, 0x94, 0x29, 0xd8, 0xa6, 0x95, 0x7f, 0x2e, 0x5c, 0x9a, 0xe2, 0x67, 0x9f, 0x05, 0x24,
+	0xff, 0xe6, 0xef, 0xa9, 0x3b, 0x4d, 0xd3, 0xdd, 0xef, 0x36, 0x32, 0xba, 0xdd, 0xce, 0x3e, 0x32,
+	0x2d, 0xa2, 0xef, 0x9b, 0x5a, 0x76, 0x4f, 0x7c, 0xdc, 0x25, 0xc6, 0x81, 0xa8, 0x0e, 0x14, 0x97,
+	0xa0, 0x75, 0x01, 0x55, 0xe4, 0x48, 0x55, 0xec, 0xe4, 0x7b, 0x2e, 0x86, 0x3f, 0x04, 0x71, 0x7a,
+	0x29, 0xdd, 0xb6, 0x5c, 0x47, 0xd3, 0x5d, 0x95, 0xb8, 0xd4, 0x66, 0xf4, 0x08, 0x12, 0x5f, 0x48,
+	0x4b, 0x5b, 0xa1, 0xfc, 0x75, 0x5f, 0x9b, 0xb3, 0x38, 0x65, 0xb4, 0xde, 0xd6, 0x8e, 0x0a, 0x62,
+	0xa7, 0x46, 0x37, 0x28, 0x38, 0x81, 0x2f, 0x41, 0x5c, 0x6b, 0xb5, 0xec, 0x57, 0xaa, 0xd9, 0x6e,
+	0x77, 0x5d, 0xad, 0xd1, 0xc2, 0xaa, 0x7d, 0x88, 0x1d, 0xc7, 0x34, 0x70, 0x7c, 0x31, 0x2d, 0x6d,
+	0x85, 0xc7, 0xd1, 0xcf, 0xe2, 0x94, 0xd1, 0x15, 0xb6, 0x55, 0xf2, 0x76, 0x2a, 0x62, 0x03, 0x7e,
+	0x17, 0xac, 0xb0, 0x98, 0x3f, 0xc4, 0x8e, 0xb9, 0x67, 0x62, 0x87, 0xc4, 0xc3, 0x2c, 0x0b, 0x37,
+	0xfc, 0x30, 0x98, 0xdc, 0x97, 0xd1, 0x32, 0x25, 0x3c, 0xf3, 0xd6, 0xf0, 0x07, 0x20, 0x3e, 0xe5,
+	0xde, 0xa6, 0x46, 0x44, 0x18, 0x46, 0xa6, 0xaf, 0x7f, 0x16, 0xe7, 0x54, 0x24, 0x3c, 0xd6, 0x08,
+	0x0b, 0x32, 0x96, 0xeb, 0x73, 0xf2, 0xbf, 0x25, 0x30, 0x11, 0xa2, 0xb0, 0x06, 0xa8, 0xb5, 0xd4,
+	0x8e, 0xa6, 0x1f, 0x60, 0x97, 0x70, 0x97, 0x52, 0x71, 0x96, 0xf4, 0xa1, 0x7c, 0xfa, 0x64, 0x98,
+	0xda, 0xf4, 0xed, 0x7d, 0x8a, 0x4d, 0x46, 0xb0, 0xad, 0x1d, 0x55, 0x39, 0x99, 0xba, 0x91, 0x12,
+	0x61, 0x15, 0xac, 0x51, 0x6e, 0xe6, 0x0e, 0xc6, 0xfb, 0xca, 0xb4, 0x0c, 0xfb, 0x15, 0x4b, 0xe5,
+	0x50, 0x3e, 0x75, 0x32, 0x4c, 0xbd, 0xe5, 0x63, 0x4e, 0x73, 0xc9, 0x68, 0xb5, 0xad, 0x1d, 0x31,
+	0x97, 0x55, 0xb1, 0xf3, 0x9c, 0xd1, 0xe0, 0xfb, 0x60, 0x99, 0xef, 0xf2, 0x63, 0x09, 0x4b, 0xcb,
+	0x50, 0x3e, 0x7e, 0x32, 0x4c, 0xad, 0x71, 0xa8, 0x89, 0x6d, 0x19, 0x45, 0xf9, 0x3a, 0xcf, 0x97,
+	0x5f, 0x48, 0x60, 0xa5, 0x94, 0x2f, 0x70, 0x3d, 0x9f, 0x12, 0xad, 0x89, 0xe1, 0x35, 0x10, 0x15,
+	0x26, 0xc4, 0x66, 0x73, 0xdf, 0x65, 0xf7, 0x0d, 0xa2, 0x25, 0x6e, 0x3b, 0x46, 0x82, 0x5b, 0x20,
+	0xe6, 0x5d, 0xd8, 0xb4, 0x84, 0x59, 0xd8, 0x15, 0xd0, 0x8a, 0xa0, 0x97, 0x2c, 0x7e, 0xe1, 0x0c,
+	0xb8, 0x2c, 0xce, 0x27, 0xae, 0xe6, 0xb8, 0x1e, 0x66, 0x90, 0x61, 0xae, 0xf2, 0xad, 0x1a, 0xdd,
+	0x11, 0xc8, 0x37, 0xc1, 0x25, 0x7e, 0x6d, 0xd3, 0xf2, 0x6c, 0x13, 0x62, 0xc0, 0xcb, 0x8c, 0x5c,
+	0xb2, 0xc4, 0xb5, 0xaf, 0x81, 0x68, 0xc7, 0xe9, 0x5a, 0xd8, 0x03, 0x9c, 0xe7, 0x4a, 0x32, 0x1a,
+	0x87, 0x92, 0x3f, 0x95, 0xc0, 0xda, 0xb8, 0x47, 0x47, 0xf1, 0x78, 0x0b, 0xc4, 0x46, 0xe9, 0xe1,
+	0xf5, 0x11, 0x7a, 0xc9, 0x08, 0xba, 0xe4, 0xd1, 0x73, 0xa2, 0x77, 0xdc, 0x01, 0x40, 0xdf, 0xd7,
+	0x2c, 0x0b, 0xb7, 0x54, 0xd3, 0x10, 0xcd, 0x66, 0xf9, 0x78, 0x98, 0x8a, 0x14, 0x38, 0xb5, 0x54,
+	0x44, 0x11, 0xc1, 0x50, 0x32, 0xe0, 0x03, 0x30, 0xff, 0x26, 0xa5, 0x31, 0x44, 0x8b, 0x08, 0xe2,
+	0x22, 0x72, 0x1b, 0xc4, 0xbc, 0xcc, 0xcc, 0x59, 0x2e, 0xa6, 0x41, 0xfa, 0x26, 0x8a, 0x66, 0x40,
+	0x88, 0x46, 0xbc, 0xe8, 0x09, 0x89, 0x19, 0x3d, 0x41, 0x80, 0x22, 0xc6, 0x27, 0x3f, 0x07, 0xab,
+	0x13, 0x85, 0xa0, 0x66, 0x7e, 0x84, 0xe1, 0x5b, 0x20, 0x72, 0x80, 0x7b, 0xaa, 0x6e, 0x77, 0x2d,
+	0xee, 0xf6, 0x10, 0x0a, 0x1f, 0xe0, 0x5e, 0x81, 0xae, 0x61, 0x0a, 0x2c, 0xb9, 0xb6, 0xab, 0xb5,
+	0x44, 0xd5, 0xe1, 0xee, 0x06, 0x8c, 0xc4, 0x42, 0xf2, 0x41, 0xe8, 0x9f, 0xb4, 0x67, 0xfe, 0x49,
+	0x02, 0x91, 0xbc, 0x97, 0x66, 0x6f, 0x72, 0x83, 0xf7, 0xc0, 0x7c, 0x67, 0x5f, 0x23, 0x58, 0x5c,
+	0x21, 0x7d, 0xfa, 0x0a, 0x23, 0xd8, 0x2a, 0xe5, 0x43, 0x9c, 0x9d, 0x2a, 0xed, 0x17, 0x83, 0x20,
+	0x57, 0xba, 0x29, 0x72, 0x1b, 0xde, 0x03, 0x6b, 0xba, 0x6d, 0x11, 0xac, 0x77, 0x5d, 0xf3, 0x10,
+	0x4f, 0x75, 0x0f, 0x74, 0x79, 0x6c, 0xcf, 0x6b, 0x0c, 0xf2, 0xef, 0x42, 0x20, 0x5c, 0xb0, 0x0d,
+	0x5c, 0xb2, 0xf6, 0x6c, 0x0a, 0xce, 0x4a, 0xd3, 0xbe, 0x46, 0xf6, 0x99, 0xe2, 0x51, 0x14, 0xa6,
+	0x84, 0x1d, 0x8d, 0xec, 0xc3, 0x38, 0x58, 0xd4, 0x1d, 0xac, 0xb9, 0xb6, 0xc3, 0x23, 0x03, 0x79,
+	0x4b, 0x58, 0x03, 0x70, 0xbc, 0xdb, 0xea, 0x6c, 0x0e, 0x60, 0x31, 0xfa, 0xfa, 0x69, 0x81, 0x47,
+	0xc5, 0xea, 0x98, 0xbc, 0x18, 0xa5, 0xee, 0x83, 0x05, 0x5a, 0xcc, 0xbb, 0xbc, 0xe2, 0xcf, 0x6c,
+	0xfc, 0x54, 0xef, 0x1a, 0xe3, 0x41, 0x82, 0x17, 0x5e, 0x07, 0xcb, 0xfc, 0x4b, 0x75, 0xb0, 0x46,
+	0x6c, 0x8b, 0x15, 0xf4, 0x08, 0x8a, 0x72, 0x22, 0x62, 0x34, 0x58, 0x02, 0xeb, 0xa4, 0xdb, 0xc1,
+	0x0e, 0xc1, 0x06, 0x36, 0xd4, 0x06, 0x0d, 0x01, 0x03, 0xd3, 0x88, 0x0f, 0xb3, 0x62, 0x72, 0xe5,
+	0x78, 0x98, 0x82, 0xb5, 0x11, 0x43, 0xbe, 0xc7, 0xec, 0x53, 0x44, 0x90, 0x4c, 0xd3, 0x0c, 0xf8,
+	0x04, 0xc4, 0xda, 0x66, 0xd3, 0xd1, 0x5c, 0xd3, 0xb6, 0xd4, 0x8e, 0xdd, 0x32, 0xf5, 0x1e, 0x2b,
+	0xd1, 0x4b, 0xdb, 0xd7, 0x4e, 0xeb, 0xbb, 0xeb, 0x71, 0x56, 0x19, 0x23, 0xba, 0xd4, 0x9e, 0x24,
+	0xc0, 0x0f, 0xc0, 0x4a, 0xa3, 0x6b, 0xb6, 0x0c, 0xb5, 0x8d, 0x5d, 0xcd, 0xd0, 0x5c, 0x2d, 0x0e,
+	0x18, 0xd6, 0xf5, 0xd9, 0x77, 0xcf, 0x53, 0xde, 0x5d, 0xc1, 0x8a, 0x96, 0x1b, 0xe3, 0x4b, 0x58,
+	0x06, 0xcb, 0xbc, 0xc3, 0xe8, 0xec, 0x04, 0x12, 0x5f, 0x62, 0x5d, 0x5e, 0x9e, 0x0d, 0xf5, 0x6c,
+	0x8c, 0x55, 0xf8, 0x64, 0x52, 0xfc, 0x83, 0x50, 0x38, 0x18, 0x0b, 0x7d, 0x10, 0x0a, 0x87, 0x62,
+	0xf3, 0xf2, 0xc7, 0x12, 0xcd, 0xa7, 0x29, 0x05, 0xe0, 0x15, 0xb0, 0x40, 0xec, 0xae, 0xa3, 0x63,
+	0x11, 0xf3, 0x62, 0x45, 0xe9, 0xba, 0xdd, 0xa6, 0xf1, 0xca, 0xe3, 0x46, 0xac, 0x68, 0x40, 0x31,
+	0x95, 0xb1, 0xc3, 0x02, 0x39, 0x82, 0xbc, 0x25, 0x7c, 0x07, 0xac, 0xda, 0x1d, 0xd7, 0x6c, 0x9b,
+	0x1f, 0x61, 0x87, 0xf6, 0x49, 0x36, 0xff, 0x85, 0x18, 0x4f, 0x6c, 0xb4, 0xf1, 0x8c, 0xd3, 0x45,
+	0x22, 0x3e, 0xa1, 0x05, 0x65, 0xf2, 0x1e, 0x30, 0x01, 0xc2, 0x5e, 0x93, 0x15, 0x2a, 0x8d, 0xd6,
+	0x54, 0x29, 0x51, 0x4b, 0x79, 0x6a, 0x8b, 0x95, 0x40, 0xfb, 0xad, 0x04, 0x2e, 0x4d, 0x79, 0x0b,
+	0xde, 0x04, 0x61, 0x11, 0x27, 0x34, 0xa9, 0x83, 0x5b, 0xa1, 0xfc, 0xd2, 0xf1, 0x30, 0xb5, 0xc8,
+	0x83, 0x83, 0xa0, 0x45, 0x9d, 0x45, 0x04, 0x81, 0x08, 0x44, 0xf4, 0x7d, 0xac, 0x1f, 0x90, 0x6e,
+	0x9b, 0xd6, 0x8d, 0xe0, 0x56, 0x34, 0x7f, 0xff, 0xeb, 0x61, 0xea, 0xdd, 0x59, 0x83, 0x91, 0x4d,
+	0x68, 0x51, 0xb0, 0xad, 0x6c, 0xcb, 0x6c, 0x90, 0x2c, 0x2b, 0x35, 0x99, 0x1d, 0xcc, 0x9b, 0x1e,
+	0xf2, 0x61, 0xe0, 0x06, 0x08, 0x1b, 0xd8, 0xea, 0xa9, 0x5a, 0xab, 0xc5, 0x6c, 0x15, 0x46, 0x8b,
+	0x74, 0x9d, 0x6b, 0xb5, 0x84, 0xc2, 0x7f, 0x0b, 0x80, 0xa8, 0x57, 0xe1, 0x58, 0x2a, 0x5f, 0x07,
+	0x8b, 0x5e, 0x54, 0xf3, 0x0e, 0x0e, 0x8e, 0x87, 0xa9, 0x05, 0x11, 0xc9, 0x0b, 0x5c, 0xd7, 0x73,
+	0x52, 0x7a, 0x0d, 0xcc, 0x6b, 0x46, 0xdb, 0xb4, 0x84, 0x67, 0xf8, 0x82, 0x52, 0x5b, 0x5a, 0x03,
+	0xb7, 0x84, 0x2f, 0xf8, 0x02, 0x3e, 0x14, 0x28, 0xd8, 0x10, 0x39, 0xff, 0xf6, 0x8c, 0x9c, 0x6f,
+	0x10, 0xbb, 0xd5, 0x75, 0x71, 0xfd, 0xa8, 0x4a, 0x07, 0x3d, 0xd3, 0xb6, 0x90, 0x27, 0x04, 0xef,
+	0x82, 0x25, 0x3a, 0x17, 0x77, 0x6c, 0xc7, 0xa5, 0xea, 0x2e, 0xf8, 0x6d, 0x87, 0xb6, 0x6a, 0xdb,
+	0x71, 0x69, 0xdb, 0x31, 0x1b, 0x3a, 0xfb, 0x34, 0xe0, 0x2e, 0x88, 0xe0, 0x23, 0x17, 0x5b, 0x2c,
+	0x28, 0x16, 0xd9, 0x81, 0x6b, 0x19, 0xfe, 0x44, 0xcc, 0x78, 0x4f, 0xc4, 0x4c, 0xce, 0xea, 0xe5,
+	0x37, 0xfe, 0xfc, 0xfb, 0xbb, 0xeb, 0xe3, 0x46, 0x51, 0x3c, 0x31, 0xe4, 0x23, 0xc0, 0x4d, 0x10,
+	0x19, 0x4d, 0x77, 0xac, 0x00, 0x84, 0x91, 0x4f, 0x10, 0xd6, 0xfd, 0x8f, 0x04, 0xe2, 0x1e, 0x10,
+	0x35, 0xe1, 0x8e, 0x49, 0xe7, 0xd6, 0x9e, 0x62, 0xb9, 0x4e, 0x0f, 0x56, 0x41, 0xc4, 0xee, 0x60,
+	0x1e, 0x2a, 0xe2, 0xc9, 0xb7, 0x3d, 0x2b, 0xc9, 0x4e, 0x89, 0x57, 0x3c, 0x29, 0xfa, 0x74, 0x41,
+	0x3e, 0xc8, 0xb8, 0xef, 0x02, 0x67, 0xfa, 0xee, 0x21, 0x58, 0xec, 0x76, 0x0c, 0x66, 0xf5, 0xe0,
+	0x9b, 0x58, 0x5d, 0x08, 0xc1, 0x2d, 0x10, 0x6c, 0x93, 0x26, 0xf3, 0x64, 0x34, 0x7f, 0xe5, 0xeb,
+	0x61, 0x0a, 0x22, 0xed, 0x95, 0xa7, 0xe5, 0x2e, 0x26, 0x74, 0x38, 0x42, 0x94, 0x45, 0x46, 0x00,
+	0x9e, 0x06, 0x9a, 0x39, 0x37, 0x85, 0x26, 0xe7, 0xa6, 0x0d, 0x10, 0x76, 0x8f, 0x54, 0xd3, 0x32,
+	0xf0, 0x91, 0xc8, 0xb2, 0x45, 0xf7, 0xa8, 0x44, 0x97, 0x32, 0x06, 0xf3, 0xbb, 0xb6, 0x81, 0x5b,
+	0xf0, 0x11, 0x08, 0x1e, 0xe0, 0x1e, 0x6f, 0x36, 0xff, 0x63, 0x9e, 0x50, 0x00, 0x1a, 0x9a, 0xfc,
+	0x59, 0x1f, 0x60, 0x6d, 0x8b, 0x2f, 0xe4, 0x1e, 0x58, 0xa9, 0x4d, 0xbc, 0x30, 0x60, 0x13, 0x2c,
+	0x68, 0x6d, 0xd1, 0xf1, 0x5f, 0xf3, 0xea, 0xb9, 0x4f, 0xcb, 0xe0, 0x1b, 0x3f, 0x69, 0x04, 0xbc,
+	0xfc, 0x12, 0xac, 0x78, 0xfd, 0x49, 0x8c, 0xd8, 0x17, 0x4a, 0xc9, 0x9b, 0xe0, 0x12, 0x1d, 0x86,
+	0xc7, 0x5f, 0x3c, 0xdc, 0x74, 0xcb, 0x6d, 0xed, 0xc8, 0x7f, 0xc4, 0xc8, 0x3f, 0x96, 0x40, 0xb4,
+	0x8a, 0x2d, 0xc3, 0xb4, 0x9a, 0x39, 0x96, 0x9b, 0x6f, 0x30, 0x7b, 0xbc, 0x05, 0x22, 0x16, 0x7e,
+	0xa5, 0xf2, 0x04, 0xe7, 0x89, 0x1f, 0xb6, 0xf0, 0x2b, 0x8e, 0x73, 0x1b, 0xac, 0xe2, 0xa3, 0x8e,
+	0xe9, 0x60, 0xa2, 0x6a, 0x13, 0x03, 0x6c, 0x08, 0x5d, 0x12, 0x1b, 0x39, 0x31, 0xbe, 0xca, 0x3f,
+	0xf2, 0xa7, 0xb8, 0xba, 0xd9, 0xc6, 0x6c, 0x04, 0x7e, 0x03, 0x3d, 0xae, 0x81, 0xa8, 0x81, 0x5b,
+	0x5a, 0xcf, 0x9b, 0xe5, 0xf9, 0x45, 0x97, 0x18, 0x4d, 0x0c, 0xec, 0x5f, 0x06, 0xc0, 0x65, 0x0f,
+	0x1a, 0x1b, 0xa3, 0x8c, 0x81, 0x57, 0x40, 0x60, 0x64, 0xc6, 0x85, 0xe3, 0x61, 0x2a, 0x50, 0x2a,
+	0xa2, 0x80, 0x69, 0xcc, 0x3c, 0x3d, 0x30, 0xfb, 0x74, 0xda, 0xae, 0xb0, 0xe5, 0x77, 0x1f, 0xb1,
+	0xe2, 0x06, 0xa0, 0x63, 0x12, 0x1e, 0x33, 0x40, 0xc8, 0x33, 0x00, 0xdb, 0xf0, 0x0c, 0x00, 0xdf,
+	0x07, 0x21, 0xea, 0x7b, 0x56, 0xf7, 0x56, 0xb6, 0x6f, 0x9d, 0xce, 0xc0, 0x19, 0xba, 0xb3, 0x6c,
+	0x67, 0x62, 0xb0, 0x00, 0x22, 0xa3, 0x11, 0x80, 0xd5, 0xbd, 0xa5, 0xed, 0x1b, 0xe7, 0x61, 0x8c,
+	0x5a, 0x12, 0xf2, 0xe5, 0x26, 0xbd, 0xb9, 0x38, 0xe5, 0xcd, 0x69, 0x13, 0x87, 0x4f, 0x9b, 0xd8,
+	0x18, 0xb7, 0xf0, 0xe8, 0x84, 0x8b, 0x45, 0xab, 0x28, 0x22, 0x81, 0xd7, 0x16, 0x91, 0xdb, 0xbf,
+	0x0e, 0x00, 0xe0, 0xff, 0x50, 0x03, 0xdf, 0x03, 0x57, 0x73, 0x85, 0x82, 0x52, 0xab, 0xa9, 0xf5,
+	0x17, 0x55, 0x45, 0x7d, 0x5a, 0xae, 0x55, 0x95, 0x42, 0xe9, 0x51, 0x49, 0x29, 0xc6, 0xe6, 0x12,
+	0x1b, 0xfd, 0x41, 0x7a, 0xdd, 0x67, 0x7e, 0x6a, 0x91, 0x0e, 0xd6, 0x69, 0xdb, 0x36, 0xe0, 0x1d,
+	0x00, 0xc7, 0xe5, 0xca, 0x95, 0x7c, 0xa5, 0xf8, 0x22, 0x26, 0x25, 0xd6, 0xfa, 0x83, 0x74, 0xcc,
+	0x17, 0x29, 0xdb, 0x0d, 0xdb, 0xe8, 0xc1, 0x6f, 0x82, 0xf8, 0x38, 0x77, 0xa5, 0xfc, 0xe4, 0x85,
+	0x9a, 0x2b, 0x16, 0x91, 0x52, 0xab, 0xc5, 0x02, 0xd3, 0xc7, 0x54, 0xac, 0x56, 0x2f, 0x37, 0xfa,
+	0x11, 0x6d, 0x7d, 0x5c, 0x50, 0x79, 0xa6, 0xa0, 0x17, 0xec, 0xa4, 0x60, 0xe2, 0x6a, 0x7f, 0x90,
+	0xbe, 0xec, 0x4b, 0x29, 0x87, 0xd8, 0xe9, 0xb1, 0xc3, 0x1e, 0x82, 0xcd, 0x71, 0x99, 0x5c, 0xf9,
+	0x85, 0x5a, 0x79, 0xe4, 0x1d, 0xa7, 0xd4, 0x62, 0xa1, 0xc4, 0x66, 0x7f, 0x90, 0x8e, 0xfb, 0xa2,
+	0x39, 0xab, 0x57, 0xd9, 0xcb, 0x79, 0x3f, 0xc2, 0x25, 0xc2, 0x3f, 0xf9, 0x45, 0x72, 0xee, 0x93,
+	0x5f, 0x26, 0xe7, 0x6e, 0xff, 0x51, 0x02, 0xe1, 0xd1, 0xab, 0x88, 0xaa, 0x52, 0xae, 0x2b, 0xea,
+	0x4e, 0xa5, 0xf2, 0xe1, 0x94, 0x9d, 0xb8, 0x2a, 0x82, 0x71, 0xdc, 0x4a, 0xdf, 0x02, 0x71, 0x5f,
+	0x26, 0xf7, 0xb4, 0xbe, 0xa3, 0x94, 0xeb, 0xa5, 0x42, 0xae, 0x5e, 0xaa, 0x94, 0x63, 0x52, 0x22,
+	0xd1, 0x1f, 0xa4, 0xaf, 0x78, 0x62, 0xb9, 0xae, 0xbb, 0x8f, 0x2d, 0xd7, 0x1b, 0x99, 0xbe, 0x0d,
+	0x36, 0x7c, 0xc9, 0x47, 0x8a, 0xa2, 0xd6, 0xaa, 0x95, 0x72, 0xad, 0x82, 0x6a, 0x3b, 0xa5, 0x6a,
+	0x2c, 0x30, 0x29, 0xfa, 0x08, 0xe3, 0x5a, 0xc7, 0xb6, 0x88, 0xed, 0x90, 0x7d, 0xb3, 0x93, 0x08,
+	0x51, 0xfd, 0x6f, 0xff, 0x55, 0x02, 0x2b, 0x93, 0x2f, 0x17, 0xf8, 0x1d, 0xb0, 0x99, 0x7f, 0x52,
+	0x29, 0x7c, 0xc8, 0x41, 0xab, 0x3b, 0xb9, 0xda, 0xb4, 0xc3, 0xff, 0xaf, 0x3f, 0x48, 0x6f, 0x4c,
+	0x4a, 0x8d, 0x5f, 0xe7, 0xe1, 0x0c, 0x80, 0xbc, 0xf2, 0xb8, 0x54, 0x56, 0x19, 0x39, 0x26, 0x71,
+	0xcb, 0x4e, 0x02, 0xe4, 0x71, 0xd3, 0x14, 0xaf, 0xf2, 0x07, 0x20, 0x71, 0x4a, 0x5e, 0x29, 0x17,
+	0x85, 0xb4, 0xb8, 0xd5, 0xa4, 0xb4, 0x62, 0x19, 0x8c, 0x20, 0x6e, 0xf5, 0x2b, 0x09, 0x00, 0xff,
+	0xb5, 0x41, 0xa3, 0xb0, 0x50, 0x29, 0x2a, 0x6a, 0xad, 0x9e, 0xab, 0x3f, 0xad, 0xa9, 0xb9, 0x42,
+	0xbd, 0xf4, 0x4c, 0x89, 0xcd, 0xf1, 0x28, 0xf4, 0xf9, 0x72, 0x3a, 0x7d, 0x6a, 0xc1, 0xfb, 0xe0,
+	0xca, 0x38, 0x77, 0x51, 0xa9, 0x22, 0xa5, 0x90, 0xab, 0x2b, 0xc5, 0x98, 0x94, 0x88, 0xf7, 0x07,
+	0xe9, 0x35, 0x5f, 0xa2, 0x88, 0x3b, 0x0e, 0xd6, 0x59, 0x7f, 0xce, 0x80, 0xcb, 0xe3, 0x52, 0x48,
+	0x79, 0x56, 0xf9, 0x50, 0x29, 0xc6, 0x02, 0x89, 0xf5, 0xfe, 0x20, 0xbd, 0x3a, 0xf6, 0xf4, 0xc1,
+	0x87, 0xf6, 0x01, 0x36, 0x3c, 0x45, 0x83, 0x20, 0xfd, 0xba, 0x51, 0x03, 0x62, 0xf0, 0x6e, 0xa1,
+	0x52, 0xae, 0xa3, 0x5c, 0xa1, 0xae, 0xb2, 0x33, 0x76, 0x4a, 0xb5, 0x7a, 0x05, 0xbd, 0x50, 0x2b,
+	0x55, 0x05, 0xb1, 0x28, 0x99, 0x95, 0x95, 0xd9, 0xfe, 0x20, 0xfd, 0xce, 0xeb, 0xb0, 0xc7, 0xdd,
+	0xf6, 0x1c, 0xdc, 0xba, 0xd0, 0x31, 0xa5, 0x72, 0xa9, 0x1e, 0x93, 0x12, 0x5b, 0xfd, 0x41, 0xfa,
+	0xed, 0xd7, 0xe1, 0x97, 0x2c, 0xd3, 0x85, 0x2f, 0xc1, 0x9d, 0x0b, 0x01, 0xef, 0x96, 0x1e, 0xa3,
+	0x5c, 0x5d, 0x89, 0x05, 0x12, 0xef, 0xf4, 0x07, 0xe9, 0xff, 0x7f, 0x1d, 0x36, 0xaf, 0x7d, 0xf8,
+	0xc2, 0xf0, 0x8f, 0x95, 0xb2, 0x52, 0x2b, 0xd5, 0x62, 0xc1, 0x8b, 0xc1, 0x3f, 0xc6, 0x16, 0x26,
+	0x26, 0x11, 0x8e, 0xfa, 0x43, 0x10, 0x5c, 0x3d, 0xa3, 0x39, 0xc0, 0xef, 0x81, 0x1b, 0xf5, 0xd2,
+	0xae, 0x42, 0xa3, 0x53, 0x29, 0x9e, 0xef, 0x94, 0x9b, 0xfd, 0x41, 0x5a, 0x3e, 0x03, 0x67, 0xdc,
+	0x17, 0x25, 0x70, 0xed, 0x6c, 0x48, 0xcf, 0x4e, 0x52, 0x42, 0xee, 0x0f, 0xd2, 0xc9, 0x33, 0xe0,
+	0x3c, 0xf3, 0x20, 0x70, 0xf3, 0x1c, 0xed, 0xaa, 0xc5, 0x5c, 0x5d, 0x51, 0x73, 0xc5, 0xdd, 0x52,
+	0x39, 0x16, 0x38, 0x5f, 0x3d, 0x36, 0x88, 0xf2, 0x36, 0x55, 0x3d, 0xef, 0xc6, 0x85, 0x27, 0x4a,
+	0x0e, 0x09, 0xc8, 0x60, 0xe2, 0x46, 0x7f, 0x90, 0xbe, 0x76, 0x06, 0x64, 0xa1, 0x85, 0x35, 0x87,
+	0x23, 0x9e, 0xab, 0x65, 0x4d, 0xa9, 0xab, 0xde, 0x6e, 0x2c, 0x74, 0xae, 0x96, 0x35, 0x3c, 0x1a,
+	0x6d, 0xb8, 0xe7, 0xf2, 0x3b, 0x9f, 0xfd, 0x23, 0x39, 0xf7, 0xc9, 0x71, 0x52, 0xfa, 0xec, 0x38,
+	0x29, 0x7d, 0x7e, 0x9c, 0x94, 0xbe, 0x3c, 0x4e, 0x4a, 0x1f, 0x7f, 0x95, 0x9c, 0xfb, 0xfc, 0xab,
+	0xe4, 0xdc, 0x17, 0x5f, 0x25, 0xe7, 0xbe, 0x7f, 0x73, 0xd6, 0xc0, 0x48, 0xbb, 0xb9, 0x91, 0x3d,
+	0xe2, 0xff, 0x24, 0x63, 0x03, 0x63, 0x63, 0x81, 0x3d, 0x57, 0xbe, 0xf1, 0xdf, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xb5, 0x15, 0x98, 0x0a, 0x42, 0x1b, 0x00, 0x00,
+}
+
+func (this *AccessTypeParam) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*AccessTypeParam)
+	if !ok {
+		that2, ok := that.(AccessTypeParam)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (this *AccessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessConfig)
+	if !ok {
+		that2, ok := that.(AccessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Permission != that1.Permission {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
+		return false
+	}
+	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
+		return false
+	}
+	if !this.IBCRateLimit.Equal(&that1.IBCRateLimit) {
+		return false
+	}
+	if this.BlockHookMaxFailures != that1.BlockHookMaxFailures {
+		return false
+	}
+	if len(this.StorageDepositPerByte) != len(that1.StorageDepositPerByte) {
+		return false
+	}
+	for i := range this.StorageDepositPerByte {
+		if !this.StorageDepositPerByte[i].Equal(&that1.StorageDepositPerByte[i]) {
+			return false
+		}
+	}
+	if this.MaxContractStateBytes != that1.MaxContractStateBytes {
+		return false
+	}
+	if this.AllowImmutableOverride != that1.AllowImmutableOverride {
+		return false
+	}
+	if len(this.CodeVerifiers) != len(that1.CodeVerifiers) {
+		return false
+	}
+	for i := range this.CodeVerifiers {
+		if this.CodeVerifiers[i] != that1.CodeVerifiers[i] {
+			return false
+		}
+	}
+	if this.BlockHookMaxGasLimit != that1.BlockHookMaxGasLimit {
+		return false
+	}
+	return true
+}
+
+func (this *IBCRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCRateLimit)
+	if !ok {
+		that2, ok := that.(IBCRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxPacketsPerBlock != that1.MaxPacketsPerBlock {
+		return false
+	}
+	if this.MaxBytesPerWindow != that1.MaxBytesPerWindow {
+		return false
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
+	}
+	return true
+}
+
+func (this *IBCPacketUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCPacketUsage)
+	if !ok {
+		that2, ok := that.(IBCPacketUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.PacketsInBlock != that1.PacketsInBlock {
+		return false
+	}
+	if this.WindowStartHeight != that1.WindowStartHeight {
+		return false
+	}
+	if this.BytesInWindow != that1.BytesInWindow {
+		return false
+	}
+	if this.PruneHeight != that1.PruneHeight {
+		return false
+	}
+	return true
+}
+
+func (this *IBCRateLimitOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCRateLimitOverride)
+	if !ok {
+		that2, ok := that.(IBCRateLimitOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.ChannelID != that1.ChannelID {
+		return false
+	}
+	if !this.Limit.Equal(&that1.Limit) {
+		return false
+	}
+	return true
+}
+
+func (this *ContractAnteHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractAnteHook)
+	if !ok {
+		that2, ok := that.(ContractAnteHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Hook != that1.Hook {
+		return false
+	}
+	return true
+}
+
+func (this *ContractStateSize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStateSize)
+	if !ok {
+		that2, ok := that.(ContractStateSize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.KeyCount != that1.KeyCount {
+		return false
+	}
+	if this.TotalBytes != that1.TotalBytes {
+		return false
+	}
+	return true
+}
+
+func (this *BlockHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockHook)
+	if !ok {
+		that2, ok := that.(BlockHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Phase != that1.Phase {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.ConsecutiveFailures != that1.ConsecutiveFailures {
+		return false
+	}
+	return true
+}
+
+func (this *CodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeInfo)
+	if !ok {
+		that2, ok := that.(CodeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.StatusReason != that1.StatusReason {
+		return false
+	}
+	if this.SupersededByCodeID != that1.SupersededByCodeID {
+		return false
+	}
+	if !this.MigrationPolicy.Equal(that1.MigrationPolicy) {
+		return false
+	}
+	if !this.BuildMetadata.Equal(that1.BuildMetadata) {
+		return false
+	}
+	if len(this.Verifications) != len(that1.Verifications) {
+		return false
+	}
+	for i := range this.Verifications {
+		if !this.Verifications[i].Equal(&that1.Verifications[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *CodeBuildMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeBuildMetadata)
+	if !ok {
+		that2, ok := that.(CodeBuildMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.Commit != that1.Commit {
+		return false
+	}
+	if this.Builder != that1.Builder {
+		return false
+	}
+	if this.OptimizerVersion != that1.OptimizerVersion {
+		return false
+	}
+	return true
+}
+
+func (this *CodeVerification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeVerification)
+	if !ok {
+		that2, ok := that.(CodeVerification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Verifier != that1.Verifier {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+
+func (this *MigrationPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MigrationPolicy)
+	if !ok {
+		that2, ok := that.(MigrationPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CodeIDs) != len(that1.CodeIDs) {
+		return false
+	}
+	for i := range this.CodeIDs {
+		if this.CodeIDs[i] != that1.CodeIDs[i] {
+			return false
+		}
+	}
+	if len(this.Checksums) != len(that1.Checksums) {
+		return false
+	}
+	for i := range this.Checksums {
+		if !bytes.Equal(this.Checksums[i], that1.Checksums[i]) {
+			return false
+		}
+	}
+	if this.DenyAll != that1.DenyAll {
+		return false
+	}
+	return true
+}
+
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if !this.Created.Equal(that1.Created) {
+		return false
+	}
+	if this.IBCPortID != that1.IBCPortID {
+		return false
+	}
+	if !this.Extension.Equal(that1.Extension) {
+		return false
+	}
+	if this.Immutable != that1.Immutable {
+		return false
+	}
+	return true
+}
+
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !this.Updated.Equal(that1.Updated) {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	return true
+}
+
+func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AbsoluteTxPosition)
+	if !ok {
+		that2, ok := that.(AbsoluteTxPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.TxIndex != that1.TxIndex {
+		return false
+	}
+	return true
+}
+
+func (this *Model) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Model)
+	if !ok {
+		that2, ok := that.(Model)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+
+func (this *StorageDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageDeposit)
+	if !ok {
+		that2, ok := that.(StorageDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *CodeStateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeStateLimit)
+	if !ok {
+		that2, ok := that.(CodeStateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.MaxStateBytes != that1.MaxStateBytes {
+		return false
+	}
+	return true
+}
+
+func (this *PendingAdmin) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingAdmin)
+	if !ok {
+		that2, ok := that.(PendingAdmin)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.NewAdmin != that1.NewAdmin {
+		return false
+	}
+	if this.ExpiresAtHeight != that1.ExpiresAtHeight {
+		return false
+	}
+	return true
+}
+
+func (this *ContractTimelock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractTimelock)
+	if !ok {
+		that2, ok := that.(ContractTimelock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.DelayBlocks != that1.DelayBlocks {
+		return false
+	}
+	return true
+}
+
+func (this *TimelockedOperation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimelockedOperation)
+	if !ok {
+		that2, ok := that.(TimelockedOperation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.ExecuteAtHeight != that1.ExecuteAtHeight {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.Migration.Equal(that1.Migration) {
+		return false
+	}
+	if this.NewAdmin != that1.NewAdmin {
+		return false
+	}
+	if this.DelayBlocks != that1.DelayBlocks {
+		return false
+	}
+	return true
+}
+
+func (this *TimelockedMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimelockedMigration)
+	if !ok {
+		that2, ok := that.(TimelockedMigration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	return true
+}
+
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHookMaxGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHookMaxGasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CodeVerifiers) > 0 {
+		for iNdEx := len(m.CodeVerifiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeVerifiers[iNdEx])
+			copy(dAtA[i:], m.CodeVerifiers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeVerifiers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.AllowImmutableOverride {
+		i--
+		if m.AllowImmutableOverride {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxContractStateBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractStateBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for iNdEx := len(m.StorageDepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDepositPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlockHookMaxFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHookMaxFailures))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.IBCRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.InstantiateDefaultPermission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBytesPerWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytesPerWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxPacketsPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPacketsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCPacketUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCPacketUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCPacketUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PruneHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PruneHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BytesInWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BytesInWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PacketsInBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PacketsInBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCRateLimitOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCRateLimitOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCRateLimitOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractAnteHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAnteHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAnteHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hook != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Hook))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStateSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStateSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStateSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Phase != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.BuildMetadata != nil {
+		{
+			size, err := m.BuildMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MigrationPolicy != nil {
+		{
+			size, err := m.MigrationPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SupersededByCodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SupersededByCodeID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.StatusReason) > 0 {
+		i -= len(m.StatusReason)
+		copy(dAtA[i:], m.StatusReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StatusReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeBuildMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeBuildMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeBuildMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OptimizerVersion) > 0 {
+		i -= len(m.OptimizerVersion)
+		copy(dAtA[i:], m.OptimizerVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OptimizerVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DenyAll {
+		i--
+		if m.DenyAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA8 := make([]byte, len(m.CodeIDs)*10)
+		var j7 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTypes(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Immutable {
+		i--
+		if m.Immutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Extension != nil {
+		{
+			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeStateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeStateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeStateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStateBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxStateBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTimelock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTimelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTimelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelayBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelayBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Migration != nil {
+		{
+			size, err := m.Migration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExecuteAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteAtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	l = m.IBCRateLimit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BlockHookMaxFailures != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHookMaxFailures))
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for _, e := range m.StorageDepositPerByte {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxContractStateBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractStateBytes))
+	}
+	if m.AllowImmutableOverride {
+		n += 2
+	}
+	if len(m.CodeVerifiers) > 0 {
+		for _, s := range m.CodeVerifiers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.BlockHookMaxGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHookMaxGasLimit))
+	}
+	return n
+}
+
+func (m *IBCRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPacketsPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPacketsPerBlock))
+	}
+	if m.MaxBytesPerWindow != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBytesPerWindow))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *IBCPacketUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.PacketsInBlock != 0 {
+		n += 1 + sovTypes(uint64(m.PacketsInBlock))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStartHeight))
+	}
+	if m.BytesInWindow != 0 {
+		n += 1 + sovTypes(uint64(m.BytesInWindow))
+	}
+	if m.PruneHeight != 0 {
+		n += 1 + sovTypes(uint64(m.PruneHeight))
+	}
+	return n
+}
+
+func (m *IBCRateLimitOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ContractAnteHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Hook != 0 {
+		n += 1 + sovTypes(uint64(m.Hook))
+	}
+	return n
+}
+
+func (m *ContractStateSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyCount != 0 {
+		n += 1 + sovTypes(uint64(m.KeyCount))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovTypes(uint64(m.TotalBytes))
+	}
+	return n
+}
+
+func (m *BlockHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovTypes(uint64(m.Phase))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovTypes(uint64(m.ConsecutiveFailures))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.StatusReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SupersededByCodeID != 0 {
+		n += 1 + sovTypes(uint64(m.SupersededByCodeID))
+	}
+	if m.MigrationPolicy != nil {
+		l = m.MigrationPolicy.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BuildMetadata != nil {
+		l = m.BuildMetadata.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeBuildMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OptimizerVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MigrationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.DenyAll {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extension != nil {
+		l = m.Extension.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Immutable {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsoluteTxPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeStateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.MaxStateBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxStateBytes))
+	}
+	return n
+}
+
+func (m *PendingAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *ContractTimelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.DelayBlocks))
+	}
+	return n
+}
+
+func (m *TimelockedOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecuteAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteAtHeight))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	if m.Migration != nil {
+		l = m.Migration.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.DelayBlocks))
+	}
+	return n
+}
+
+func (m *TimelockedMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IBCRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHookMaxFailures", wireType)
+			}
+			m.BlockHookMaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHookMaxFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDepositPerByte = append(m.StorageDepositPerByte, types.Coin{})
+			if err := m.StorageDepositPerByte[len(m.StorageDepositPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractStateBytes", wireType)
+			}
+			m.MaxContractStateBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractStateBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowImmutableOverride", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowImmutableOverride = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeVerifiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeVerifiers = append(m.CodeVerifiers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHookMaxGasLimit", wireType)
+			}
+			m.BlockHookMaxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHookMaxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IBCRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsPerBlock", wireType)
+			}
+			m.MaxPacketsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytesPerWindow", wireType)
+			}
+			m.MaxBytesPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytesPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IBCPacketUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCPacketUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCPacketUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsInBlock", wireType)
+			}
+			m.PacketsInBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsInBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesInWindow", wireType)
+			}
+			m.BytesInWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesInWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneHeight", wireType)
+			}
+			m.PruneHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IBCRateLimitOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCRateLimitOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCRateLimitOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractAnteHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractAnteHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractAnteHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			m.Hook = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hook |= AnteHook(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractStateSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStateSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStateSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			m.KeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BlockHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= BlockHookPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CodeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededByCodeID", wireType)
+			}
+			m.SupersededByCodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupersededByCodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationPolicy == nil {
+				m.MigrationPolicy = &MigrationPolicy{}
+			}
+			if err := m.MigrationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuildMetadata == nil {
+				m.BuildMetadata = &CodeBuildMetadata{}
+			}
+			if err := m.BuildMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifications = append(m.Verifications, CodeVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeBuildMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeBuildMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeBuildMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimizerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimizerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MigrationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenyAll = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1391,17 +6110,17 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			m.Value = 0
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1411,11 +6130,231 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extension == nil {
+				m.Extension = &types1.Any{}
+			}
+			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Immutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Immutable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1438,7 +6377,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1461,17 +6400,36 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			m.Permission = 0
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1481,16 +6439,16 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1500,29 +6458,33 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1532,23 +6494,25 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1572,7 +6536,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,17 +6559,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1615,30 +6579,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1648,7 +6598,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1675,7 +6625,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *Model) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1698,15 +6648,15 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1733,16 +6683,16 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1752,27 +6702,80 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StorageDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1799,7 +6802,8 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1825,7 +6829,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *CodeStateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1848,10 +6852,10 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeStateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeStateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1874,10 +6878,10 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStateBytes", wireType)
 			}
-			var stringLen uint64
+			m.MaxStateBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1887,27 +6891,65 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxStateBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PendingAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1935,11 +6977,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1967,13 +7009,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
 			}
-			var msglen int
+			m.ExpiresAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1983,31 +7025,65 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractTimelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractTimelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractTimelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2035,13 +7111,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
 			}
-			var msglen int
+			m.DelayBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2051,28 +7127,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DelayBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Extension == nil {
-				m.Extension = &types.Any{}
-			}
-			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2095,7 +7154,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *TimelockedOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,17 +7177,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimelockedOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimelockedOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Operation = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2138,16 +7197,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2157,16 +7216,29 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2176,33 +7248,67 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAtHeight", wireType)
+			}
+			m.ExecuteAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TimelockedOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2212,82 +7318,33 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
+			if m.Migration == nil {
+				m.Migration = &TimelockedMigration{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Migration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2297,16 +7354,29 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
 			}
-			m.TxIndex = 0
+			m.DelayBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2316,7 +7386,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				m.DelayBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2343,7 +7413,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Model) Unmarshal(dAtA []byte) error {
+func (m *TimelockedMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2366,17 +7436,17 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimelockedMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimelockedMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var byteLen int
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2386,29 +7456,14 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2435,9 +7490,9 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
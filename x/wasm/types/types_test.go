@@ -15,6 +15,7 @@ import (
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
+	tmBytes "github.com/Finschia/ostracon/libs/bytes"
 	"github.com/Finschia/ostracon/libs/rand"
 	wasmvmtypes "github.com/Finschia/wasmvm/types"
 )
@@ -110,6 +111,55 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
 			expError:   true,
 		},
+		"deprecated and superseded": {
+			srcMutator: func(c *CodeInfo) {
+				c.Status = CodeStatusDeprecated
+				c.SupersededByCodeID = 2
+			},
+		},
+		"active and superseded": {
+			srcMutator: func(c *CodeInfo) { c.SupersededByCodeID = 2 },
+			expError:   true,
+		},
+		"status unknown": {
+			srcMutator: func(c *CodeInfo) { c.Status = 3 },
+			expError:   true,
+		},
+		"with migration policy": {
+			srcMutator: func(c *CodeInfo) { c.MigrationPolicy = &MigrationPolicy{CodeIDs: []uint64{2}} },
+		},
+		"invalid migration policy": {
+			srcMutator: func(c *CodeInfo) { c.MigrationPolicy = &MigrationPolicy{CodeIDs: []uint64{0}} },
+			expError:   true,
+		},
+		"with build metadata and verifications": {
+			srcMutator: func(c *CodeInfo) {
+				c.BuildMetadata = &CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"}
+				c.Verifications = []CodeVerification{{Verifier: c.Creator, Height: 1}}
+			},
+		},
+		"invalid build metadata": {
+			srcMutator: func(c *CodeInfo) { c.BuildMetadata = &CodeBuildMetadata{} },
+			expError:   true,
+		},
+		"verifications without build metadata": {
+			srcMutator: func(c *CodeInfo) { c.Verifications = []CodeVerification{{Verifier: c.Creator, Height: 1}} },
+			expError:   true,
+		},
+		"invalid verification": {
+			srcMutator: func(c *CodeInfo) {
+				c.BuildMetadata = &CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"}
+				c.Verifications = []CodeVerification{{Verifier: c.Creator}}
+			},
+			expError: true,
+		},
+		"duplicate verifier": {
+			srcMutator: func(c *CodeInfo) {
+				c.BuildMetadata = &CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"}
+				c.Verifications = []CodeVerification{{Verifier: c.Creator, Height: 1}, {Verifier: c.Creator, Height: 2}}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -707,3 +757,160 @@ func TestAccessTypeSubset(t *testing.T) {
 		})
 	}
 }
+
+func TestCodeBuildMetadataValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    CodeBuildMetadata
+		expErr bool
+	}{
+		"all good": {
+			src: CodeBuildMetadata{
+				Source:           "https://github.com/Finschia/wasmd",
+				Commit:           "1234abcd",
+				Builder:          "cosmwasm/rust-optimizer:0.12.13",
+				OptimizerVersion: "0.12.13",
+			},
+		},
+		"without optimizer version": {
+			src: CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: "cosmwasm/rust-optimizer:0.12.13"},
+		},
+		"source only": {
+			src:    CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd"},
+			expErr: true,
+		},
+		"commit empty": {
+			src:    CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Builder: "cosmwasm/rust-optimizer:0.12.13"},
+			expErr: true,
+		},
+		"builder empty": {
+			src:    CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd"},
+			expErr: true,
+		},
+		"source empty": {
+			src:    CodeBuildMetadata{Commit: "1234abcd"},
+			expErr: true,
+		},
+		"source not an url": {
+			src:    CodeBuildMetadata{Source: "github.com/Finschia/wasmd"},
+			expErr: true,
+		},
+		"commit too long": {
+			src:    CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: strings.Repeat("a", MaxBuildMetadataFieldSize+1), Builder: "cosmwasm/rust-optimizer:0.12.13"},
+			expErr: true,
+		},
+		"builder too long": {
+			src:    CodeBuildMetadata{Source: "https://github.com/Finschia/wasmd", Commit: "1234abcd", Builder: strings.Repeat("a", MaxBuildMetadataFieldSize+1)},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMigrationPolicyValidateBasic(t *testing.T) {
+	checksum := bytes.Repeat([]byte{1}, 32)
+	specs := map[string]struct {
+		src    MigrationPolicy
+		expErr bool
+	}{
+		"empty": {},
+		"code ids and checksums": {
+			src: MigrationPolicy{CodeIDs: []uint64{1, 2}, Checksums: []tmBytes.HexBytes{checksum}},
+		},
+		"code id zero": {
+			src:    MigrationPolicy{CodeIDs: []uint64{0}},
+			expErr: true,
+		},
+		"duplicate code id": {
+			src:    MigrationPolicy{CodeIDs: []uint64{1, 1}},
+			expErr: true,
+		},
+		"checksum too short": {
+			src:    MigrationPolicy{Checksums: []tmBytes.HexBytes{checksum[1:]}},
+			expErr: true,
+		},
+		"duplicate checksum": {
+			src:    MigrationPolicy{Checksums: []tmBytes.HexBytes{checksum, checksum}},
+			expErr: true,
+		},
+		"deny all": {
+			src: MigrationPolicy{DenyAll: true},
+		},
+		"deny all with code id": {
+			src:    MigrationPolicy{CodeIDs: []uint64{1}, DenyAll: true},
+			expErr: true,
+		},
+		"deny all with checksum": {
+			src:    MigrationPolicy{Checksums: []tmBytes.HexBytes{checksum}, DenyAll: true},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCodeInfoAllowsMigrationTo(t *testing.T) {
+	checksum := bytes.Repeat([]byte{1}, 32)
+	otherChecksum := bytes.Repeat([]byte{2}, 32)
+	specs := map[string]struct {
+		policy   *MigrationPolicy
+		codeID   uint64
+		checksum []byte
+		exp      bool
+	}{
+		"no policy": {
+			codeID:   1,
+			checksum: checksum,
+			exp:      true,
+		},
+		"empty policy": {
+			policy:   &MigrationPolicy{},
+			codeID:   1,
+			checksum: checksum,
+			exp:      true,
+		},
+		"code id listed": {
+			policy:   &MigrationPolicy{CodeIDs: []uint64{1}},
+			codeID:   1,
+			checksum: checksum,
+			exp:      true,
+		},
+		"checksum listed": {
+			policy:   &MigrationPolicy{Checksums: []tmBytes.HexBytes{checksum}},
+			codeID:   1,
+			checksum: checksum,
+			exp:      true,
+		},
+		"not listed": {
+			policy:   &MigrationPolicy{CodeIDs: []uint64{2}, Checksums: []tmBytes.HexBytes{otherChecksum}},
+			codeID:   1,
+			checksum: checksum,
+		}, "deny all": {
+			policy:   &MigrationPolicy{DenyAll: true},
+			codeID:   1,
+			checksum: checksum,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			info := CodeInfoFixture(func(c *CodeInfo) { c.MigrationPolicy = spec.policy })
+			assert.Equal(t, spec.exp, info.AllowsMigrationTo(spec.codeID, spec.checksum))
+		})
+	}
+}
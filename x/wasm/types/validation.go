@@ -1,11 +1,21 @@
 package types
 
 import (
+	"regexp"
+
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
-// MaxSaltSize is the longest salt that can be used when instantiating a contract
-const MaxSaltSize = 64
+const (
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract
+	MaxSaltSize = 64
+
+	// DefaultAddressGenerator is the name of the address generator that is used for predictable contract addresses
+	// when no other generator is selected
+	DefaultAddressGenerator = "default"
+)
+
+var addressGeneratorNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]{0,63}$`)
 
 var (
 	// MaxLabelSize is the longest label that can be used when instantiating a contract
@@ -13,6 +23,9 @@ var (
 
 	// MaxWasmSize is the largest a compiled contract code can be when storing code on chain
 	MaxWasmSize = 800 * 1024 // extension point for chains to customize via compile flag.
+
+	// MaxBuildMetadataFieldSize is the longest value of a build metadata field of a code
+	MaxBuildMetadataFieldSize = 256 // extension point for chains to customize via compile flag.
 )
 
 func validateWasmCode(s []byte) error {
@@ -46,3 +59,14 @@ func ValidateSalt(salt []byte) error {
 	}
 	return nil
 }
+
+// ValidateAddressGeneratorName ensure address generator name constraints
+func ValidateAddressGeneratorName(name string) error {
+	if name == "" {
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	}
+	if !addressGeneratorNameRegexp.MatchString(name) {
+		return ErrInvalid.Wrapf("must match %s", addressGeneratorNameRegexp)
+	}
+	return nil
+}
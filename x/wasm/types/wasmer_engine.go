@@ -8,6 +8,9 @@ import (
 // DefaultMaxQueryStackSize maximum size of the stack of contract instances doing queries
 const DefaultMaxQueryStackSize uint32 = 10
 
+// DefaultTimelockedOperationGasLimit is the max gas that a queued admin operation can consume when it is executed
+const DefaultTimelockedOperationGasLimit uint64 = 10_000_000
+
 // WasmerEngine defines the WASM contract runtime engine.
 type WasmerEngine interface {
 	// Create will compile the wasm code, and store the resulting pre-compile
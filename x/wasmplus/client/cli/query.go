@@ -34,6 +34,11 @@ func GetQueryCmd() *cobra.Command {
 		wasmcli.GetCmdLibVersion(),
 		wasmcli.GetCmdQueryParams(),
 		wasmcli.GetCmdBuildAddress(),
+		wasmcli.GetCmdGetIBCPacketUsage(),
+		wasmcli.GetCmdListBlockHooks(),
+		wasmcli.GetCmdGetStorageDeposit(),
+		wasmcli.GetCmdGetPendingAdmin(),
+		wasmcli.GetCmdGetContractTimelock(),
 		GetCmdListInactiveContracts(),
 		GetCmdIsInactiveContract(),
 	)
@@ -47,6 +47,16 @@ func GetTxCmd() *cobra.Command {
 		wasmcli.MigrateContractCmd(),
 		wasmcli.UpdateContractAdminCmd(),
 		wasmcli.ClearContractAdminCmd(),
+		wasmcli.ProposeContractAdminCmd(),
+		wasmcli.AcceptContractAdminCmd(),
+		wasmcli.CancelContractAdminProposalCmd(),
+		wasmcli.SetContractTimelockCmd(),
+		wasmcli.CancelTimelockedOperationCmd(),
+		wasmcli.MakeContractImmutableCmd(),
+		wasmcli.SetCodeStatusCmd(),
+		wasmcli.PruneCodesCmd(),
+		wasmcli.SetMigrationPolicyCmd(),
+		wasmcli.VerifyCodeCmd(),
 	)
 	return txCmd
 }
@@ -20,6 +20,14 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(wasmcli.ProposalPinCodesCmd),
 	govclient.NewProposalHandler(wasmcli.ProposalUnpinCodesCmd),
 	govclient.NewProposalHandler(wasmcli.ProposalUpdateInstantiateConfigCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalRegisterBlockHookCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalDeregisterBlockHookCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalSetCodeStatusCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalPruneCodesCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalSetCodeStateLimitCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalSetMigrationPolicyCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalSetIBCRateLimitCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalSetContractAnteHookCmd),
 	govclient.NewProposalHandler(cli.ProposalDeactivateContractCmd),
 	govclient.NewProposalHandler(cli.ProposalActivateContractCmd),
 }
@@ -39,15 +39,20 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 	wasmState := wasmkeeper.ExportGenesis(ctx, &keeper.Keeper)
 
 	genState := types.GenesisState{
-		Params:    wasmState.Params,
-		Codes:     wasmState.Codes,
-		Contracts: wasmState.Contracts,
-		Sequences: wasmState.Sequences,
-		GenMsgs:   wasmState.GenMsgs,
+		Params:     wasmState.Params,
+		Codes:      wasmState.Codes,
+		Contracts:  wasmState.Contracts,
+		Sequences:  wasmState.Sequences,
+		GenMsgs:    wasmState.GenMsgs,
+		BlockHooks: wasmState.BlockHooks,
 	}
 
+	// contracts can be excluded by the wasm genesis export filter
+	filter := keeper.GenesisExportFilter()
 	keeper.IterateInactiveContracts(ctx, func(contractAddr sdk.AccAddress) (stop bool) {
-		genState.InactiveContractAddresses = append(genState.InactiveContractAddresses, contractAddr.String())
+		if filter.IncludesContract(contractAddr) {
+			genState.InactiveContractAddresses = append(genState.InactiveContractAddresses, contractAddr.String())
+		}
 		return false
 	})
 
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
+	"github.com/Finschia/wasmd/x/wasmplus/types"
+)
+
+// RegisterInvariants registers the wasm module invariants and the wasmplus inactive contract invariant
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	wasmkeeper.RegisterInvariants(ir, &k.Keeper)
+	ir.RegisterRoute(types.ModuleName, "inactive-contracts", InactiveContractsInvariant(k))
+}
+
+// InactiveContractsInvariant checks that every inactive contract exists and that the inactive contracts
+// match the inactive addresses of bankplus
+func InactiveContractsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.InactiveContractPrefix)
+		for ; iter.Valid(); iter.Next() {
+			contractAddr := sdk.AccAddress(iter.Value())
+			switch {
+			case !bytes.Equal(iter.Key(), types.GetInactiveContractKey(contractAddr)):
+				count++
+				msg += fmt.Sprintf("\tinactive contract key %X does not match address %s\n", iter.Key(), contractAddr)
+			case !k.HasContractInfo(ctx, contractAddr):
+				count++
+				msg += fmt.Sprintf("\tinactive contract %s does not exist\n", contractAddr)
+			case !k.bank.IsInactiveAddr(contractAddr):
+				count++
+				msg += fmt.Sprintf("\tinactive contract %s is not an inactive address in bankplus\n", contractAddr)
+			}
+		}
+		iter.Close()
+
+		k.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ wasmtypes.ContractInfo) bool {
+			if k.bank.IsInactiveAddr(contractAddr) && !k.IsInactiveContract(ctx, contractAddr) {
+				count++
+				msg += fmt.Sprintf("\tcontract %s is an inactive address in bankplus but active\n", contractAddr)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(
+			types.ModuleName, "inactive-contracts",
+			fmt.Sprintf("amount of inconsistent inactive contracts found %d\n%s", count, msg),
+		), count != 0
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasmplus/types"
+)
+
+func TestInactiveContractsInvariant(t *testing.T) {
+	specs := map[string]struct {
+		setup     func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress)
+		expBroken bool
+	}{
+		"all active": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {},
+		},
+		"deactivated contract": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				require.NoError(t, k.deactivateContract(ctx, contractAddr))
+			},
+		},
+		"inactive contract does not exist": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				otherAddr := RandomAccountAddress(t)
+				k.addInactiveContract(ctx, otherAddr)
+				k.bank.AddToInactiveAddr(ctx, otherAddr)
+			},
+			expBroken: true,
+		},
+		"inactive contract key mismatch": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				require.NoError(t, k.deactivateContract(ctx, contractAddr))
+				ctx.KVStore(k.storeKey).Set(types.GetInactiveContractKey(RandomAccountAddress(t)), contractAddr)
+			},
+			expBroken: true,
+		},
+		"inactive contract without bankplus inactive address": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.addInactiveContract(ctx, contractAddr)
+			},
+			expBroken: true,
+		},
+		"bankplus inactive address of active contract": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.bank.AddToInactiveAddr(ctx, contractAddr)
+			},
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			k := keepers.WasmKeeper
+			spec.setup(t, ctx, k, example.Contract)
+
+			msg, gotBroken := InactiveContractsInvariant(k)(ctx)
+			assert.Equal(t, spec.expBroken, gotBroken, msg)
+		})
+	}
+}
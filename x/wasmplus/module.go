@@ -3,6 +3,7 @@ package wasmplus
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -18,10 +19,11 @@ import (
 	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
 
 	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
-	"github.com/Finschia/wasmd/x/wasm/simulation"
+	wasmsimulation "github.com/Finschia/wasmd/x/wasm/simulation"
 	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
 	"github.com/Finschia/wasmd/x/wasmplus/client/cli"
 	"github.com/Finschia/wasmd/x/wasmplus/keeper"
+	"github.com/Finschia/wasmd/x/wasmplus/simulation"
 	"github.com/Finschia/wasmd/x/wasmplus/types"
 )
 
@@ -88,7 +90,7 @@ type AppModule struct {
 	keeper             *keeper.Keeper
 	validatorSetSource wasmkeeper.ValidatorSetSource
 	accountKeeper      wasmtypes.AccountKeeper // for simulation
-	bankKeeper         simulation.BankKeeper
+	bankKeeper         wasmsimulation.BankKeeper
 }
 
 func NewAppModule(
@@ -96,7 +98,7 @@ func NewAppModule(
 	keeper *keeper.Keeper,
 	vs wasmkeeper.ValidatorSetSource,
 	ak wasmtypes.AccountKeeper,
-	bk simulation.BankKeeper,
+	bk wasmsimulation.BankKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic:     AppModuleBasic{},
@@ -124,8 +126,25 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// BeginBlock calls the contracts that are registered as begin block hooks.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.ExecuteBlockHooks(ctx, wasmtypes.BlockHookPhaseBeginBlock)
+}
+
+// EndBlock calls the contracts that are registered as end block hooks, executes the due timelocked operations and
+// removes outdated IBC packet usages. It updates the contract state metrics and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ExecuteBlockHooks(ctx, wasmtypes.BlockHookPhaseEndBlock)
+	am.keeper.ExecuteTimelockedOperations(ctx)
+	am.keeper.PruneIBCPacketUsages(ctx)
+	am.keeper.UpdateContractStateMetrics(ctx)
+	return []abci.ValidatorUpdate{}
+}
+
 // RegisterInvariants registers the wasm module invariants.
-func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(registry, am.keeper)
+}
 
 // Route returns the message routing key for the wasm module.
 func (am AppModule) Route() sdk.Route {
@@ -149,6 +168,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// wasm service
 	wasmtypes.RegisterMsgServer(cfg.MsgServer(), wasmkeeper.NewMsgServerImpl(wasmkeeper.NewDefaultPermissionKeeper(am.keeper)))
 	wasmtypes.RegisterQueryServer(cfg.QueryServer(), keeper.WasmQuerier(am.keeper))
+
+	m := wasmkeeper.NewMigrator(am.keeper.Keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the
@@ -156,7 +180,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (am AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 2
 }
 
 // ____________________________________________________________________________
@@ -168,14 +192,14 @@ func (am AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
 }
 
-// ProposalContents doesn't return any content functions for governance proposals.
+// ProposalContents returns all the wasmplus content functions used to simulate governance proposals.
 func (am AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
-	return nil
+	return simulation.ProposalContents(am.bankKeeper, am.keeper)
 }
 
 // RandomizedParams creates randomized bank param changes for the simulator.
 func (am AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
-	return simulation.ParamChanges(r, am.cdc)
+	return wasmsimulation.ParamChanges(r, am.cdc)
 }
 
 // RegisterStoreDecoder registers a decoder for supply module's types
@@ -0,0 +1,36 @@
+package simulation
+
+import (
+	"github.com/Finschia/finschia-sdk/types/module"
+
+	wasmsimulation "github.com/Finschia/wasmd/x/wasm/simulation"
+	"github.com/Finschia/wasmd/x/wasmplus/types"
+)
+
+// RandomizedGenState generates a random GenesisState for wasmplus. The first genesis contract and a random subset
+// of the others are set inactive.
+func RandomizedGenState(simstate *module.SimulationState) {
+	wasmGenesis := wasmsimulation.RandomGenesisState(simstate)
+
+	var inactiveContracts []string
+	for i, addr := range wasmsimulation.GenesisContractAddresses(wasmGenesis) {
+		if i == 0 || simstate.Rand.Intn(2) == 0 {
+			inactiveContracts = append(inactiveContracts, addr.String())
+		}
+	}
+	wasmplusGenesis := types.GenesisState{
+		Params:                    wasmGenesis.Params,
+		Codes:                     wasmGenesis.Codes,
+		Contracts:                 wasmGenesis.Contracts,
+		Sequences:                 wasmGenesis.Sequences,
+		GenMsgs:                   wasmGenesis.GenMsgs,
+		InactiveContractAddresses: inactiveContracts,
+	}
+
+	_, err := simstate.Cdc.MarshalJSON(&wasmplusGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	simstate.GenState[types.ModuleName] = simstate.Cdc.MustMarshalJSON(&wasmplusGenesis)
+}
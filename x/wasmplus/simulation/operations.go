@@ -0,0 +1,225 @@
+package simulation
+
+import (
+	"encoding/json"
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+
+	"github.com/Finschia/wasmd/app/params"
+	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
+	wasmsimulation "github.com/Finschia/wasmd/x/wasm/simulation"
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
+	"github.com/Finschia/wasmd/x/wasmplus/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgStoreCodeAndInstantiateContract = "op_weight_msg_store_code_and_instantiate_contract"
+)
+
+// WasmKeeper is a subset of the wasmplus keeper used by simulations
+type WasmKeeper interface {
+	wasmsimulation.WasmKeeper
+	IsInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights. The wasm
+// operations only select active contracts as inactive contracts can not be executed, migrated or administrated.
+func WeightedOperations(
+	simstate *module.SimulationState,
+	ak wasmtypes.AccountKeeper,
+	bk wasmsimulation.BankKeeper,
+	wasmKeeper WasmKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgStoreCode                       int
+		weightMsgInstantiateContract             int
+		weightMsgInstantiateContract2            int
+		weightMsgExecuteContract                 int
+		weightMsgMigrateContract                 int
+		weightMsgUpdateAdmin                     int
+		weightMsgClearAdmin                      int
+		weightMsgStoreCodeAndInstantiateContract int
+	)
+
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, wasmsimulation.OpWeightMsgStoreCode, &weightMsgStoreCode, nil,
+		func(_ *rand.Rand) {
+			weightMsgStoreCode = params.DefaultWeightMsgStoreCode
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, wasmsimulation.OpWeightMsgInstantiateContract, &weightMsgInstantiateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgInstantiateContract = params.DefaultWeightMsgInstantiateContract
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, wasmsimulation.OpWeightMsgInstantiateContract2, &weightMsgInstantiateContract2, nil,
+		func(_ *rand.Rand) {
+			weightMsgInstantiateContract2 = params.DefaultWeightMsgInstantiateContract2
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, wasmsimulation.OpWeightMsgExecuteContract, &weightMsgExecuteContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgExecuteContract = params.DefaultWeightMsgExecuteContract
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, wasmsimulation.OpWeightMsgMigrateContract, &weightMsgMigrateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgMigrateContract = params.DefaultWeightMsgMigrateContract
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, wasmsimulation.OpWeightMsgUpdateAdmin, &weightMsgUpdateAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateAdmin = params.DefaultWeightMsgUpdateAdmin
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, wasmsimulation.OpWeightMsgClearAdmin, &weightMsgClearAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgClearAdmin = params.DefaultWeightMsgClearAdmin
+		},
+	)
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgStoreCodeAndInstantiateContract, &weightMsgStoreCodeAndInstantiateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgStoreCodeAndInstantiateContract = params.DefaultWeightMsgStoreCodeAndInstantiateContract
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgStoreCode,
+			wasmsimulation.SimulateMsgStoreCode(ak, bk, wasmKeeper, testdata.ReflectContractWasm(), 5_000_000),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgInstantiateContract,
+			wasmsimulation.SimulateMsgInstantiateContract(ak, bk, wasmKeeper, wasmsimulation.DefaultSimulationCodeIDSelector),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExecuteContract,
+			wasmsimulation.SimulateMsgExecuteContract(
+				ak,
+				bk,
+				wasmKeeper,
+				ActiveContractExecuteSelector(wasmKeeper),
+				wasmsimulation.DefaultSimulationExecuteSenderSelector,
+				wasmsimulation.DefaultSimulationExecutePayloader,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgInstantiateContract2,
+			wasmsimulation.SimulateMsgInstantiateContract2(
+				ak,
+				bk,
+				wasmKeeper,
+				wasmsimulation.DefaultSimulationInstantiate2CodeIDSelector,
+				wasmsimulation.DefaultSimulationInstantiate2Payloader,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMigrateContract,
+			wasmsimulation.SimulateMsgMigrateContract(
+				ak,
+				bk,
+				wasmKeeper,
+				ActiveContractMigrateSelector(wasmKeeper),
+				wasmsimulation.DefaultSimulationMigrateCodeIDSelector,
+				wasmsimulation.DefaultSimulationMigratePayloader,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateAdmin,
+			wasmsimulation.SimulateMsgUpdateAdmin(ak, bk, wasmKeeper, ActiveContractWithAdminSelector(wasmKeeper)),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClearAdmin,
+			wasmsimulation.SimulateMsgClearAdmin(ak, bk, wasmKeeper, ActiveContractWithAdminSelector(wasmKeeper)),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgStoreCodeAndInstantiateContract,
+			SimulateMsgStoreCodeAndInstantiateContract(ak, bk, wasmKeeper),
+		),
+	}
+}
+
+// SimulateMsgStoreCodeAndInstantiateContract generates a MsgStoreCodeAndInstantiateContract that stores the
+// hackatom code and instantiates it with random funds
+func SimulateMsgStoreCodeAndInstantiateContract(ak wasmtypes.AccountKeeper, bk wasmsimulation.BankKeeper, wasmKeeper WasmKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if wasmKeeper.GetParams(ctx).CodeUploadAccess.Permission != wasmtypes.AccessTypeEverybody {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgStoreCodeAndInstantiateContract{}.Type(), "no chain permission"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		deposit := sdk.Coins{}
+		spendableCoins := bk.SpendableCoins(ctx, simAccount.Address)
+		for _, v := range spendableCoins {
+			if bk.IsSendEnabledCoin(ctx, v) {
+				deposit = deposit.Add(simtypes.RandSubsetCoins(r, sdk.NewCoins(v))...)
+			}
+		}
+
+		verifier, _ := simtypes.RandomAcc(r, accs)
+		beneficiary, _ := simtypes.RandomAcc(r, accs)
+		initMsg, err := json.Marshal(testdata.HackatomInitMsg{
+			Verifier:    verifier.Address.String(),
+			Beneficiary: beneficiary.Address.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgStoreCodeAndInstantiateContract{}.Type(), "contract instantiate payload"), nil, err
+		}
+		adminAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.MsgStoreCodeAndInstantiateContract{
+			Sender:       simAccount.Address.String(),
+			WASMByteCode: testdata.HackatomContractWasm(),
+			Admin:        adminAccount.Address.String(),
+			Label:        simtypes.RandStringOfLength(r, 10),
+			Msg:          initMsg,
+			Funds:        deposit,
+		}
+		txCtx := wasmsimulation.BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, deposit)
+		txCtx.ModuleName = types.ModuleName
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// ActiveContractExecuteSelector picks the first active contract that is not a hackatom contract
+func ActiveContractExecuteSelector(wasmKeeper WasmKeeper) wasmsimulation.MsgExecuteContractSelector {
+	return func(ctx sdk.Context, _ wasmsimulation.WasmKeeper) sdk.AccAddress {
+		r, _ := wasmsimulation.FindContract(ctx, wasmKeeper, func(addr sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+			codeInfo := wasmKeeper.GetCodeInfo(ctx, info.CodeID)
+			return codeInfo != nil && !wasmsimulation.IsHackatomCode(*codeInfo) && !wasmKeeper.IsInactiveContract(ctx, addr)
+		})
+		return r
+	}
+}
+
+// ActiveContractMigrateSelector picks the first active hackatom contract with an admin
+func ActiveContractMigrateSelector(wasmKeeper WasmKeeper) wasmsimulation.MsgMigrateContractSelector {
+	return func(ctx sdk.Context, _ wasmsimulation.WasmKeeper) (sdk.AccAddress, wasmtypes.ContractInfo) {
+		return wasmsimulation.FindContract(ctx, wasmKeeper, func(addr sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+			codeInfo := wasmKeeper.GetCodeInfo(ctx, info.CodeID)
+			return info.Admin != "" && codeInfo != nil && wasmsimulation.IsHackatomCode(*codeInfo) &&
+				!wasmKeeper.IsInactiveContract(ctx, addr)
+		})
+	}
+}
+
+// ActiveContractWithAdminSelector picks the first active contract with an admin
+func ActiveContractWithAdminSelector(wasmKeeper WasmKeeper) wasmsimulation.MsgContractAdminSelector {
+	return func(ctx sdk.Context, _ wasmsimulation.WasmKeeper) (sdk.AccAddress, wasmtypes.ContractInfo) {
+		return wasmsimulation.FindContract(ctx, wasmKeeper, func(addr sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+			return info.Admin != "" && !wasmKeeper.IsInactiveContract(ctx, addr)
+		})
+	}
+}
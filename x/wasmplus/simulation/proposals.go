@@ -0,0 +1,119 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+
+	"github.com/Finschia/wasmd/app/params"
+	wasmsimulation "github.com/Finschia/wasmd/x/wasm/simulation"
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
+	"github.com/Finschia/wasmd/x/wasmplus/types"
+)
+
+// Simulation proposal weights constants
+//
+//nolint:gosec
+const (
+	OpWeightDeactivateContractProposal = "op_weight_deactivate_contract_proposal"
+	OpWeightActivateContractProposal   = "op_weight_activate_contract_proposal"
+)
+
+// ProposalContents returns all the wasmplus content functions used to simulate governance proposals
+func ProposalContents(bk wasmsimulation.BankKeeper, wasmKeeper WasmKeeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			wasmsimulation.OpWeightPinCodesProposal,
+			params.DefaultWeightPinCodesProposal,
+			wasmsimulation.SimulatePinCodesProposal(wasmKeeper),
+		),
+		simulation.NewWeightedProposalContent(
+			wasmsimulation.OpWeightUnpinCodesProposal,
+			params.DefaultWeightUnpinCodesProposal,
+			wasmsimulation.SimulateUnpinCodesProposal(wasmKeeper),
+		),
+		simulation.NewWeightedProposalContent(
+			wasmsimulation.OpWeightSudoContractProposal,
+			params.DefaultWeightSudoContractProposal,
+			wasmsimulation.SimulateSudoContractProposal(bk, wasmKeeper, ActiveContractSudoSelector(wasmKeeper)),
+		),
+		simulation.NewWeightedProposalContent(
+			wasmsimulation.OpWeightUpdateInstantiateConfigProposal,
+			params.DefaultWeightUpdateInstantiateConfigProposal,
+			wasmsimulation.SimulateUpdateInstantiateConfigProposal(wasmKeeper),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightDeactivateContractProposal,
+			params.DefaultWeightDeactivateContractProposal,
+			SimulateDeactivateContractProposal(wasmKeeper),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightActivateContractProposal,
+			params.DefaultWeightActivateContractProposal,
+			SimulateActivateContractProposal(wasmKeeper),
+		),
+	}
+}
+
+// SimulateDeactivateContractProposal generates a DeactivateContractProposal for a random active contract
+func SimulateDeactivateContractProposal(wasmKeeper WasmKeeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		contractAddr := randomContract(r, ctx, wasmKeeper, func(addr sdk.AccAddress) bool {
+			return !wasmKeeper.IsInactiveContract(ctx, addr)
+		})
+		if contractAddr == nil {
+			return nil
+		}
+		return &types.DeactivateContractProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			Contract:    contractAddr.String(),
+		}
+	}
+}
+
+// SimulateActivateContractProposal generates an ActivateContractProposal for a random inactive contract
+func SimulateActivateContractProposal(wasmKeeper WasmKeeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		contractAddr := randomContract(r, ctx, wasmKeeper, func(addr sdk.AccAddress) bool {
+			return wasmKeeper.IsInactiveContract(ctx, addr)
+		})
+		if contractAddr == nil {
+			return nil
+		}
+		return &types.ActivateContractProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			Contract:    contractAddr.String(),
+		}
+	}
+}
+
+// ActiveContractSudoSelector picks the first active hackatom contract with spendable funds
+func ActiveContractSudoSelector(wasmKeeper WasmKeeper) wasmsimulation.SudoContractSelector {
+	return func(ctx sdk.Context, _ wasmsimulation.WasmKeeper, bk wasmsimulation.BankKeeper) sdk.AccAddress {
+		r, _ := wasmsimulation.FindContract(ctx, wasmKeeper, func(addr sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+			codeInfo := wasmKeeper.GetCodeInfo(ctx, info.CodeID)
+			return codeInfo != nil && wasmsimulation.IsHackatomCode(*codeInfo) &&
+				!wasmKeeper.IsInactiveContract(ctx, addr) && !bk.SpendableCoins(ctx, addr).IsZero()
+		})
+		return r
+	}
+}
+
+// randomContract returns a random contract address of the contracts that match the given filter or nil when none does
+func randomContract(r *rand.Rand, ctx sdk.Context, wasmKeeper WasmKeeper, filter func(sdk.AccAddress) bool) sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	wasmKeeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, _ wasmtypes.ContractInfo) bool {
+		if filter(addr) {
+			addrs = append(addrs, addr)
+		}
+		return false
+	})
+	if len(addrs) == 0 {
+		return nil
+	}
+	return addrs[r.Intn(len(addrs))]
+}
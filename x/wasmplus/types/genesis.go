@@ -31,6 +31,9 @@ func (gs GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "gen message: %d", i)
 		}
 	}
+	if err := wasmtypes.ValidateBlockHooks(gs.BlockHooks, gs.Params.BlockHookMaxGasLimit); err != nil {
+		return err
+	}
 	for i, addr := range gs.InactiveContractAddresses {
 		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
 			return sdkerrors.Wrapf(err, "inactive contract address: %d", i)
@@ -42,16 +45,13 @@ func (gs GenesisState) ValidateBasic() error {
 // RawWasmState convert to wasm genesis state for vanilla import.
 // Custom data models for privileged contracts are not included
 func (gs GenesisState) RawWasmState() wasmtypes.GenesisState {
-	params := wasmtypes.Params{
-		CodeUploadAccess:             gs.Params.CodeUploadAccess,
-		InstantiateDefaultPermission: gs.Params.InstantiateDefaultPermission,
-	}
 	return wasmtypes.GenesisState{
-		Params:    params,
-		Codes:     gs.Codes,
-		Contracts: gs.Contracts,
-		Sequences: gs.Sequences,
-		GenMsgs:   gs.GenMsgs,
+		Params:     gs.Params,
+		Codes:      gs.Codes,
+		Contracts:  gs.Contracts,
+		Sequences:  gs.Sequences,
+		GenMsgs:    gs.GenMsgs,
+		BlockHooks: gs.BlockHooks,
 	}
 }
 
@@ -38,6 +38,8 @@ type GenesisState struct {
 	GenMsgs   []types.GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
 	// InactiveContractAddresses is a list of contract address that set inactive
 	InactiveContractAddresses []string `protobuf:"bytes,6,rep,name=inactive_contract_addresses,json=inactiveContractAddresses,proto3" json:"inactive_contract_address,omitempty"`
+	// BlockHooks are the contracts that are called with sudo in every block
+	BlockHooks []types.BlockHook `protobuf:"bytes,7,rep,name=block_hooks,json=blockHooks,proto3" json:"block_hooks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -120,6 +122,13 @@ func (m *GenesisState) GetInactiveContractAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetBlockHooks() []types.BlockHook {
+	if m != nil {
+		return m.BlockHooks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.wasm.v1.GenesisState")
 }
@@ -127,33 +136,35 @@ func init() {
 func init() { proto.RegisterFile("lbm/wasm/v1/genesis.proto", fileDescriptor_3308f670fed712dc) }
 
 var fileDescriptor_3308f670fed712dc = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x8b, 0xda, 0x40,
-	0x14, 0xc7, 0x93, 0xfa, 0xa3, 0x35, 0x16, 0x0a, 0x69, 0x69, 0x63, 0x2c, 0x51, 0x5a, 0x68, 0xa5,
-	0x94, 0x04, 0x2d, 0xf4, 0xde, 0xf4, 0xd7, 0xa9, 0x50, 0x94, 0xbd, 0x2c, 0x2c, 0x32, 0x99, 0x0c,
-	0x63, 0xc0, 0xc9, 0x64, 0xf3, 0x46, 0x77, 0x3d, 0xef, 0x3f, 0xb0, 0x7f, 0x96, 0x47, 0x8f, 0x7b,
-	0x92, 0x45, 0x6f, 0xfe, 0x15, 0x4b, 0x26, 0x19, 0xcd, 0xe2, 0x7a, 0x4a, 0xf2, 0xde, 0xe7, 0xfb,
-	0x79, 0x99, 0xe1, 0x19, 0xad, 0x69, 0xc0, 0xbc, 0x2b, 0x04, 0xcc, 0x9b, 0xf7, 0x3d, 0x4a, 0x62,
-	0x02, 0x11, 0xb8, 0x49, 0xca, 0x05, 0x37, 0x9b, 0xd3, 0x80, 0xb9, 0x59, 0xcb, 0x9d, 0xf7, 0xed,
-	0x37, 0x94, 0x53, 0x2e, 0xeb, 0x5e, 0xf6, 0x96, 0x23, 0xf6, 0x7b, 0xcc, 0x81, 0xc9, 0xb4, 0x52,
-	0x88, 0x45, 0x42, 0x0a, 0x81, 0xed, 0x1c, 0x75, 0x1f, 0x0d, 0xf8, 0x70, 0x53, 0x35, 0x5e, 0xfe,
-	0xcd, 0x2b, 0x23, 0x81, 0x04, 0x31, 0xbf, 0x1b, 0xf5, 0x04, 0xa5, 0x88, 0x81, 0xa5, 0x77, 0xf5,
-	0x5e, 0x73, 0x60, 0xb9, 0xca, 0xa0, 0xfe, 0xc3, 0xfd, 0x2f, 0xfb, 0x7e, 0x75, 0xb9, 0xee, 0x68,
-	0xc3, 0x82, 0x36, 0x7f, 0x1b, 0x35, 0xcc, 0x43, 0x02, 0xd6, 0xb3, 0x6e, 0xa5, 0xd7, 0x1c, 0xbc,
-	0x3d, 0x8e, 0xfd, 0xe4, 0x21, 0xf1, 0xdf, 0x65, 0xa1, 0xdd, 0xba, 0xf3, 0x4a, 0xc2, 0x5f, 0x39,
-	0x8b, 0x04, 0x61, 0x89, 0x58, 0x0c, 0xf3, 0xb4, 0x79, 0x66, 0x34, 0x30, 0x8f, 0x45, 0x8a, 0xb0,
-	0x00, 0xab, 0x22, 0x55, 0xf6, 0x53, 0xaa, 0x1c, 0xf1, 0xdb, 0x85, 0xee, 0xf5, 0x3e, 0x54, 0x52,
-	0x1e, 0x4c, 0x99, 0x16, 0xc8, 0xe5, 0x8c, 0xc4, 0x98, 0x80, 0x55, 0x3d, 0xa5, 0x1d, 0x15, 0xc8,
-	0x41, 0xbb, 0x0f, 0x95, 0xb5, 0xfb, 0xa2, 0x79, 0x61, 0xbc, 0xa0, 0x24, 0x1e, 0x33, 0xa0, 0x60,
-	0xd5, 0xa4, 0xf5, 0xd3, 0xb1, 0xb5, 0x7c, 0xbd, 0xd9, 0xc7, 0x3f, 0xa0, 0xe0, 0xdb, 0xc5, 0x04,
-	0x53, 0xe5, 0x4b, 0x03, 0x9e, 0xd3, 0x1c, 0x32, 0xa9, 0xd1, 0x8e, 0x62, 0x84, 0x45, 0x34, 0x27,
-	0x63, 0x75, 0x96, 0x31, 0x0a, 0xc3, 0x94, 0x00, 0x10, 0xb0, 0xea, 0xdd, 0x4a, 0xaf, 0xe1, 0x7f,
-	0xde, 0xad, 0x3b, 0x1f, 0x4f, 0x62, 0x25, 0x6d, 0x4b, 0x41, 0xea, 0xf6, 0x7e, 0x28, 0x93, 0xff,
-	0x6b, 0xb9, 0x71, 0xf4, 0xd5, 0xc6, 0xd1, 0xef, 0x37, 0x8e, 0x7e, 0xbb, 0x75, 0xb4, 0xd5, 0xd6,
-	0xd1, 0xee, 0xb6, 0x8e, 0x76, 0xfe, 0x85, 0x46, 0x62, 0x32, 0x0b, 0x5c, 0xcc, 0x99, 0xf7, 0x27,
-	0x8a, 0x01, 0x4f, 0x22, 0x24, 0x57, 0x29, 0xf4, 0xae, 0xe5, 0x33, 0x99, 0xce, 0x20, 0xdf, 0xb8,
-	0xa0, 0x2e, 0x57, 0xea, 0xdb, 0x43, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x26, 0x39, 0xc4, 0xd0,
-	0x02, 0x00, 0x00,
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xda, 0x75, 0xcc, 0x45, 0x42, 0x32, 0xbf, 0xbc, 0x14, 0xd2, 0x0a, 0x24, 0xa8,
+	0x10, 0x4a, 0xb4, 0x21, 0x71, 0xc7, 0xfc, 0xbc, 0x20, 0xa1, 0x4d, 0x5c, 0x40, 0xa8, 0x72, 0x1c,
+	0xcb, 0x8d, 0x5a, 0xc7, 0xa1, 0xcf, 0x2d, 0xec, 0xbf, 0xe0, 0xcf, 0xda, 0x71, 0x47, 0x4e, 0xd5,
+	0xd4, 0xde, 0xf6, 0x57, 0xa0, 0x38, 0x71, 0x17, 0x14, 0x7a, 0x6a, 0xe3, 0xf7, 0xf9, 0x7e, 0x9e,
+	0x9f, 0xf5, 0xd0, 0xe1, 0x2c, 0x56, 0xd1, 0x4f, 0x06, 0x2a, 0x5a, 0x1e, 0x45, 0x52, 0x64, 0x02,
+	0x52, 0x08, 0xf3, 0xb9, 0x36, 0x1a, 0xf7, 0x66, 0xb1, 0x0a, 0x8b, 0x52, 0xb8, 0x3c, 0xf2, 0xef,
+	0x4a, 0x2d, 0xb5, 0x3d, 0x8f, 0x8a, 0x7f, 0x25, 0xe2, 0x3f, 0xe4, 0x1a, 0x94, 0x4d, 0x3b, 0x85,
+	0x39, 0xcb, 0x45, 0x25, 0xf0, 0x83, 0x46, 0xf5, 0x9f, 0x06, 0x8f, 0x2f, 0x3b, 0xe8, 0xd6, 0x87,
+	0xf2, 0xe4, 0xd4, 0x30, 0x23, 0xf0, 0x2b, 0xd4, 0xcd, 0xd9, 0x9c, 0x29, 0x20, 0xde, 0xd0, 0x1b,
+	0xf5, 0x8e, 0x49, 0xe8, 0x0c, 0xee, 0x1e, 0xe1, 0x67, 0x5b, 0xa7, 0x9d, 0xf3, 0xd5, 0xa0, 0x75,
+	0x52, 0xd1, 0xf8, 0x1d, 0xda, 0xe3, 0x3a, 0x11, 0x40, 0x6e, 0x0c, 0xdb, 0xa3, 0xde, 0xf1, 0xfd,
+	0x66, 0xec, 0x8d, 0x4e, 0x04, 0x7d, 0x50, 0x84, 0xae, 0x56, 0x83, 0xdb, 0x16, 0x7e, 0xa1, 0x55,
+	0x6a, 0x84, 0xca, 0xcd, 0xd9, 0x49, 0x99, 0xc6, 0x5f, 0xd0, 0x01, 0xd7, 0x99, 0x99, 0x33, 0x6e,
+	0x80, 0xb4, 0xad, 0xca, 0xff, 0x9f, 0xaa, 0x44, 0x68, 0xbf, 0xd2, 0xdd, 0xd9, 0x86, 0x6a, 0xca,
+	0x6b, 0x53, 0xa1, 0x05, 0xf1, 0x63, 0x21, 0x32, 0x2e, 0x80, 0x74, 0x76, 0x69, 0x4f, 0x2b, 0xe4,
+	0x5a, 0xbb, 0x0d, 0xd5, 0xb5, 0xdb, 0x43, 0xfc, 0x1d, 0xdd, 0x94, 0x22, 0x1b, 0x2b, 0x90, 0x40,
+	0xf6, 0xac, 0xf5, 0x69, 0xd3, 0x5a, 0x7f, 0xde, 0xe2, 0xe3, 0x13, 0x48, 0xa0, 0x7e, 0xd5, 0x01,
+	0xbb, 0x7c, 0xad, 0xc1, 0xbe, 0x2c, 0x21, 0x2c, 0x51, 0x3f, 0xcd, 0x18, 0x37, 0xe9, 0x52, 0x8c,
+	0xdd, 0x2c, 0x63, 0x96, 0x24, 0x73, 0x01, 0x20, 0x80, 0x74, 0x87, 0xed, 0xd1, 0x01, 0x7d, 0x76,
+	0xb5, 0x1a, 0x3c, 0xd9, 0x89, 0xd5, 0xb4, 0x87, 0x0e, 0x72, 0xaf, 0xf7, 0xda, 0x99, 0xf0, 0x37,
+	0xd4, 0x8b, 0x67, 0x9a, 0x4f, 0xc7, 0x13, 0xad, 0xa7, 0x40, 0xf6, 0xed, 0x28, 0xfd, 0xe6, 0x28,
+	0xb4, 0x80, 0x3e, 0x6a, 0x3d, 0xa5, 0x8f, 0xaa, 0xfb, 0xdf, 0xab, 0xe5, 0x6a, 0xbd, 0x50, 0xec,
+	0x48, 0xa0, 0x6f, 0xcf, 0xd7, 0x81, 0x77, 0xb1, 0x0e, 0xbc, 0xcb, 0x75, 0xe0, 0xfd, 0xde, 0x04,
+	0xad, 0x8b, 0x4d, 0xd0, 0xfa, 0xb3, 0x09, 0x5a, 0x5f, 0x9f, 0xcb, 0xd4, 0x4c, 0x16, 0x71, 0xc8,
+	0xb5, 0x8a, 0xde, 0xa7, 0x19, 0xf0, 0x49, 0xca, 0xec, 0x9e, 0x26, 0xd1, 0x2f, 0xfb, 0x9b, 0xcf,
+	0x16, 0x50, 0xae, 0x73, 0xdc, 0xb5, 0xfb, 0xfa, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x36,
+	0x63, 0xb6, 0xdd, 0x2d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +187,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockHooks) > 0 {
+		for iNdEx := len(m.BlockHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.InactiveContractAddresses) > 0 {
 		for iNdEx := len(m.InactiveContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InactiveContractAddresses[iNdEx])
@@ -304,6 +329,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BlockHooks) > 0 {
+		for _, e := range m.BlockHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -545,6 +576,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.InactiveContractAddresses = append(m.InactiveContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHooks = append(m.BlockHooks, types.BlockHook{})
+			if err := m.BlockHooks[len(m.BlockHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])